@@ -0,0 +1,228 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"github.com/gorilla/context"
+	"github.com/kylelemons/go-gypsy/yaml"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The time format used in Common and Combined Log Format entries.
+const ACCESS_LOG_TIME_FORMAT = "02/Jan/2006:15:04:05 -0700"
+
+// A NamedUser is a User that can say what its username is. If the User your GetUser() function returns implements it, the username
+// is written to the access log. Otherwise a string field called Username is used if the User has one.
+type NamedUser interface {
+	User
+	GetUsername() string
+}
+
+// username returns the name of u for the access log, or an empty string if it can't be found.
+func username(u User) string {
+	if nu, ok := u.(NamedUser); ok {
+		return nu.GetUsername()
+	}
+	v := reflect.ValueOf(u)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		if f := v.FieldByName("Username"); f.IsValid() && f.Kind() == reflect.String {
+			return f.String()
+		}
+	}
+	return ""
+}
+
+// An accessLog writes an entry for every request an App handles, in Common or Combined Log Format.
+type accessLog struct {
+	// The file being written to, or "-" for standard output.
+	path     string
+	combined bool
+
+	mu   sync.Mutex
+	w    io.Writer
+	file *os.File
+}
+
+// newAccessLog sets up the access log from the "log.access" section of the config file. It returns nil if log.access.file isn't set.
+// A relative file name is taken to be in basePath.
+func newAccessLog(c *yaml.File, basePath string) (al *accessLog, err error) {
+	path, err := configString(c, "log.access.file", "")
+	if err != nil || path == "" {
+		return
+	}
+	format, err := configString(c, "log.access.format", "combined")
+	if err != nil {
+		return
+	}
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format != "common" && format != "combined" {
+		err = &SawsijError{fmt.Sprintf("Config value log.access.format must be common or combined, not %q", format)}
+		return
+	}
+
+	al = &accessLog{path: path, combined: format == "combined"}
+	if path == "-" {
+		al.w = os.Stdout
+		return
+	}
+	if !filepath.IsAbs(path) {
+		al.path = filepath.Join(basePath, path)
+	}
+	if err = al.reopen(); err != nil {
+		al = nil
+	}
+	return
+}
+
+// reopen opens the log file again, so that a file moved away by logrotate is replaced with a new one. It's called when the process
+// gets SIGHUP.
+func (al *accessLog) reopen() (err error) {
+	if al.path == "-" {
+		return
+	}
+	f, err := os.OpenFile(al.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return
+	}
+	al.mu.Lock()
+	old := al.file
+	al.file, al.w = f, f
+	al.mu.Unlock()
+	if old != nil {
+		old.Close()
+	}
+	return
+}
+
+// close closes the log file.
+func (al *accessLog) close() (err error) {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	if al.file != nil {
+		err = al.file.Close()
+		al.file = nil
+		al.w = nil
+	}
+	return
+}
+
+// quoteLogField escapes a value that goes inside double quotes in a log entry.
+func quoteLogField(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return strings.Replace(strings.Replace(s, `\`, `\\`, -1), `"`, `\"`, -1)
+}
+
+// write logs a finished request. The entry is in Common or Combined Log Format, with the time taken in microseconds added to the
+// end, like Apache's %D.
+func (al *accessLog) write(r *http.Request, lw *loggingWriter, remote string, user string, start time.Time) {
+	var buf bytes.Buffer
+	if user == "" {
+		user = "-"
+	}
+	uri := r.RequestURI
+	if uri == "" {
+		uri = r.URL.RequestURI()
+	}
+	size := "-"
+	if lw.bytes > 0 {
+		size = strconv.FormatInt(lw.bytes, 10)
+	}
+	fmt.Fprintf(&buf, `%v - %v [%v] "%v %v %v" %v %v`, remote, strings.Replace(user, " ", "_", -1), start.Format(ACCESS_LOG_TIME_FORMAT),
+		r.Method, quoteLogField(uri), r.Proto, lw.status, size)
+	if al.combined {
+		fmt.Fprintf(&buf, ` "%v" "%v"`, quoteLogField(r.Referer()), quoteLogField(r.UserAgent()))
+	}
+	fmt.Fprintf(&buf, " %d\n", time.Since(start)/time.Microsecond)
+
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	if al.w != nil {
+		al.w.Write(buf.Bytes())
+	}
+}
+
+// A loggingWriter keeps track of the status and size of a response for the access log.
+type loggingWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (lw *loggingWriter) WriteHeader(status int) {
+	if lw.status == 0 {
+		lw.status = status
+	}
+	lw.ResponseWriter.WriteHeader(status)
+}
+
+func (lw *loggingWriter) Write(b []byte) (n int, err error) {
+	if lw.status == 0 {
+		lw.status = http.StatusOK
+	}
+	n, err = lw.ResponseWriter.Write(b)
+	lw.bytes += int64(n)
+	return
+}
+
+// Flush sends what's been written so far, if the underlying writer can, so compression and handlers that stream still work with
+// the access log on.
+func (lw *loggingWriter) Flush() {
+	if f, ok := lw.ResponseWriter.(http.Flusher); ok {
+		if lw.status == 0 {
+			lw.status = http.StatusOK
+		}
+		f.Flush()
+	}
+}
+
+// Hijack hands the connection over to the handler, for things like websockets, if the underlying writer allows it.
+func (lw *loggingWriter) Hijack() (conn net.Conn, rw *bufio.ReadWriter, err error) {
+	h, ok := lw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("The response can't be hijacked.")
+	}
+	if lw.status == 0 {
+		// The handler writes its own response to the connection, which is almost always a switch to another protocol.
+		lw.status = http.StatusSwitchingProtocols
+	}
+	return h.Hijack()
+}
+
+// serveLogged handles a request with the router, then writes it to the access log and the metrics. Routes put the username in the
+// request context under usernameKey.
+func (app *App) serveLogged(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	lw := &loggingWriter{ResponseWriter: w}
+	app.router.ServeHTTP(lw, r)
+	if lw.status == 0 {
+		// Nothing was written, so net/http sends an empty 200.
+		lw.status = http.StatusOK
+	}
+	app.metrics.observeRequest(r, lw.status, time.Since(start))
+	if app.accessLog != nil {
+		user, _ := context.Get(r, usernameKey).(string)
+		app.accessLog.write(r, lw, app.proxies.clientIp(r), user, start)
+	}
+}
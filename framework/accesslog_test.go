@@ -0,0 +1,214 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+type namedTestUser struct {
+	authTestUser
+}
+
+func (u *namedTestUser) GetUsername() string {
+	return "named"
+}
+
+func TestUsername(t *testing.T) {
+	tests := []struct {
+		user     User
+		expected string
+	}{
+		{&namedTestUser{}, "named"},
+		{&authTestUser{Username: "jaybill"}, "jaybill"},
+		{&singleRoleUser{}, ""},
+		{nil, ""},
+	}
+	for _, test := range tests {
+		if name := username(test.user); name != test.expected {
+			t.Errorf("%T: expected %q, got %q", test.user, test.expected, name)
+		}
+	}
+}
+
+func TestClientIp(t *testing.T) {
+	tp, err := parseTrustedProxies([]string{"10.0.0.1", "192.168.0.0/16", "::1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		remote    string
+		forwarded string
+		expected  string
+	}{
+		{"1.2.3.4:5000", "", "1.2.3.4"},
+		{"1.2.3.4:5000", "5.6.7.8", "1.2.3.4"},
+		{"10.0.0.1:5000", "5.6.7.8", "5.6.7.8"},
+		{"10.0.0.1:5000", "6.6.6.6, 5.6.7.8, 192.168.1.5", "5.6.7.8"},
+		{"[::1]:5000", "5.6.7.8", "5.6.7.8"},
+		{"10.0.0.1:5000", "", "10.0.0.1"},
+		{"10.0.0.1:5000", "192.168.1.5", "192.168.1.5"},
+	}
+	for _, test := range tests {
+		r, _ := http.NewRequest("GET", "http://localhost/", nil)
+		r.RemoteAddr = test.remote
+		if test.forwarded != "" {
+			r.Header.Set("X-Forwarded-For", test.forwarded)
+		}
+		if ip := tp.clientIp(r); ip != test.expected {
+			t.Errorf("%v via %q: expected %v, got %v", test.remote, test.forwarded, test.expected, ip)
+		}
+	}
+	if _, err = parseTrustedProxies([]string{"proxy.local"}); err == nil {
+		t.Error("Expected an error for a host name")
+	}
+}
+
+func TestAccessLog(t *testing.T) {
+	basePath := standupApp(t, "accesslog", []string{"trustedProxies: 10.0.0.1"}, map[string]string{"index.html": "hello"})
+	defer os.RemoveAll(basePath)
+	f, err := os.OpenFile(basePath+"/etc/config.yaml", os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("log:\n  access:\n    file: access.log\n")
+	f.Close()
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app.Route(RouteConfig{Pattern: "/", Handler: testHandler, Roles: []int{R_GUEST}})
+
+	request := func(path string) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost"+path, nil)
+		r.RequestURI = path
+		r.RemoteAddr = "10.0.0.1:4000"
+		r.Header.Set("X-Forwarded-For", "5.6.7.8")
+		r.Header.Set("User-Agent", `Test "Agent"`)
+		r.Header.Set("Referer", "http://example.com/")
+		app.ServeHTTP(w, r)
+	}
+	request("/?q=1")
+	request("/missing")
+
+	b, err := ioutil.ReadFile(basePath + "/access.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 entries, got %q", b)
+	}
+	entry := regexp.MustCompile(`^5\.6\.7\.8 - - \[[^\]]+\] "GET /\?q=1 HTTP/1\.1" 200 5 "http://example\.com/" "Test \\"Agent\\"" \d+$`)
+	if !entry.MatchString(lines[0]) {
+		t.Errorf("Entry doesn't match Combined Log Format: %q", lines[0])
+	}
+	if !strings.Contains(lines[1], `"GET /missing HTTP/1.1" 404 `) {
+		t.Errorf("Expected a 404 entry, got %q", lines[1])
+	}
+
+	// What logrotate does: move the file away, then send SIGHUP.
+	if err = os.Rename(basePath+"/access.log", basePath+"/access.log.1"); err != nil {
+		t.Fatal(err)
+	}
+	if err = app.accessLog.reopen(); err != nil {
+		t.Fatal(err)
+	}
+	request("/")
+	b, err = ioutil.ReadFile(basePath + "/access.log")
+	if err != nil || strings.Count(string(b), "\n") != 1 {
+		t.Errorf("Expected one entry in the new file, got %q (%v)", b, err)
+	}
+	app.accessLog.close()
+}
+
+func TestAccessLogStreaming(t *testing.T) {
+	basePath := standupApp(t, "accesslogstream", nil, map[string]string{"index.html": "hello"})
+	defer os.RemoveAll(basePath)
+	f, err := os.OpenFile(basePath+"/etc/config.yaml", os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("log:\n  access:\n    file: access.log\n")
+	f.Close()
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer app.accessLog.close()
+	app.router.add("/stream", nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "first")
+		f, ok := w.(http.Flusher)
+		if !ok {
+			t.Fatal("Expected the response to be an http.Flusher")
+		}
+		f.Flush()
+	}))
+	app.router.add("/hijack", nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// This runs in the server's goroutine, so it can't use t.Fatal.
+		h, ok := w.(http.Hijacker)
+		if !ok {
+			t.Error("Expected the response to be an http.Hijacker")
+			return
+		}
+		conn, rw, err := h.Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: test\r\nConnection: Upgrade\r\n\r\nhijacked")
+		rw.Flush()
+	}))
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "http://localhost/stream", nil)
+	app.ServeHTTP(w, r)
+	if !w.Flushed || w.Body.String() != "first" {
+		t.Errorf("Expected the response to be flushed, got %v %q", w.Flushed, w.Body.String())
+	}
+
+	server := httptest.NewServer(app)
+	defer server.Close()
+	conn, err := net.Dial("tcp", server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	io.WriteString(conn, "GET /hijack HTTP/1.1\r\nHost: localhost\r\nUpgrade: test\r\nConnection: Upgrade\r\n\r\n")
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadAll(br)
+	if resp.StatusCode != http.StatusSwitchingProtocols || string(b) != "hijacked" {
+		t.Errorf("Expected the hijacked connection's response, got %v %q", resp.StatusCode, b)
+	}
+
+	// The hijacked request is logged once its handler returns, which can be after the client has its response.
+	for i := 0; i < 100; i++ {
+		if b, err = ioutil.ReadFile(basePath + "/access.log"); err != nil || strings.Count(string(b), "\n") == 2 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !strings.Contains(string(b), `"GET /stream HTTP/1.1" 200 5`) || !strings.Contains(string(b), `"GET /hijack HTTP/1.1" 101 -`) {
+		t.Errorf("Expected entries for both requests, got %q", b)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+// A MultiRoleUser is a User that can hold more than one role. If the User your GetUser() function returns implements it, GetRoles()
+// is used instead of GetRole() for permission and role checks.
+type MultiRoleUser interface {
+	User
+	// All of the roles the user has.
+	GetRoles() []int64
+}
+
+// A RoleDef says what a role is allowed to do. Set them up with the Grant() and Inherit() methods of AppSetup.
+type RoleDef struct {
+	// Named permissions granted to the role, like "users.edit"
+	Permissions []string
+	// Roles whose permissions this role also has, so an admin role can inherit everything a member can do.
+	Inherits []int
+}
+
+// Grant gives role the named permissions. Permissions granted to R_GUEST are given to everyone, logged in or not.
+func (as *AppSetup) Grant(role int, permissions ...string) {
+	if as.RoleDefs == nil {
+		as.RoleDefs = make(map[int]RoleDef)
+	}
+	rd := as.RoleDefs[role]
+	rd.Permissions = append(rd.Permissions, permissions...)
+	as.RoleDefs[role] = rd
+}
+
+// Inherit gives role all the permissions that the parent roles have, including the ones they inherit themselves.
+func (as *AppSetup) Inherit(role int, parents ...int) {
+	if as.RoleDefs == nil {
+		as.RoleDefs = make(map[int]RoleDef)
+	}
+	rd := as.RoleDefs[role]
+	rd.Inherits = append(rd.Inherits, parents...)
+	as.RoleDefs[role] = rd
+}
+
+// Permissions returns the set of permissions that a user with the given roles has. This includes inherited permissions and the
+// permissions of R_GUEST.
+func (as *AppSetup) Permissions(roles []int) (perms map[string]bool) {
+	perms = make(map[string]bool)
+	seen := make(map[int]bool)
+	pending := append([]int{R_GUEST}, roles...)
+	for len(pending) > 0 {
+		role := pending[0]
+		pending = pending[1:]
+		if seen[role] {
+			continue
+		}
+		seen[role] = true
+		rd := as.RoleDefs[role]
+		for _, p := range rd.Permissions {
+			perms[p] = true
+		}
+		pending = append(pending, rd.Inherits...)
+	}
+	return
+}
+
+// UserRoles returns the roles that u holds. A nil User is a guest, and only has R_GUEST.
+func UserRoles(u User) (roles []int) {
+	switch mu := u.(type) {
+	case nil:
+		roles = []int{R_GUEST}
+	case MultiRoleUser:
+		for _, role := range mu.GetRoles() {
+			roles = append(roles, int(role))
+		}
+	default:
+		roles = []int{int(u.GetRole())}
+	}
+	return
+}
+
+// Can reports whether u has the named permission through any of its roles. u can be nil, which means a guest. Handler functions
+// can use it for checks that depend on the request, i.e. a.Can(user, "posts.edit").
+func (a *AppScope) Can(u User, permission string) bool {
+	return a.Setup.Permissions(UserRoles(u))[permission]
+}
+
+// HasPermission reports whether the current user has the named permission. Pass it the view, so in a template it's used as
+// <% if can . "users.edit" %>, or <% if can $ "users.edit" %> inside a range.
+// Used by the template parser as "can"
+func HasPermission(view interface{}, permission string) bool {
+	if v, ok := view.(map[string]interface{}); ok {
+		if global, ok := v["global"].(map[string]interface{}); ok {
+			v = global
+		}
+		if perms, ok := v["permissions"].(map[string]bool); ok {
+			return perms[permission]
+		}
+	}
+	return false
+}
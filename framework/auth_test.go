@@ -0,0 +1,163 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"encoding/gob"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+const (
+	testMember = 2
+	testEditor = 3
+	testAdmin  = 4
+)
+
+// authTestUser is a User with any number of roles.
+type authTestUser struct {
+	Username string
+	Roles    []int64
+}
+
+func (u *authTestUser) TestPassword(password string, a *AppScope) bool { return password == "secret" }
+func (u *authTestUser) GetRole() int64                                 { return u.Roles[0] }
+func (u *authTestUser) GetRoles() []int64                              { return u.Roles }
+func (u *authTestUser) ClearPasswordHash()                             {}
+
+// singleRoleUser only implements User, not MultiRoleUser.
+type singleRoleUser struct {
+	Role int64
+}
+
+func (u *singleRoleUser) TestPassword(password string, a *AppScope) bool { return false }
+func (u *singleRoleUser) GetRole() int64                                 { return u.Role }
+func (u *singleRoleUser) ClearPasswordHash()                             {}
+
+func init() {
+	gob.Register(&authTestUser{})
+}
+
+func testRoleSetup() *AppSetup {
+	as := &AppSetup{Roles: &map[string]int{}}
+	as.Grant(R_GUEST, "site.view")
+	as.Grant(testMember, "posts.comment")
+	as.Grant(testEditor, "posts.edit")
+	as.Grant(testAdmin, "users.edit", "users.delete")
+	as.Inherit(testEditor, testMember)
+	as.Inherit(testAdmin, testEditor)
+	return as
+}
+
+func TestPermissions(t *testing.T) {
+	as := testRoleSetup()
+	a := &AppScope{Setup: as}
+
+	tests := []struct {
+		user     User
+		expected string
+	}{
+		{nil, "site.view"},
+		{&singleRoleUser{testMember}, "posts.comment site.view"},
+		{&singleRoleUser{testEditor}, "posts.comment posts.edit site.view"},
+		{&authTestUser{Roles: []int64{testMember, testAdmin}}, "posts.comment posts.edit site.view users.delete users.edit"},
+		{&singleRoleUser{99}, "site.view"},
+	}
+
+	check := func() {
+		for _, test := range tests {
+			var perms []string
+			for _, p := range []string{"posts.comment", "posts.edit", "site.view", "users.delete", "users.edit"} {
+				if a.Can(test.user, p) {
+					perms = append(perms, p)
+				}
+			}
+			if strings.Join(perms, " ") != test.expected {
+				t.Errorf("%+v: expected %q, got %q", test.user, test.expected, strings.Join(perms, " "))
+			}
+		}
+	}
+	check()
+
+	// Inheriting in a loop makes both roles the same, and mustn't hang.
+	as.Inherit(testEditor, testAdmin)
+	tests[2].expected = tests[3].expected
+	check()
+}
+
+func TestRoutePermissions(t *testing.T) {
+	basePath := standupApp(t, "auth", nil, map[string]string{
+		"index.html":      `<% if can . "users.edit" %>admin link<% else %>no link<% end %>`,
+		"users-edit.html": "editing",
+		"legacy.html":     "legacy",
+	})
+	defer os.RemoveAll(basePath)
+
+	app, err := NewApp(testRoleSetup(), basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loginAs *authTestUser
+	app.Route(RouteConfig{Pattern: "/login", Permission: "site.view",
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			rs.Session.Values["user"] = loginAs
+			h.Redirect = "/"
+			return
+		}})
+	app.Route(RouteConfig{Pattern: "/", Permission: "site.view", Handler: testHandler})
+	app.Route(RouteConfig{Pattern: "/users/edit", Permission: "users.edit", Handler: testHandler})
+	app.Route(RouteConfig{Pattern: "/legacy", Roles: []int{testEditor}, Handler: testHandler})
+
+	login := func(u *authTestUser) string {
+		loginAs = u
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost/login", nil)
+		app.ServeHTTP(w, r)
+		return w.Header().Get("Set-Cookie")
+	}
+	guest := ""
+	member := login(&authTestUser{"member", []int64{testMember}})
+	admin := login(&authTestUser{"admin", []int64{testMember, testAdmin}})
+	editor := login(&authTestUser{"editor", []int64{testEditor}})
+
+	tests := []struct {
+		cookie   string
+		path     string
+		status   int
+		body     string
+		location string
+	}{
+		{guest, "/", http.StatusOK, "no link", ""},
+		{member, "/", http.StatusOK, "no link", ""},
+		{admin, "/", http.StatusOK, "admin link", ""},
+		{guest, "/users/edit", http.StatusFound, "", "/login/dest/"},
+		{member, "/users/edit", http.StatusFound, "", "/denied"},
+		{admin, "/users/edit", http.StatusOK, "editing", ""},
+		{editor, "/legacy", http.StatusOK, "legacy", ""},
+		{admin, "/legacy", http.StatusFound, "", "/denied"},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost"+test.path, nil)
+		if test.cookie != "" {
+			r.Header.Set("Cookie", test.cookie)
+		}
+		app.ServeHTTP(w, r)
+		if w.Code != test.status {
+			t.Errorf("%v %v: expected %v, got %v", test.cookie == "", test.path, test.status, w.Code)
+			continue
+		}
+		if test.body != "" && w.Body.String() != test.body {
+			t.Errorf("%v: expected %q, got %q", test.path, test.body, w.Body.String())
+		}
+		if !strings.HasPrefix(w.Header().Get("Location"), test.location) {
+			t.Errorf("%v: expected redirect to %v, got %v", test.path, test.location, w.Header().Get("Location"))
+		}
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"compress/gzip"
+	"fmt"
+	"github.com/kylelemons/go-gypsy/yaml"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// The content types that are compressed when server.compression.types isn't set.
+var DEFAULT_COMPRESSION_TYPES = []string{"text/html", "text/plain", "text/css", "text/xml", "application/xml", "application/json",
+	"application/javascript", "image/svg+xml"}
+
+// A Compressor wraps w in a writer that compresses what's written to it, for one content coding. Closing it must flush everything
+// that's left, but not close w. To use brotli, put a Compressor for "br" in AppSetup.Compressors and list "br" in
+// server.compression.encodings.
+type Compressor func(w io.Writer) (io.WriteCloser, error)
+
+// compression holds the response compression settings of an App.
+type compression struct {
+	enabled bool
+	// Responses smaller than this many bytes are sent as they are.
+	minSize int
+	types   map[string]bool
+	// Content codings in order of preference, all of which have a Compressor.
+	encodings   []string
+	compressors map[string]Compressor
+}
+
+// newCompression reads the settings in the "server.compression" section of the config file. Compressors in extra are added to the
+// built in gzip one.
+func newCompression(c *yaml.File, extra map[string]Compressor) (cp *compression, err error) {
+	cp = &compression{types: make(map[string]bool), compressors: make(map[string]Compressor)}
+	if cp.enabled, err = configBool(c, "server.compression.enabled", true); err != nil {
+		return
+	}
+	if cp.minSize, err = configInt(c, "server.compression.minSize", 1024); err != nil {
+		return
+	}
+	level, err := configInt(c, "server.compression.level", gzip.DefaultCompression)
+	if err != nil {
+		return
+	}
+	if _, err = gzip.NewWriterLevel(nil, level); err != nil {
+		err = &SawsijError{fmt.Sprintf("Config value server.compression.level must be from %v to %v, not %v", gzip.HuffmanOnly,
+			gzip.BestCompression, level)}
+		return
+	}
+	cp.compressors["gzip"] = func(w io.Writer) (io.WriteCloser, error) {
+		return gzip.NewWriterLevel(w, level)
+	}
+	for coding, compressor := range extra {
+		cp.compressors[strings.ToLower(coding)] = compressor
+	}
+
+	types, err := configList(c, "server.compression.types")
+	if err != nil {
+		return
+	}
+	if len(types) == 0 {
+		types = DEFAULT_COMPRESSION_TYPES
+	}
+	for _, t := range types {
+		cp.types[strings.ToLower(t)] = true
+	}
+
+	encodings, err := configList(c, "server.compression.encodings")
+	if err != nil {
+		return
+	}
+	if len(encodings) == 0 {
+		encodings = []string{"br", "gzip"}
+	}
+	for _, coding := range encodings {
+		coding = strings.ToLower(coding)
+		if cp.compressors[coding] != nil {
+			cp.encodings = append(cp.encodings, coding)
+		}
+	}
+	return
+}
+
+// wrap returns a ResponseWriter that compresses what's written to it when the client accepts one of the configured encodings, and
+// the response turns out to be big enough and of a type that's allowed. It must be closed once the response is done.
+func (cp *compression) wrap(w http.ResponseWriter, r *http.Request) *compressWriter {
+	cw := &compressWriter{ResponseWriter: w, cp: cp}
+	for _, coding := range cp.encodings {
+		if acceptsEncoding(r.Header.Get("Accept-Encoding"), coding) {
+			cw.coding = coding
+			break
+		}
+	}
+	return cw
+}
+
+// A compressWriter holds back the start of a response until it knows whether to compress it. The headers and status are sent once
+// minSize bytes have been written, or when it's closed.
+type compressWriter struct {
+	http.ResponseWriter
+	cp *compression
+	// The content coding to use, or blank if the client didn't accept any of them.
+	coding string
+
+	status int
+	buf    []byte
+	// Set once the headers have been looked at to see if the response could be compressed.
+	checked bool
+	// Set once the headers and status have been sent.
+	decided bool
+	// Set once compression has started.
+	out io.WriteCloser
+}
+
+// WriteHeader holds on to the status until the first part of the body is written. Responses that can't have a body, or that are
+// only part of the content, are never compressed.
+func (cw *compressWriter) WriteHeader(status int) {
+	if cw.decided || cw.status != 0 {
+		return
+	}
+	cw.status = status
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusPartialContent ||
+		status == http.StatusNotModified {
+		cw.passThrough()
+	}
+}
+
+// Write buffers b until enough has been written to decide whether to compress, then sends it on.
+func (cw *compressWriter) Write(b []byte) (n int, err error) {
+	if cw.status == 0 {
+		cw.status = http.StatusOK
+	}
+	if !cw.decided {
+		if !cw.checked {
+			cw.checked = true
+			h := cw.Header()
+			if h.Get("Content-Type") == "" {
+				// What net/http would have done, so the type can be checked.
+				h.Set("Content-Type", http.DetectContentType(b))
+			}
+			if !cw.compressible() || cw.coding == "" {
+				cw.passThrough()
+				return cw.ResponseWriter.Write(b)
+			}
+		}
+		cw.buf = append(cw.buf, b...)
+		n = len(b)
+		if len(cw.buf) < cw.cp.minSize {
+			return
+		}
+		if err = cw.start(); err != nil {
+			return
+		}
+		_, err = cw.out.Write(cw.buf)
+		cw.buf = nil
+		return
+	}
+	if cw.out != nil {
+		return cw.out.Write(b)
+	}
+	return cw.ResponseWriter.Write(b)
+}
+
+// compressible reports whether the response could be compressed, going by its headers. When it could, the Vary header is set, even if
+// this client doesn't get it compressed, so that caches keep the versions apart.
+func (cw *compressWriter) compressible() bool {
+	h := cw.Header()
+	if h.Get("Content-Encoding") != "" || h.Get("Content-Range") != "" {
+		return false
+	}
+	ctype, _, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil || !cw.cp.types[ctype] {
+		return false
+	}
+	h.Add("Vary", "Accept-Encoding")
+	return true
+}
+
+// passThrough sends the headers and status, after which everything is written uncompressed.
+func (cw *compressWriter) passThrough() {
+	cw.decided = true
+	cw.ResponseWriter.WriteHeader(cw.status)
+}
+
+// start sends the headers and status for a compressed response, and sets up the compressor.
+func (cw *compressWriter) start() (err error) {
+	cw.decided = true
+	h := cw.Header()
+	h.Set("Content-Encoding", cw.coding)
+	// The length and ranges are of the uncompressed content.
+	h.Del("Content-Length")
+	h.Del("Accept-Ranges")
+	cw.ResponseWriter.WriteHeader(cw.status)
+	cw.out, err = cw.cp.compressors[cw.coding](cw.ResponseWriter)
+	return
+}
+
+// Flush sends what's been written so far, for handlers and middleware that stream. If it hasn't been decided whether to compress
+// the response, it's decided now, even if fewer than minSize bytes have been written.
+func (cw *compressWriter) Flush() {
+	if !cw.decided && cw.status != 0 {
+		if !cw.checked {
+			// Only the status has been written, so the type can't be sniffed from the body.
+			cw.checked = true
+			if !cw.compressible() || cw.coding == "" {
+				cw.passThrough()
+			}
+		}
+		if !cw.decided && cw.start() == nil {
+			cw.out.Write(cw.buf)
+			cw.buf = nil
+		}
+	}
+	if f, ok := cw.out.(interface {
+		Flush() error
+	}); ok {
+		f.Flush()
+	}
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Close finishes the response, sending anything that was held back.
+func (cw *compressWriter) Close() (err error) {
+	if !cw.decided {
+		if cw.status == 0 {
+			// Nothing was written at all, so let net/http do what it normally would.
+			return
+		}
+		cw.passThrough()
+		_, err = cw.ResponseWriter.Write(cw.buf)
+		return
+	}
+	if cw.out != nil {
+		err = cw.out.Close()
+	}
+	return
+}
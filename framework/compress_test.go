@@ -0,0 +1,136 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// upperCompressor is a stand in for brotli that upper cases everything.
+func upperCompressor(w io.Writer) (io.WriteCloser, error) {
+	return &upperWriter{w}, nil
+}
+
+type upperWriter struct {
+	w io.Writer
+}
+
+func (uw *upperWriter) Write(b []byte) (int, error) {
+	return uw.w.Write(bytes.ToUpper(b))
+}
+
+func (uw *upperWriter) Close() error {
+	return nil
+}
+
+func TestCompression(t *testing.T) {
+	config := []string{"compression:", "  minSize: 100", "  types: text/html, application/json"}
+	basePath := standupApp(t, "compress", config, map[string]string{"page.html": "<p><% .text %></p>"})
+	defer os.RemoveAll(basePath)
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}, Compressors: map[string]Compressor{"br": upperCompressor}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	long := strings.Repeat("compress me ", 50)
+	text := func(s string) HandlerFunc {
+		return func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			h.Init()
+			h.View["text"] = s
+			return
+		}
+	}
+	app.Route(RouteConfig{Pattern: "/page", Handler: text(long), Roles: []int{R_GUEST}, TemplateFilename: "page.html"})
+	app.Route(RouteConfig{Pattern: "/short", Handler: text("hi"), Roles: []int{R_GUEST}, TemplateFilename: "page.html"})
+	app.Route(RouteConfig{Pattern: "/api", Handler: text(long), Roles: []int{R_GUEST}, ReturnType: RT_JSON})
+	app.Route(RouteConfig{Pattern: "/raw", Roles: []int{R_GUEST}, ReturnType: RT_RAW,
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			h.Content = strings.NewReader(long)
+			h.Modtime = time.Now()
+			return
+		}})
+
+	get := func(path string, accept string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost"+path, nil)
+		r.Header.Set("Accept-Encoding", accept)
+		app.ServeHTTP(w, r)
+		return w
+	}
+
+	w := get("/page", "gzip")
+	if w.Header().Get("Content-Encoding") != "gzip" || w.Header().Get("Vary") != "Accept-Encoding" {
+		t.Fatalf("Expected a gzipped page, got %v", w.Header())
+	}
+	gz, err := gzip.NewReader(w.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(gz)
+	if err != nil || string(body) != "<p>"+long+"</p>" {
+		t.Errorf("Expected the page once gunzipped, got %q %v", body, err)
+	}
+
+	w = get("/api", "gzip, br")
+	if w.Header().Get("Content-Encoding") != "br" || !strings.Contains(w.Body.String(), "COMPRESS ME") {
+		t.Errorf("Expected the br compressor to be preferred, got %v %q", w.Header(), w.Body.String())
+	}
+
+	uncompressed := []struct {
+		path   string
+		accept string
+		body   string
+	}{
+		{"/page", "identity", "<p>" + long + "</p>"},
+		{"/short", "gzip", "<p>hi</p>"},
+		{"/raw", "gzip", long},
+	}
+	for _, test := range uncompressed {
+		w = get(test.path, test.accept)
+		if w.Header().Get("Content-Encoding") != "" || w.Body.String() != test.body {
+			t.Errorf("%v: expected an uncompressed %q, got %v %q", test.path, test.body, w.Header(), w.Body.String())
+		}
+	}
+	if w.Header().Get("Vary") != "" {
+		t.Errorf("Expected no Vary header for a type that's never compressed, got %q", w.Header().Get("Vary"))
+	}
+	if w = get("/short", "gzip"); w.Header().Get("Vary") != "Accept-Encoding" {
+		t.Errorf("Expected a Vary header on a short page, got %v", w.Header())
+	}
+
+	// A streamed response is sent, compressed, as soon as it's flushed.
+	w = httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "http://localhost/stream", nil)
+	r.Header.Set("Accept-Encoding", "gzip")
+	cw := app.compression.wrap(w, r)
+	cw.Header().Set("Content-Type", "text/html")
+	io.WriteString(cw, "first")
+	f, ok := interface{}(cw).(http.Flusher)
+	if !ok {
+		t.Fatal("Expected the compressing writer to be an http.Flusher")
+	}
+	f.Flush()
+	if !w.Flushed || w.Header().Get("Content-Encoding") != "gzip" {
+		t.Fatalf("Expected a flushed, gzipped response, got %v %v", w.Flushed, w.Header())
+	}
+	gz, err = gzip.NewReader(bytes.NewReader(w.Body.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := make([]byte, 5)
+	if _, err = io.ReadFull(gz, first); err != nil || string(first) != "first" {
+		t.Errorf("Expected the flushed part of the body, got %q %v", first, err)
+	}
+	cw.Close()
+}
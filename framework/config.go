@@ -0,0 +1,117 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"fmt"
+	"github.com/kylelemons/go-gypsy/yaml"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// configValue gets key from the config file. If the key isn't there, found will be false and err will be nil.
+func configValue(c *yaml.File, key string) (value string, found bool, err error) {
+	value, err = c.Get(key)
+	if err != nil {
+		if _, ok := err.(*yaml.NodeNotFound); ok {
+			err = nil
+		}
+		return
+	}
+	found = true
+	return
+}
+
+// configString returns the value of key in the config file, or def if it isn't set.
+func configString(c *yaml.File, key string, def string) (value string, err error) {
+	value, found, err := configValue(c, key)
+	if !found {
+		value = def
+	}
+	return
+}
+
+// configInt returns the value of key in the config file as an int, or def if it isn't set.
+func configInt(c *yaml.File, key string, def int) (value int, err error) {
+	s, found, err := configValue(c, key)
+	if !found {
+		value = def
+		return
+	}
+	value, err = strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		err = &SawsijError{fmt.Sprintf("Config value %v must be a whole number, not %q", key, s)}
+	}
+	return
+}
+
+// configBool returns the value of key in the config file as a bool, or def if it isn't set.
+func configBool(c *yaml.File, key string, def bool) (value bool, err error) {
+	s, found, err := configValue(c, key)
+	if !found {
+		value = def
+		return
+	}
+	value, err = strconv.ParseBool(strings.TrimSpace(s))
+	if err != nil {
+		err = &SawsijError{fmt.Sprintf("Config value %v must be true or false, not %q", key, s)}
+	}
+	return
+}
+
+// configDuration returns the value of key in the config file as a time.Duration, or def if it isn't set. Values can be anything
+// time.ParseDuration understands, like "30s" or "1h30m". A plain number is taken to be a number of seconds.
+func configDuration(c *yaml.File, key string, def time.Duration) (value time.Duration, err error) {
+	s, found, err := configValue(c, key)
+	if !found {
+		value = def
+		return
+	}
+	s = strings.TrimSpace(s)
+	if secs, perr := strconv.Atoi(s); perr == nil {
+		value = time.Duration(secs) * time.Second
+		return
+	}
+	value, err = time.ParseDuration(s)
+	if err != nil {
+		err = &SawsijError{fmt.Sprintf("Config value %v must be a duration like \"30s\", not %q", key, s)}
+	}
+	return
+}
+
+// configList returns the value of key in the config file as a list of strings. The value can either be a yaml list or a single
+// comma separated string. If the key isn't set, the list will be empty.
+func configList(c *yaml.File, key string) (values []string, err error) {
+	node, err := yaml.Child(c.Root, key)
+	if err != nil {
+		if _, ok := err.(*yaml.NodeNotFound); ok {
+			err = nil
+		}
+		return
+	}
+
+	switch n := node.(type) {
+	case yaml.List:
+		for i := 0; i < n.Len(); i++ {
+			if s, ok := n.Item(i).(yaml.Scalar); ok {
+				values = append(values, strings.TrimSpace(s.String()))
+			} else {
+				err = &SawsijError{fmt.Sprintf("Config value %v must be a list of strings", key)}
+				return
+			}
+		}
+	case yaml.Scalar:
+		for _, s := range strings.Split(n.String(), ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				values = append(values, s)
+			}
+		}
+	case nil:
+	default:
+		err = &SawsijError{fmt.Sprintf("Config value %v must be a list of strings", key)}
+	}
+	return
+}
@@ -0,0 +1,55 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"github.com/kylelemons/go-gypsy/yaml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfigValues(t *testing.T) {
+	c := yaml.Config(`
+server:
+  readTimeout: 10s
+  writeTimeout: 45
+  maxHeaderBytes: 4096
+  secure: true
+  bad: soon
+  proxies:
+    - 10.0.0.1
+    - 10.0.0.2
+  types: text/html, application/json
+`)
+
+	if d, err := configDuration(c, "server.readTimeout", time.Second); err != nil || d != 10*time.Second {
+		t.Errorf("Expected 10s, got %v (%v)", d, err)
+	}
+	if d, err := configDuration(c, "server.writeTimeout", time.Second); err != nil || d != 45*time.Second {
+		t.Errorf("Expected plain numbers to be seconds, got %v (%v)", d, err)
+	}
+	if d, err := configDuration(c, "server.idleTimeout", time.Minute); err != nil || d != time.Minute {
+		t.Errorf("Expected default for missing key, got %v (%v)", d, err)
+	}
+	if _, err := configDuration(c, "server.bad", time.Minute); err == nil {
+		t.Error("Expected an error for an invalid duration")
+	}
+	if i, err := configInt(c, "server.maxHeaderBytes", 0); err != nil || i != 4096 {
+		t.Errorf("Expected 4096, got %v (%v)", i, err)
+	}
+	if b, err := configBool(c, "server.secure", false); err != nil || !b {
+		t.Errorf("Expected true, got %v (%v)", b, err)
+	}
+	if l, err := configList(c, "server.proxies"); err != nil || strings.Join(l, ",") != "10.0.0.1,10.0.0.2" {
+		t.Errorf("Expected yaml list, got %v (%v)", l, err)
+	}
+	if l, err := configList(c, "server.types"); err != nil || strings.Join(l, ",") != "text/html,application/json" {
+		t.Errorf("Expected comma separated list, got %v (%v)", l, err)
+	}
+	if l, err := configList(c, "server.missing"); err != nil || len(l) != 0 {
+		t.Errorf("Expected empty list, got %v (%v)", l, err)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"github.com/gorilla/sessions"
+	"html/template"
+	"net/http"
+)
+
+const (
+	// The name of the form field the CSRF token is sent in.
+	CSRF_FIELD = "csrf_token"
+	// The request header the CSRF token can be sent in instead, for requests made from JavaScript.
+	CSRF_HEADER = "X-CSRF-Token"
+	// The session value the token is kept in.
+	csrfSessionKey = "csrfToken"
+	// The length of a token in bytes.
+	csrfTokenLength = 32
+)
+
+// Methods that don't change anything, so don't need a CSRF token.
+var csrfSafeMethods = map[string]bool{"GET": true, "HEAD": true, "OPTIONS": true, "TRACE": true}
+
+// csrfToken returns the CSRF token for a session, creating one if the session doesn't have one yet. Every form rendered for the
+// session uses the same token, so several tabs can be open at once.
+func csrfToken(session *sessions.Session) (token []byte, err error) {
+	if s, ok := session.Values[csrfSessionKey].(string); ok {
+		token, err = base64.StdEncoding.DecodeString(s)
+		if err == nil && len(token) == csrfTokenLength {
+			return
+		}
+	}
+	token = make([]byte, csrfTokenLength)
+	if _, err = rand.Read(token); err != nil {
+		return
+	}
+	session.Values[csrfSessionKey] = base64.StdEncoding.EncodeToString(token)
+	return
+}
+
+// maskCsrfToken returns the token XORed with a random pad, with the pad in front. The result is different every time it's rendered,
+// which stops the token from being guessed from compressed responses (the BREACH attack).
+func maskCsrfToken(token []byte) (masked string, err error) {
+	pad := make([]byte, len(token))
+	if _, err = rand.Read(pad); err != nil {
+		return
+	}
+	b := make([]byte, len(token)*2)
+	copy(b, pad)
+	for i := range token {
+		b[len(token)+i] = pad[i] ^ token[i]
+	}
+	masked = base64.URLEncoding.EncodeToString(b)
+	return
+}
+
+// unmaskCsrfToken reverses maskCsrfToken. It returns nil if masked isn't a token.
+func unmaskCsrfToken(masked string) (token []byte) {
+	b, err := base64.URLEncoding.DecodeString(masked)
+	if err != nil || len(b) != csrfTokenLength*2 {
+		return
+	}
+	token = make([]byte, csrfTokenLength)
+	for i := range token {
+		token[i] = b[i] ^ b[csrfTokenLength+i]
+	}
+	return
+}
+
+// validCsrf checks that the request carries the session's token, either in the CSRF_FIELD form field or the CSRF_HEADER header.
+// Requests with safe methods are always valid.
+func validCsrf(r *http.Request, token []byte) bool {
+	if csrfSafeMethods[r.Method] {
+		return true
+	}
+	sent := r.Header.Get(CSRF_HEADER)
+	if sent == "" {
+		sent = r.FormValue(CSRF_FIELD)
+	}
+	got := unmaskCsrfToken(sent)
+	return got != nil && subtle.ConstantTimeCompare(got, token) == 1
+}
+
+// CsrfField returns a hidden form field containing the CSRF token, which must be in every form that POSTs to a route that
+// isn't CsrfExempt. Pass it the view, so in a template it's used as <% csrfField . %>, or <% csrfField $ %> inside a range.
+// Used by the template parser as "csrfField"
+func CsrfField(view interface{}) template.HTML {
+	token := ""
+	if v, ok := view.(map[string]interface{}); ok {
+		if global, ok := v["global"].(map[string]interface{}); ok {
+			v = global
+		}
+		token, _ = v[csrfSessionKey].(string)
+	}
+	return template.HTML(fmt.Sprintf(`<input type="hidden" name="%v" value="%v">`, CSRF_FIELD, template.HTMLEscapeString(token)))
+}
@@ -0,0 +1,95 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestCsrfTokenMasking(t *testing.T) {
+	token := bytes.Repeat([]byte{7}, csrfTokenLength)
+	first, err := maskCsrfToken(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _ := maskCsrfToken(token)
+	if first == second {
+		t.Error("Expected masked tokens to differ")
+	}
+	for _, masked := range []string{first, second} {
+		if !bytes.Equal(unmaskCsrfToken(masked), token) {
+			t.Errorf("%v did not unmask to the token", masked)
+		}
+	}
+	if unmaskCsrfToken("not a token") != nil {
+		t.Error("Expected garbage not to unmask")
+	}
+}
+
+func TestCsrfProtection(t *testing.T) {
+	basePath := standupApp(t, "csrf", nil, map[string]string{"form.html": "<form><% csrfField . %></form>", "error.html": "<% .status %>"})
+	defer os.RemoveAll(basePath)
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app.Route(RouteConfig{Pattern: "/form", Roles: []int{R_GUEST}, Handler: testHandler})
+	app.Route(RouteConfig{Pattern: "/save", Methods: []string{"POST"}, Roles: []int{R_GUEST}, ReturnType: RT_JSON, Handler: testHandler})
+	app.Route(RouteConfig{Pattern: "/api/save", Methods: []string{"POST"}, Roles: []int{R_GUEST}, ReturnType: RT_JSON, CsrfExempt: true,
+		Handler: testHandler})
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "http://localhost/form", nil)
+	app.ServeHTTP(w, r)
+	m := regexp.MustCompile(`name="` + CSRF_FIELD + `" value="([^"]+)"`).FindStringSubmatch(w.Body.String())
+	if m == nil {
+		t.Fatalf("Expected a token field, got %q", w.Body.String())
+	}
+	token := m[1]
+	cookie := w.Header().Get("Set-Cookie")
+
+	post := func(path string, form url.Values, header string, withCookie bool) int {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("POST", "http://localhost"+path, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if header != "" {
+			r.Header.Set(CSRF_HEADER, header)
+		}
+		if withCookie {
+			r.Header.Set("Cookie", cookie)
+		}
+		app.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		form       url.Values
+		header     string
+		withCookie bool
+		status     int
+	}{
+		{"no token", "/save", url.Values{}, "", true, http.StatusForbidden},
+		{"wrong token", "/save", url.Values{CSRF_FIELD: {"bogus"}}, "", true, http.StatusForbidden},
+		{"other session", "/save", url.Values{CSRF_FIELD: {token}}, "", false, http.StatusForbidden},
+		{"form field", "/save", url.Values{CSRF_FIELD: {token}}, "", true, http.StatusOK},
+		{"header", "/save", url.Values{}, token, true, http.StatusOK},
+		{"exempt route", "/api/save", url.Values{}, "", false, http.StatusOK},
+	}
+	for _, test := range tests {
+		if status := post(test.path, test.form, test.header, test.withCookie); status != test.status {
+			t.Errorf("%v: expected %v, got %v", test.name, test.status, status)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bufio"
+	"fmt"
+	"html/template"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// How many lines either side of the failing line of a template are shown on the development error page.
+const DEV_TEMPLATE_CONTEXT = 3
+
+// A panicError is a panic that was recovered while handling a request, along with the stack of the goroutine that panicked.
+type panicError struct {
+	value interface{}
+	stack []byte
+}
+
+// Error returns the value the code panicked with.
+func (e *panicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.value)
+}
+
+// Matches the template name and line number at the start of errors from html/template, i.e. "template: index.html:12:3: ..."
+var templateErrorPosition = regexp.MustCompile(`template: ([^:\s]+):(\d+)`)
+
+// A templateLine is one line of a template shown on the development error page.
+type templateLine struct {
+	Number  int
+	Text    string
+	Failing bool
+}
+
+// A templateExcerpt is the part of a template around the line that caused an error.
+type templateExcerpt struct {
+	Name  string
+	Line  int
+	Lines []templateLine
+}
+
+// excerptTemplate finds the template and line an error refers to and reads the lines around it from the templates folder. It
+// returns nil if the error doesn't refer to a template line, or the template can't be read.
+func (app *App) excerptTemplate(err error) (ex *templateExcerpt) {
+	m := templateErrorPosition.FindStringSubmatch(err.Error())
+	if m == nil {
+		return
+	}
+	line, _ := strconv.Atoi(m[2])
+	// Templates in subfolders are named with their path, which mustn't lead out of the templates folder.
+	name := cleanName(m[1])
+	if name == "" {
+		return
+	}
+	f, ferr := os.Open(filepath.Join(app.BasePath, "templates", filepath.FromSlash(name)))
+	if ferr != nil {
+		return
+	}
+	defer f.Close()
+
+	ex = &templateExcerpt{Name: m[1], Line: line}
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		if n >= line-DEV_TEMPLATE_CONTEXT && n <= line+DEV_TEMPLATE_CONTEXT {
+			ex.Lines = append(ex.Lines, templateLine{Number: n, Text: scanner.Text(), Failing: n == line})
+		}
+	}
+	return
+}
+
+// dumpValues turns a map into sorted "key: value" strings for display.
+func dumpValues(m map[string]interface{}) (lines []string) {
+	for key, value := range m {
+		lines = append(lines, fmt.Sprintf("%v: %+v", key, value))
+	}
+	sort.Strings(lines)
+	return
+}
+
+// The page shown instead of error.html when server.devMode is true. It uses the standard delimiters and doesn't depend on any of the
+// application's templates, so it still works when those are broken.
+var devErrorTemplate = template.Must(template.New("deverror").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Status}} {{.Error}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+pre { background: #f5f5f5; padding: 1em; overflow: auto; }
+.failing { background: #f2dede; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>{{.Status}} {{.StatusText}}</h1>
+<pre>{{.Error}}</pre>
+{{with .Template}}<h2>Template {{.Name}}, line {{.Line}}</h2>
+<pre>{{range .Lines}}<span{{if .Failing}} class="failing"{{end}}>{{printf "%4d" .Number}}  {{.Text}}</span>
+{{end}}</pre>
+{{end}}{{if .Stack}}<h2>Stack trace</h2>
+<pre>{{.Stack}}</pre>
+{{end}}<h2>Request</h2>
+<pre>{{.Method}} {{.Url}} {{.Proto}}
+Remote address: {{.RemoteAddr}}
+{{range .Header}}{{.}}
+{{end}}</pre>
+{{if .Form}}<h2>Form values</h2>
+<pre>{{range .Form}}{{.}}
+{{end}}</pre>
+{{end}}<h2>Session</h2>
+<pre>{{range .Session}}{{.}}
+{{else}}(empty)
+{{end}}</pre>
+<p>This page is shown because server.devMode is set in config.yaml. Turn it off in production.</p>
+</body>
+</html>
+`))
+
+// renderDevError writes a page describing err, with the stack trace if it was a panic, the failing template line if a template was
+// to blame, and a dump of the request and session. It's only used when server.devMode is true.
+func (app *App) renderDevError(w http.ResponseWriter, r *http.Request, status int, err error) {
+	page := map[string]interface{}{
+		"Status":     status,
+		"StatusText": http.StatusText(status),
+		"Error":      err.Error(),
+		"Template":   app.excerptTemplate(err),
+		"Method":     r.Method,
+		"Url":        r.URL.RequestURI(),
+		"Proto":      r.Proto,
+		"RemoteAddr": r.RemoteAddr,
+	}
+	if pe, ok := err.(*panicError); ok {
+		page["Stack"] = string(pe.stack)
+	}
+
+	header := make(map[string]interface{})
+	for key, values := range r.Header {
+		header[key] = values
+	}
+	page["Header"] = dumpValues(header)
+
+	form := make(map[string]interface{})
+	for key, values := range r.Form {
+		form[key] = values
+	}
+	page["Form"] = dumpValues(form)
+
+	values := make(map[string]interface{})
+	if session, serr := app.store.Get(r, app.sessionCookie.name); serr == nil {
+		for key, value := range session.Values {
+			values[fmt.Sprint(key)] = value
+		}
+	}
+	page["Session"] = dumpValues(values)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := devErrorTemplate.Execute(w, page); err != nil {
+		app.Log.Error("Rendering the development error page failed", "error", err)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+// routePanics sets up routes that panic in the handler and in the template.
+func routePanics(app *App) {
+	app.Route(RouteConfig{Pattern: "/panic", Roles: []int{R_GUEST},
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			rs.Session.Values["visits"] = 3
+			panic("handler exploded")
+		}})
+	app.Route(RouteConfig{Pattern: "/api/panic", Roles: []int{R_GUEST}, ReturnType: RT_JSON,
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			panic("handler exploded")
+		}})
+	app.Route(RouteConfig{Pattern: "/broken", Roles: []int{R_GUEST},
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			h.Init()
+			h.View["a"] = 1
+			h.View["b"] = 2
+			return
+		}})
+}
+
+var panicTemplates = map[string]string{
+	"error.html":  "<% .status %> <% .message %>",
+	"broken.html": "<p>first</p>\n<p><% index .a 5 %></p>\n<p>last</p>",
+}
+
+// getPath sends a GET request for path, with a query string, to app and returns the recorded response.
+func getPath(app *App, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "http://localhost"+path+"?q=search", nil)
+	app.ServeHTTP(w, r)
+	return w
+}
+
+func TestPanicRecovery(t *testing.T) {
+	basePath := standupApp(t, "panic", nil, panicTemplates)
+	defer os.RemoveAll(basePath)
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	routePanics(app)
+
+	for _, path := range []string{"/panic", "/broken"} {
+		w := getPath(app, path)
+		if w.Code != http.StatusInternalServerError || w.Body.String() != "500 "+DEFAULT_ERROR_MESSAGE {
+			t.Errorf("%v: expected error.html with a 500, got %v %q", path, w.Code, w.Body.String())
+		}
+	}
+
+	w := getPath(app, "/api/panic")
+	if w.Code != http.StatusInternalServerError || strings.Contains(w.Body.String(), "exploded") {
+		t.Errorf("Expected a 500 without details, got %v %q", w.Code, w.Body.String())
+	}
+}
+
+func TestDevModeErrorPage(t *testing.T) {
+	basePath := standupApp(t, "devmode", []string{"devMode: true"}, panicTemplates)
+	defer os.RemoveAll(basePath)
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	routePanics(app)
+
+	w := getPath(app, "/panic")
+	body := w.Body.String()
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("Expected a 500, got %v", w.Code)
+	}
+	for _, expected := range []string{"panic: handler exploded", "devmode_test.go", "GET /panic?q=search", "visits: 3"} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("Expected dev page to contain %q, got %q", expected, body)
+		}
+	}
+
+	w = getPath(app, "/broken")
+	body = w.Body.String()
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("Expected a 500, got %v", w.Code)
+	}
+	for _, expected := range []string{"Template broken.html, line 2", `<span class="failing">   2  &lt;p&gt;&lt;% index .a 5 %&gt;&lt;/p&gt;</span>`, "   3  &lt;p&gt;last"} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("Expected dev page to contain %q, got %q", expected, body)
+		}
+	}
+
+	w = getPath(app, "/api/panic")
+	if !strings.Contains(w.Body.String(), `"detail":"panic: handler exploded"`) {
+		t.Errorf("Expected JSON error detail, got %q", w.Body.String())
+	}
+}
+
+func TestDevModeSubfolderTemplate(t *testing.T) {
+	basePath := standupApp(t, "devmodesub", []string{"devMode: true"}, panicTemplates)
+	defer os.RemoveAll(basePath)
+	if err := os.MkdirAll(basePath+"/templates/admin", 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteStringToFile("<h1>admin</h1>\n<p><% index .a 5 %></p>", basePath+"/templates/admin/broken.html"); err != nil {
+		t.Fatal(err)
+	}
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	routePanics(app)
+	app.Route(RouteConfig{Pattern: "/admin/broken", Roles: []int{R_GUEST}, TemplateFilename: "admin/broken.html",
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			h.Init()
+			h.View["a"] = 1
+			return
+		}})
+
+	body := getPath(app, "/admin/broken").Body.String()
+	for _, expected := range []string{"Template admin/broken.html, line 2", "   1  &lt;h1&gt;admin"} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("Expected dev page to contain %q, got %q", expected, body)
+		}
+	}
+	if strings.Contains(body, "&lt;p&gt;first") {
+		t.Error("Expected the excerpt to come from the template in the subfolder, not the one at the top")
+	}
+}
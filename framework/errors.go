@@ -0,0 +1,170 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bitbucket.org/jaybill/sawsij/framework/logging"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+)
+
+// The message sent to the client when a handler returns an error that isn't an *HttpError. The error itself is only written to the log.
+const DEFAULT_ERROR_MESSAGE = "An error occured. See log for details."
+
+// The template used to render errors for HTML routes. It gets "status", "message", "fields" and "global" in its view.
+const ERROR_TEMPLATE = "error.html"
+
+// An HttpError is an error that carries the HTTP status code the response should have. Return one from a handler function to
+// send something other than a 500, i.e. return h, framework.NotFound("There is no post with that id").
+type HttpError struct {
+	// The HTTP status code of the response, i.e. http.StatusNotFound
+	Status int
+	// A message that is safe to show to the user. If it's blank, the standard text for the status code is used.
+	Message string
+	// Messages about individual form fields, keyed by field name. Only used by Validation errors.
+	Fields map[string]string
+}
+
+// Error returns the status code and message.
+func (e *HttpError) Error() string {
+	return fmt.Sprintf("%d %v", e.Status, e.message())
+}
+
+// message returns the message of the error, or the standard text for the status if there isn't one.
+func (e *HttpError) message() string {
+	if e.Message == "" {
+		return http.StatusText(e.Status)
+	}
+	return e.Message
+}
+
+// NewHttpError returns an error that will send the given status code and message.
+func NewHttpError(status int, message string) *HttpError {
+	return &HttpError{Status: status, Message: message}
+}
+
+// BadRequest returns an error that sends a 400 response.
+func BadRequest(message string) *HttpError {
+	return NewHttpError(http.StatusBadRequest, message)
+}
+
+// Forbidden returns an error that sends a 403 response.
+func Forbidden(message string) *HttpError {
+	return NewHttpError(http.StatusForbidden, message)
+}
+
+// NotFound returns an error that sends a 404 response.
+func NotFound(message string) *HttpError {
+	return NewHttpError(http.StatusNotFound, message)
+}
+
+// Conflict returns an error that sends a 409 response.
+func Conflict(message string) *HttpError {
+	return NewHttpError(http.StatusConflict, message)
+}
+
+// TooManyRequests returns an error that sends a 429 response.
+func TooManyRequests(message string) *HttpError {
+	return NewHttpError(http.StatusTooManyRequests, message)
+}
+
+// Validation returns an error that sends a 422 response. fields maps the names of the form fields that didn't validate to
+// a message about each of them, and can be nil.
+func Validation(message string, fields map[string]string) *HttpError {
+	return &HttpError{Status: http.StatusUnprocessableEntity, Message: message, Fields: fields}
+}
+
+// errorResponse is the body sent for errors on RT_JSON and RT_XML routes.
+type errorResponse struct {
+	Status  int               `json:"status"`
+	Message string            `json:"message"`
+	Fields  map[string]string `json:"fields,omitempty"`
+	Detail  string            `json:"detail,omitempty"`
+}
+
+// toHttpError turns any error returned by a handler into an *HttpError. Errors that aren't already one become a 500 with a
+// generic message, and are logged to l since the client never sees them.
+func toHttpError(err error, l logging.Logger) (he *HttpError) {
+	he, ok := err.(*HttpError)
+	if !ok {
+		if pe, isPanic := err.(*panicError); isPanic {
+			l.Error("Recovered from a panic", "error", pe, "stack", string(pe.stack))
+		} else {
+			l.Error("Request failed", "error", err)
+		}
+		he = NewHttpError(http.StatusInternalServerError, DEFAULT_ERROR_MESSAGE)
+	} else if he.Status >= http.StatusInternalServerError {
+		l.Error("Request failed", "error", err)
+	}
+	return
+}
+
+// renderError sends err to the client in the form that suits returnType. HTML routes use the error.html template, falling back
+// to plain text if it can't be rendered. JSON and XML routes get an "error" object with the status, message and any fields.
+// In development mode, server errors on HTML routes get a page with the details instead, and JSON routes get the error text in "detail".
+func (app *App) renderError(w http.ResponseWriter, r *http.Request, returnType int, err error, global map[string]interface{}) {
+	he := toHttpError(err, app.Log)
+	body := errorResponse{Status: he.Status, Message: he.message(), Fields: he.Fields}
+	isServerError := he.Status >= http.StatusInternalServerError
+	if app.devMode && isServerError {
+		body.Detail = err.Error()
+	}
+
+	switch returnType {
+	case RT_JSON:
+		b, err := json.Marshal(map[string]errorResponse{"error": body})
+		if err != nil {
+			app.Log.Error("Encoding JSON error failed", "error", err)
+			http.Error(w, body.Message, body.Status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(body.Status)
+		w.Write(b)
+	case RT_XML:
+		v := map[string]interface{}{"status": body.Status, "message": body.Message}
+		if len(body.Fields) > 0 {
+			v["fields"] = body.Fields
+		}
+		if body.Detail != "" {
+			v["detail"] = body.Detail
+		}
+		b, err := marshalXml(v, "error")
+		if err != nil {
+			app.Log.Error("Encoding XML error failed", "error", err)
+			http.Error(w, body.Message, body.Status)
+			return
+		}
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(body.Status)
+		fmt.Fprintf(w, "%s%s", xml.Header, b)
+	case RT_RAW:
+		http.Error(w, body.Message, body.Status)
+	default:
+		if app.devMode && isServerError {
+			app.renderDevError(w, r, body.Status, err)
+			return
+		}
+		view := map[string]interface{}{"status": body.Status, "message": body.Message, "fields": body.Fields, "global": global}
+		var buf bytes.Buffer
+		// The last good set of templates is used even if they've since failed to parse, since the parse error may be what's being shown.
+		tmpl, name, _ := app.templates.lookup(ERROR_TEMPLATE, "")
+		if tmpl == nil || tmpl.Lookup(name) == nil {
+			http.Error(w, body.Message, body.Status)
+			return
+		}
+		if err := tmpl.ExecuteTemplate(&buf, name, view); err != nil {
+			app.Log.Error("Template execution failed", "template", name, "error", err)
+			http.Error(w, body.Message, body.Status)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(body.Status)
+		buf.WriteTo(w)
+	}
+}
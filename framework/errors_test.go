@@ -0,0 +1,77 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestHandlerErrors(t *testing.T) {
+	templates := map[string]string{
+		"error.html":   "<% .status %> <% .message %><% range $f, $m := .fields %> <% $f %>=<% $m %><% end %>",
+		"created.html": "made",
+	}
+	basePath := standupApp(t, "errors", nil, templates)
+	defer os.RemoveAll(basePath)
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	failWith := func(e error) func(*http.Request, *AppScope, *RequestScope) (HandlerResponse, error) {
+		return func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			return h, e
+		}
+	}
+	invalid := Validation("Please fix the form", map[string]string{"email": "is required"})
+
+	app.Route(RouteConfig{Pattern: "/missing", Roles: []int{R_GUEST}, Handler: failWith(NotFound("No such post"))})
+	app.Route(RouteConfig{Pattern: "/broken", Roles: []int{R_GUEST}, Handler: failWith(errors.New("database is on fire"))})
+	app.Route(RouteConfig{Pattern: "/invalid", Roles: []int{R_GUEST}, Handler: failWith(invalid)})
+	app.Route(RouteConfig{Pattern: "/api/invalid", Roles: []int{R_GUEST}, ReturnType: RT_JSON, Handler: failWith(invalid)})
+	app.Route(RouteConfig{Pattern: "/api/conflict", Roles: []int{R_GUEST}, ReturnType: RT_XML, Handler: failWith(Conflict(""))})
+	app.Route(RouteConfig{Pattern: "/created", Roles: []int{R_GUEST},
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			h.Status = http.StatusCreated
+			return
+		}})
+	app.Route(RouteConfig{Pattern: "/moved", Roles: []int{R_GUEST},
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			h.Redirect = "/created"
+			h.Status = http.StatusMovedPermanently
+			return
+		}})
+
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{"/missing", http.StatusNotFound, "404 No such post"},
+		{"/broken", http.StatusInternalServerError, "500 " + DEFAULT_ERROR_MESSAGE},
+		{"/invalid", http.StatusUnprocessableEntity, "422 Please fix the form email=is required"},
+		{"/api/invalid", http.StatusUnprocessableEntity, `{"error":{"status":422,"message":"Please fix the form","fields":{"email":"is required"}}}`},
+		{"/api/conflict", http.StatusConflict, `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<error><message>Conflict</message><status>409</status></error>`},
+		{"/created", http.StatusCreated, "made"},
+		{"/moved", http.StatusMovedPermanently, ""},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost"+test.path, nil)
+		app.ServeHTTP(w, r)
+		if w.Code != test.status {
+			t.Errorf("%v: expected status %v, got %v", test.path, test.status, w.Code)
+			continue
+		}
+		if test.body != "" && w.Body.String() != test.body {
+			t.Errorf("%v: expected %q, got %q", test.path, test.body, w.Body.String())
+		}
+	}
+}
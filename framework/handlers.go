@@ -6,25 +6,30 @@ package framework
 
 import (
 	"encoding/base64"
-	"log"
 	"net/http"
 )
 
 // LoginHandler can be used by applications as a handler for authentication. It uses the GetUser() function you supply to
 // in AppSetup and the TestPassword() function implemented in the User type. If the login credentials are valid, the handler
-// will place the user data in the session, calling ClearPasswordHash() before it does so.
+// will place the user data in a renewed session with RequestScope.LogIn(). When someone who's already logged in is sent here by a
+// route's RecentLogin, .confirm is true and .username is theirs, so the template can ask for their password again.
 func LoginHandler(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
 	h.Init()
 	var dest string
 
+	if current, ok := rs.Session.Values["user"].(User); ok {
+		h.View["confirm"] = true
+		h.View["username"] = username(current)
+	}
+
 	if rs.UrlParamMap["dest"] != "" {
 		if err != nil {
-			log.Print(err)
+			a.Log.Error("Login failed", "error", err)
 		} else {
 			h.View["dest"] = rs.UrlParamMap["dest"]
 		}
 	} else {
-		log.Println("No destination specified, will redirect to /")
+		a.Log.Debug("No destination specified, will redirect to /")
 	}
 
 	if r.Method == "POST" {
@@ -36,7 +41,7 @@ func LoginHandler(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResp
 		if dest64 != "" {
 			bDest, err := base64.URLEncoding.DecodeString(dest64)
 			if err != nil {
-				log.Print(err)
+				a.Log.Warn("Login destination isn't valid base64", "dest", dest64, "error", err)
 			} else {
 				dest = string(bDest)
 			}
@@ -44,9 +49,9 @@ func LoginHandler(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResp
 		}
 
 		if err != nil {
-			log.Println(err)
+			a.Log.Error("Login failed", "error", err)
 		}
-		log.Println("Checking username/password")
+		a.Log.Debug("Checking username/password", "username", username)
 		user := a.Setup.GetUser(username, a)
 		var loggedIn bool = false
 		if user != nil {
@@ -56,9 +61,10 @@ func LoginHandler(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResp
 		}
 
 		if loggedIn {
-			user.ClearPasswordHash()
-			rs.Session.Values["user"] = user
-			log.Printf("Logging in userId: %+v", rs.Session.Values["user"])
+			if err = rs.LogIn(user); err != nil {
+				return
+			}
+			a.Log.Info("Logged in", "user", rs.Session.Values["user"])
 			if dest != "" {
 				h.Redirect = dest
 			} else {
@@ -89,10 +95,10 @@ func ErrorHandler(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResp
 	return
 }
 
-// A handler that can be used for clearing the session, logging the user out. No template is required.
+// A handler that can be used for destroying the session, logging the user out. No template is required.
 func LogoutHandler(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
 	h.Init()
-	rs.Session.Values = nil
+	rs.DestroySession()
 	h.Redirect = "/"
 	return
 }
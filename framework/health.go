@@ -0,0 +1,182 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/context"
+	"github.com/kylelemons/go-gypsy/yaml"
+	"net/http"
+	"time"
+)
+
+// The status of a health check that passed, and of one that failed or took too long.
+const (
+	HEALTH_OK   = "ok"
+	HEALTH_FAIL = "fail"
+)
+
+// A HealthCheck tells whether something the application depends on is working. Returning an error makes the application not ready,
+// so load balancers stop sending it requests. Add your own to AppSetup.HealthChecks.
+type HealthCheck func(a *AppScope) error
+
+// healthResult is the outcome of one check, as sent to the client.
+type healthResult struct {
+	Status string `json:"status"`
+	// How long the check took, in milliseconds.
+	Latency float64 `json:"latencyMs"`
+	Error   string  `json:"error,omitempty"`
+}
+
+// healthResponse is the JSON body of the health and readiness endpoints.
+type healthResponse struct {
+	Status string                  `json:"status"`
+	Checks map[string]healthResult `json:"checks,omitempty"`
+}
+
+// health serves the liveness and readiness endpoints.
+type health struct {
+	app       *App
+	livePath  string
+	readyPath string
+	// How long a check can take before it counts as failed.
+	timeout time.Duration
+	checks  map[string]HealthCheck
+}
+
+// newHealth sets up the health endpoints from the "server.health" section of the config file and adds them to the app's router.
+// They're on by default. They go straight to the router, so they don't touch the session, templates or permissions.
+func newHealth(c *yaml.File, app *App) (h *health, err error) {
+	enabled, err := configBool(c, "server.health.enabled", true)
+	if err != nil || !enabled {
+		return
+	}
+	h = &health{app: app, checks: make(map[string]HealthCheck)}
+	if h.livePath, err = configString(c, "server.health.livePath", "/healthz"); err != nil {
+		return
+	}
+	if h.readyPath, err = configString(c, "server.health.readyPath", "/readyz"); err != nil {
+		return
+	}
+	if h.timeout, err = configDuration(c, "server.health.timeout", 5*time.Second); err != nil {
+		return
+	}
+
+	if app.Db != nil {
+		h.checks["database"] = checkDatabase
+		h.checks["schema"] = checkSchemas
+	}
+	for name, check := range app.Setup.HealthChecks {
+		if _, ok := h.checks[name]; ok {
+			err = &SawsijError{fmt.Sprintf("Health check %q is built in and can't be replaced", name)}
+			return
+		}
+		h.checks[name] = check
+	}
+
+	methods := []string{"GET"}
+	if _, err = app.router.add(h.livePath, methods, http.HandlerFunc(h.serveLive)); err != nil {
+		return
+	}
+	_, err = app.router.add(h.readyPath, methods, http.HandlerFunc(h.serveReady))
+	return
+}
+
+// checkDatabase pings the database.
+func checkDatabase(a *AppScope) error {
+	return a.Db.Db.Ping()
+}
+
+// checkSchemas makes sure every schema in dbversions.yaml is at the version the application expects, the same way Configure() does.
+func checkSchemas(a *AppScope) error {
+	for _, schema := range a.Db.Schemas {
+		dbversion, err := schemaVersion(a.Db, schema.Name)
+		if err != nil {
+			return err
+		}
+		if dbversion != schema.Version {
+			return &SawsijError{fmt.Sprintf("Schema %v is at version %v, but the application needs version %v", schema.Name, dbversion,
+				schema.Version)}
+		}
+	}
+	return nil
+}
+
+// run runs a check, giving up on it after the timeout. A check that panics fails rather than taking the server down.
+func (h *health) run(check HealthCheck) (result healthResult) {
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				done <- fmt.Errorf("panic: %v", p)
+			}
+		}()
+		done <- check(h.app.AppScope)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-time.After(h.timeout):
+		err = &SawsijError{fmt.Sprintf("Timed out after %v", h.timeout)}
+	}
+	result.Latency = float64(time.Since(start)) / float64(time.Millisecond)
+	result.Status = HEALTH_OK
+	if err != nil {
+		result.Status = HEALTH_FAIL
+		result.Error = err.Error()
+	}
+	return
+}
+
+// write sends the response as JSON, with a 503 if it failed.
+func (h *health) write(w http.ResponseWriter, resp healthResponse) {
+	b, err := json.Marshal(resp)
+	if err != nil {
+		h.app.Log.Error("Encoding health response failed", "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if resp.Status != HEALTH_OK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	w.Write(b)
+}
+
+// serveLive answers as long as the process can handle requests at all.
+func (h *health) serveLive(w http.ResponseWriter, r *http.Request) {
+	context.Set(r, patternKey, h.livePath)
+	h.write(w, healthResponse{Status: HEALTH_OK})
+}
+
+// serveReady runs every check at once and reports on each of them. The application is ready if they all pass.
+func (h *health) serveReady(w http.ResponseWriter, r *http.Request) {
+	context.Set(r, patternKey, h.readyPath)
+	type named struct {
+		name   string
+		result healthResult
+	}
+	results := make(chan named, len(h.checks))
+	for name, check := range h.checks {
+		go func(name string, check HealthCheck) {
+			results <- named{name, h.run(check)}
+		}(name, check)
+	}
+
+	resp := healthResponse{Status: HEALTH_OK, Checks: make(map[string]healthResult)}
+	for range h.checks {
+		n := <-results
+		resp.Checks[n.name] = n.result
+		if n.result.Status != HEALTH_OK {
+			resp.Status = HEALTH_FAIL
+			h.app.Log.Warn("Health check failed", "check", n.name, "error", n.result.Error)
+		}
+	}
+	h.write(w, resp)
+}
@@ -0,0 +1,97 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestHealth(t *testing.T) {
+	basePath := standupApp(t, "health", []string{"health:", "  timeout: 50ms"}, map[string]string{"index.html": "hello"})
+	defer os.RemoveAll(basePath)
+
+	queueUp := true
+	as := &AppSetup{Roles: &map[string]int{}, HealthChecks: map[string]HealthCheck{
+		"queue": func(a *AppScope) error {
+			if !queueUp {
+				return errors.New("queue is down")
+			}
+			return nil
+		},
+		"cache": func(a *AppScope) error { return nil },
+	}}
+	app, err := NewApp(as, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(path string) (w *httptest.ResponseRecorder, resp healthResponse) {
+		w = httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost"+path, nil)
+		app.ServeHTTP(w, r)
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("%v: %v in %q", path, err, w.Body.String())
+		}
+		return
+	}
+
+	w, resp := get("/healthz")
+	if w.Code != http.StatusOK || resp.Status != HEALTH_OK || w.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("Expected a live app, got %v %+v", w.Code, resp)
+	}
+
+	w, resp = get("/readyz")
+	if w.Code != http.StatusOK || resp.Status != HEALTH_OK || len(resp.Checks) != 2 {
+		t.Errorf("Expected a ready app with 2 checks, got %v %+v", w.Code, resp)
+	}
+	if _, ok := resp.Checks["database"]; ok {
+		t.Error("Expected no database check without a database")
+	}
+
+	queueUp = false
+	w, resp = get("/readyz")
+	if w.Code != http.StatusServiceUnavailable || resp.Status != HEALTH_FAIL {
+		t.Errorf("Expected 503, got %v %+v", w.Code, resp)
+	}
+	if q := resp.Checks["queue"]; q.Status != HEALTH_FAIL || q.Error != "queue is down" {
+		t.Errorf("Expected the queue check to fail, got %+v", q)
+	}
+	if c := resp.Checks["cache"]; c.Status != HEALTH_OK {
+		t.Errorf("Expected the cache check to pass, got %+v", c)
+	}
+	queueUp = true
+
+	app.health.checks["slow"] = func(a *AppScope) error { time.Sleep(time.Second); return nil }
+	app.health.checks["panicky"] = func(a *AppScope) error { panic("oops") }
+	start := time.Now()
+	w, resp = get("/readyz")
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("Expected the slow check to time out, took %v", time.Since(start))
+	}
+	if w.Code != http.StatusServiceUnavailable || resp.Checks["slow"].Status != HEALTH_FAIL || resp.Checks["panicky"].Error != "panic: oops" {
+		t.Errorf("Expected the slow and panicking checks to fail, got %+v", resp)
+	}
+}
+
+func TestHealthConfig(t *testing.T) {
+	basePath := standupApp(t, "healthconfig", []string{"health:", "  enabled: false"}, map[string]string{"index.html": "hello"})
+	defer os.RemoveAll(basePath)
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "http://localhost/healthz", nil)
+	app.ServeHTTP(w, r)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected no health endpoint when it's turned off, got %v", w.Code)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bitbucket.org/jaybill/sawsij/framework/logging"
+	"fmt"
+	"github.com/kylelemons/go-gypsy/yaml"
+	"os"
+	"strings"
+)
+
+// newLogger makes the application's Logger from the "log" section of the config file. It writes to standard error.
+func newLogger(c *yaml.File) (l logging.Logger, err error) {
+	name, err := configString(c, "log.level", "info")
+	if err != nil {
+		return
+	}
+	level, err := logging.ParseLevel(name)
+	if err != nil {
+		err = &SawsijError{fmt.Sprintf("Config value log.level: %v", err)}
+		return
+	}
+
+	format, err := configString(c, "log.format", "text")
+	if err != nil {
+		return
+	}
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format != "text" && format != "json" {
+		err = &SawsijError{fmt.Sprintf("Config value log.format must be text or json, not %q", format)}
+		return
+	}
+
+	redact, err := configList(c, "log.redact")
+	if err != nil {
+		return
+	}
+	l = logging.New(os.Stderr, level, format == "json", redact...)
+	return
+}
+
+// fatal logs msg as an error and stops the program.
+func (app *App) fatal(msg string, fields ...interface{}) {
+	app.Log.Error(msg, fields...)
+	os.Exit(1)
+}
@@ -0,0 +1,73 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bitbucket.org/jaybill/sawsij/framework/logging"
+	"bytes"
+	"github.com/kylelemons/go-gypsy/yaml"
+	"strings"
+	"testing"
+)
+
+func TestLoggerConfig(t *testing.T) {
+	tests := []struct {
+		config string
+		valid  bool
+		debug  bool
+	}{
+		{"", true, false},
+		{"log:\n  level: debug\n  format: json\n  redact: apiKey, ssn\n", true, true},
+		{"log:\n  level: WARN\n  format: Text\n", true, false},
+		{"log:\n  level: loud\n", false, false},
+		{"log:\n  format: xml\n", false, false},
+	}
+	for _, test := range tests {
+		l, err := newLogger(yaml.Config(test.config))
+		if (err == nil) != test.valid {
+			t.Errorf("%q: expected valid to be %v, got %v", test.config, test.valid, err)
+			continue
+		}
+		if err == nil && l.Enabled(logging.LEVEL_DEBUG) != test.debug {
+			t.Errorf("%q: expected debug to be %v", test.config, test.debug)
+		}
+	}
+}
+
+func TestLoggerErrors(t *testing.T) {
+	var text, json bytes.Buffer
+	for _, l := range []logging.Logger{logging.New(&text, logging.LEVEL_INFO, false), logging.New(&json, logging.LEVEL_INFO, true)} {
+		l.Error("Request failed", "error", &SawsijError{"no such table"})
+		l.Error("Request failed", "error", &HttpError{Status: 404, Message: "No such page", Fields: map[string]string{"id": "missing"}})
+	}
+
+	lines := strings.Split(strings.TrimSpace(text.String()), "\n")
+	expected := []string{
+		` ERROR Request failed error="no such table"`,
+		` ERROR Request failed error="404 No such page"`,
+	}
+	for i, line := range lines {
+		if i >= len(expected) || !strings.HasSuffix(line, expected[i]) {
+			t.Errorf("Unexpected text line %q", line)
+		}
+	}
+	if len(lines) != len(expected) {
+		t.Errorf("Expected %v text lines, got %q", len(expected), lines)
+	}
+
+	lines = strings.Split(strings.TrimSpace(json.String()), "\n")
+	expected = []string{
+		`"level":"error","msg":"Request failed","error":"no such table"}`,
+		`"level":"error","msg":"Request failed","error":"404 No such page"}`,
+	}
+	for i, line := range lines {
+		if i >= len(expected) || !strings.HasSuffix(line, expected[i]) {
+			t.Errorf("Unexpected JSON line %q", line)
+		}
+	}
+	if len(lines) != len(expected) {
+		t.Errorf("Expected %v JSON lines, got %q", len(expected), lines)
+	}
+}
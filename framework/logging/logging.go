@@ -0,0 +1,295 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package logging provides the leveled, structured logger used by the framework and model packages.
+//
+// Every message has a level and can carry fields, which are given as pairs of keys and values:
+//
+//	a.Log.Info("Order placed", "orderId", order.Id, "total", order.Total)
+//
+// Text output looks like this:
+//
+//	2013/06/01 12:00:00 INFO Order placed orderId=12 total=9.99
+//
+// JSON output has one object per line, with the time, level and message followed by the fields:
+//
+//	{"time":"2013-06-01T12:00:00Z","level":"info","msg":"Order placed","orderId":12,"total":9.99}
+//
+// Fields whose names look sensitive, like "password" or "session", are replaced with REDACTED. So are fields with those names inside
+// structs and maps, so logging a user struct doesn't write its password hash to the log.
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A Level is how important a message is. A Logger only writes messages at or above its level.
+type Level int
+
+const (
+	// Detail that's only useful while working on the application, like every database query.
+	LEVEL_DEBUG Level = iota
+	// Normal events, like the server starting.
+	LEVEL_INFO
+	// Something unexpected that the application can carry on from.
+	LEVEL_WARN
+	// Something failed.
+	LEVEL_ERROR
+)
+
+// What a sensitive value is replaced with.
+const REDACTED = "[REDACTED]"
+
+// Field names that are always redacted. A field is redacted if its name contains any of these, ignoring case.
+var DEFAULT_REDACT = []string{"password", "passwd", "secret", "token", "session", "cookie", "authorization", "salt"}
+
+// How far into nested structs and maps values are checked for sensitive fields.
+const maxRedactDepth = 4
+
+// What structs, maps and slices nested deeper than maxRedactDepth are replaced with, since they can't be checked.
+const TOO_DEEP = "[TOO DEEP]"
+
+var levelNames = map[Level]string{LEVEL_DEBUG: "debug", LEVEL_INFO: "info", LEVEL_WARN: "warn", LEVEL_ERROR: "error"}
+
+// String returns the name of the level, i.e. "info".
+func (l Level) String() string {
+	if name, ok := levelNames[l]; ok {
+		return name
+	}
+	return fmt.Sprintf("level(%d)", int(l))
+}
+
+// ParseLevel returns the level with the given name, which is one of "debug", "info", "warn" or "error".
+func ParseLevel(name string) (level Level, err error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for l, n := range levelNames {
+		if n == name {
+			level = l
+			return
+		}
+	}
+	err = fmt.Errorf("%q isn't a log level. Use debug, info, warn or error.", name)
+	return
+}
+
+// A Logger writes leveled messages with key/value fields. fields alternate between keys, which should be strings, and values.
+type Logger interface {
+	Debug(msg string, fields ...interface{})
+	Info(msg string, fields ...interface{})
+	Warn(msg string, fields ...interface{})
+	Error(msg string, fields ...interface{})
+	// With returns a Logger that adds the given fields to every message, i.e. a.Log.With("requestId", id).
+	With(fields ...interface{}) Logger
+	// Enabled reports whether messages at level are written. It can be used to skip building expensive fields.
+	Enabled(level Level) bool
+}
+
+// The Logger used by code that doesn't have one of its own, like a model.Table without a DbSetup.Log. It writes text to
+// standard error at LEVEL_INFO.
+var Default Logger = New(os.Stderr, LEVEL_INFO, false)
+
+// output is what the loggers made by one New() call share.
+type output struct {
+	mu     sync.Mutex
+	w      io.Writer
+	level  Level
+	json   bool
+	redact []string
+}
+
+type logger struct {
+	out    *output
+	fields []interface{}
+}
+
+// New returns a Logger that writes messages at level or above to w, as JSON if json is true and as text otherwise. Fields with names
+// containing anything in redact are redacted, along with those in DEFAULT_REDACT.
+func New(w io.Writer, level Level, json bool, redact ...string) Logger {
+	out := &output{w: w, level: level, json: json}
+	for _, r := range append(DEFAULT_REDACT, redact...) {
+		out.redact = append(out.redact, strings.ToLower(r))
+	}
+	return &logger{out: out}
+}
+
+func (l *logger) Debug(msg string, fields ...interface{}) { l.write(LEVEL_DEBUG, msg, fields) }
+func (l *logger) Info(msg string, fields ...interface{})  { l.write(LEVEL_INFO, msg, fields) }
+func (l *logger) Warn(msg string, fields ...interface{})  { l.write(LEVEL_WARN, msg, fields) }
+func (l *logger) Error(msg string, fields ...interface{}) { l.write(LEVEL_ERROR, msg, fields) }
+
+func (l *logger) With(fields ...interface{}) Logger {
+	all := make([]interface{}, 0, len(l.fields)+len(fields))
+	all = append(append(all, l.fields...), fields...)
+	return &logger{out: l.out, fields: all}
+}
+
+func (l *logger) Enabled(level Level) bool {
+	return level >= l.out.level
+}
+
+// write formats a message and writes it as one line.
+func (l *logger) write(level Level, msg string, fields []interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+	all := append(append([]interface{}{}, l.fields...), fields...)
+	if len(all)%2 == 1 {
+		// A value without a key still gets logged.
+		all = append(all[:len(all)-1], "extra", all[len(all)-1])
+	}
+
+	var buf bytes.Buffer
+	now := time.Now()
+	if l.out.json {
+		buf.WriteString(`{"time":`)
+		writeJson(&buf, now.Format(time.RFC3339Nano))
+		buf.WriteString(`,"level":`)
+		writeJson(&buf, level.String())
+		buf.WriteString(`,"msg":`)
+		writeJson(&buf, msg)
+		for i := 0; i < len(all); i += 2 {
+			key := fmt.Sprint(all[i])
+			buf.WriteByte(',')
+			writeJson(&buf, key)
+			buf.WriteByte(':')
+			writeJson(&buf, l.out.clean(key, all[i+1], 0))
+		}
+		buf.WriteString("}\n")
+	} else {
+		buf.WriteString(now.Format("2006/01/02 15:04:05 "))
+		buf.WriteString(strings.ToUpper(level.String()))
+		buf.WriteByte(' ')
+		buf.WriteString(msg)
+		for i := 0; i < len(all); i += 2 {
+			key := fmt.Sprint(all[i])
+			buf.WriteByte(' ')
+			buf.WriteString(key)
+			buf.WriteByte('=')
+			buf.WriteString(textValue(l.out.clean(key, all[i+1], 0)))
+		}
+		buf.WriteByte('\n')
+	}
+
+	l.out.mu.Lock()
+	defer l.out.mu.Unlock()
+	l.out.w.Write(buf.Bytes())
+}
+
+// sensitive reports whether a field called key should be redacted.
+func (out *output) sensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, r := range out.redact {
+		if strings.Contains(key, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// clean returns value, or REDACTED if key is sensitive. Errors are turned into their messages. Structs and maps are turned into maps
+// of their fields, with sensitive ones redacted, even if they have a String() method, since it could print anything, and those nested
+// too deeply to check become TOO_DEEP. Anything else with a String() method is turned into a string.
+func (out *output) clean(key string, value interface{}, depth int) interface{} {
+	if out.sensitive(key) {
+		return REDACTED
+	}
+	if value == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if err, ok := value.(error); ok {
+		return err.Error()
+	}
+	if v, ok := value.(fmt.Stringer); ok && !hasFields(rv) {
+		return v.String()
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		if depth >= maxRedactDepth {
+			return TOO_DEEP
+		}
+		m := make(map[string]interface{})
+		t := rv.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			m[t.Field(i).Name] = out.clean(t.Field(i).Name, rv.Field(i).Interface(), depth+1)
+		}
+		return m
+	case reflect.Map:
+		if depth >= maxRedactDepth {
+			return TOO_DEEP
+		}
+		m := make(map[string]interface{})
+		for _, k := range rv.MapKeys() {
+			name := fmt.Sprint(k.Interface())
+			m[name] = out.clean(name, rv.MapIndex(k).Interface(), depth+1)
+		}
+		return m
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return value
+		}
+		if depth >= maxRedactDepth {
+			return TOO_DEEP
+		}
+		s := make([]interface{}, rv.Len())
+		for i := range s {
+			s[i] = out.clean("", rv.Index(i).Interface(), depth+1)
+		}
+		return s
+	}
+	return rv.Interface()
+}
+
+// hasFields reports whether v is a map, or a struct with exported fields, which clean() walks. Structs like time.Time that keep
+// everything to themselves are better shown by their String() method.
+func hasFields(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Map:
+		return true
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeJson writes value as JSON, falling back to a string if it can't be marshalled.
+func writeJson(buf *bytes.Buffer, value interface{}) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		b, _ = json.Marshal(fmt.Sprintf("%+v", value))
+	}
+	buf.Write(b)
+}
+
+// textValue formats a value for text output, quoting it if it's blank or has spaces, quotes or equals signs in it.
+func textValue(value interface{}) string {
+	s := fmt.Sprintf("%+v", value)
+	if s == "" || strings.ContainsAny(s, " \t\r\n\"=") {
+		return strconv.Quote(s)
+	}
+	return s
+}
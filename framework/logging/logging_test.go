@@ -0,0 +1,136 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testUser struct {
+	Username     string
+	PasswordHash string
+	Role         int64
+}
+
+// stringerUser prints everything it has, secrets included.
+type stringerUser struct {
+	Username string
+	Password string
+}
+
+func (u stringerUser) String() string { return u.Username + ":" + u.Password }
+
+func TestLevels(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, LEVEL_WARN, false)
+	l.Debug("debug")
+	l.Info("info")
+	l.Warn("warn")
+	l.Error("error")
+	out := buf.String()
+	if strings.Contains(out, "debug") || strings.Contains(out, "INFO") || !strings.Contains(out, "WARN warn") ||
+		!strings.Contains(out, "ERROR error") {
+		t.Errorf("Expected only warn and error, got %q", out)
+	}
+	if l.Enabled(LEVEL_INFO) || !l.Enabled(LEVEL_ERROR) {
+		t.Error("Enabled doesn't match the level")
+	}
+
+	for _, name := range []string{"debug", "INFO", " warn ", "error"} {
+		if _, err := ParseLevel(name); err != nil {
+			t.Errorf("Expected %q to parse, got %v", name, err)
+		}
+	}
+	if _, err := ParseLevel("loud"); err == nil {
+		t.Error("Expected an error for an unknown level")
+	}
+}
+
+func TestTextOutput(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, LEVEL_DEBUG, false).With("route", "/posts")
+	l.Info("Query", "query", "select * from post", "rows", 3, "err", errors.New("oops"), "dangling")
+	out := buf.String()
+	expected := ` INFO Query route=/posts query="select * from post" rows=3 err=oops extra=dangling` + "\n"
+	if !strings.HasSuffix(out, expected) {
+		t.Errorf("Expected a line ending %q, got %q", expected, out)
+	}
+}
+
+func TestJsonOutputAndRedaction(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, LEVEL_DEBUG, true, "creditCard")
+	user := &testUser{Username: "jaybill", PasswordHash: "abc123", Role: 2}
+	values := map[interface{}]interface{}{"user": user, "csrfToken": "xyz", "visits": 3}
+	l.Info("Request", "user", user, "vals", values, "session", "whole thing", "creditCardNumber", "4111", "password", "hunter2")
+
+	var line map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("Expected a JSON line, got %q: %v", buf.String(), err)
+	}
+	if line["level"] != "info" || line["msg"] != "Request" || line["time"] == nil {
+		t.Errorf("Wrong standard fields in %v", line)
+	}
+	for _, key := range []string{"session", "creditCardNumber", "password"} {
+		if line[key] != REDACTED {
+			t.Errorf("Expected %v to be redacted, got %v", key, line[key])
+		}
+	}
+	u := line["user"].(map[string]interface{})
+	if u["Username"] != "jaybill" || u["PasswordHash"] != REDACTED {
+		t.Errorf("Expected the password hash in the user struct to be redacted, got %v", u)
+	}
+	vals := line["vals"].(map[string]interface{})
+	if vals["csrfToken"] != REDACTED || vals["visits"] != float64(3) ||
+		vals["user"].(map[string]interface{})["PasswordHash"] != REDACTED {
+		t.Errorf("Expected nested values to be redacted, got %v", vals)
+	}
+	if strings.Contains(buf.String(), "abc123") || strings.Contains(buf.String(), "hunter2") {
+		t.Errorf("A secret made it into the log: %q", buf.String())
+	}
+}
+
+func TestStringerRedaction(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, LEVEL_DEBUG, true)
+	l.Info("Login", "user", &stringerUser{Username: "jaybill", Password: "hunter2"}, "at", time.Unix(0, 0).UTC(),
+		"error", errors.New("no such user"))
+
+	var line map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("Expected a JSON line, got %q: %v", buf.String(), err)
+	}
+	u, ok := line["user"].(map[string]interface{})
+	if !ok || u["Username"] != "jaybill" || u["Password"] != REDACTED {
+		t.Errorf("Expected the struct's fields to be redacted rather than its String() used, got %v", line["user"])
+	}
+	if strings.Contains(buf.String(), "hunter2") {
+		t.Errorf("A secret made it into the log: %q", buf.String())
+	}
+	// Errors use Error(), and values without exported fields still use String().
+	if line["at"] != "1970-01-01 00:00:00 +0000 UTC" || line["error"] != "no such user" {
+		t.Errorf("Expected the time and error as strings, got %v and %v", line["at"], line["error"])
+	}
+}
+
+func TestDeepRedaction(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, LEVEL_DEBUG, false)
+	nested := map[string]interface{}{"a": map[string]interface{}{"b": map[string]interface{}{"c": map[string]interface{}{
+		"depth": 4, "d": map[string]interface{}{"password": "hunter2"}}}}}
+	l.Info("Nested", "data", nested)
+
+	if strings.Contains(buf.String(), "hunter2") {
+		t.Errorf("A secret made it into the log: %q", buf.String())
+	}
+	if !strings.HasSuffix(buf.String(), ` data="map[a:map[b:map[c:map[d:[TOO DEEP] depth:4]]]]"`+"\n") {
+		t.Errorf("Expected values too deep to check to be replaced, got %q", buf.String())
+	}
+}
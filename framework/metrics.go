@@ -0,0 +1,271 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gorilla/context"
+	"github.com/kylelemons/go-gypsy/yaml"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The upper bounds, in seconds, of the buckets in the request and query duration histograms.
+var METRICS_BUCKETS = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// The pattern label given to requests that didn't match a route or the static files.
+const METRICS_UNMATCHED = "unmatched"
+
+// The Content-Type of the Prometheus text format.
+const METRICS_CONTENT_TYPE = "text/plain; version=0.0.4; charset=utf-8"
+
+// The method label given to requests with a method that isn't one of the standard ones. Clients can send any method they like,
+// and each one would otherwise be a new series.
+const METRICS_OTHER_METHOD = "other"
+
+// The methods requests are labelled with as they are.
+var metricsMethods = map[string]bool{"GET": true, "HEAD": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true,
+	"CONNECT": true, "OPTIONS": true, "TRACE": true}
+
+// A histogram counts observations into buckets, as well as keeping their count and sum.
+type histogram struct {
+	// counts[i] is the number of observations no bigger than METRICS_BUCKETS[i]. They're not cumulative until they're written.
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func (h *histogram) observe(v float64) {
+	if h.counts == nil {
+		h.counts = make([]uint64, len(METRICS_BUCKETS))
+	}
+	for i, bound := range METRICS_BUCKETS {
+		if v <= bound {
+			h.counts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+// requestKey is the labels a request is counted under.
+type requestKey struct {
+	pattern string
+	method  string
+	status  int
+}
+
+// metrics collects the numbers an App exposes in the Prometheus text format. A nil *metrics collects nothing, so the methods can
+// be called whether metrics are turned on or not.
+type metrics struct {
+	// The path the metrics are served at on the main server, if they are.
+	path string
+	// The address of a separate server for the metrics, if there is one.
+	listen string
+
+	mu             sync.Mutex
+	requests       map[requestKey]*histogram
+	templateErrors map[string]uint64
+	queries        map[string]*histogram
+}
+
+// newMetrics sets up metrics from the "metrics" section of the config file. It returns nil if metrics.enabled isn't true. If
+// metrics.path is set, a route is added to app for it, which needs metrics.permission or one of metrics.roles.
+func newMetrics(c *yaml.File, app *App) (m *metrics, err error) {
+	enabled, err := configBool(c, "metrics.enabled", false)
+	if err != nil || !enabled {
+		return
+	}
+	m = &metrics{requests: make(map[requestKey]*histogram), templateErrors: make(map[string]uint64), queries: make(map[string]*histogram)}
+	if m.listen, err = configString(c, "metrics.listen", ""); err != nil {
+		return
+	}
+	if m.path, err = configString(c, "metrics.path", ""); err != nil {
+		return
+	}
+	if m.path == "" && m.listen == "" {
+		m.path = "/metrics"
+	}
+	if m.path == "" {
+		return
+	}
+
+	rcfg := RouteConfig{Pattern: m.path, ReturnType: RT_RAW, CsrfExempt: true,
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			h.Header = http.Header{"Content-Type": {METRICS_CONTENT_TYPE}}
+			h.Content = bytes.NewReader(m.exposition())
+			return
+		}}
+	if rcfg.Permission, err = configString(c, "metrics.permission", ""); err != nil {
+		return
+	}
+	roles, err := configList(c, "metrics.roles")
+	if err != nil {
+		return
+	}
+	for _, name := range roles {
+		role, ok := 0, false
+		if app.Setup.Roles != nil {
+			role, ok = (*app.Setup.Roles)[name]
+		}
+		if !ok {
+			err = &SawsijError{fmt.Sprintf("Config value metrics.roles has %q, which isn't one of the application's roles", name)}
+			return
+		}
+		rcfg.Roles = append(rcfg.Roles, role)
+	}
+	if rcfg.Permission == "" && len(rcfg.Roles) == 0 {
+		err = &SawsijError{"Metrics served at metrics.path need metrics.permission or metrics.roles. To serve them to anyone who " +
+			"can reach a private address instead, set metrics.listen and leave out metrics.path."}
+		return
+	}
+	app.Route(rcfg)
+	return
+}
+
+// ServeHTTP writes the metrics. It's used for the server on metrics.listen.
+func (m *metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", METRICS_CONTENT_TYPE)
+	w.Write(m.exposition())
+}
+
+// observeRequest records a finished request. The pattern is the one the route or static files put in the request context.
+func (m *metrics) observeRequest(r *http.Request, status int, took time.Duration) {
+	if m == nil {
+		return
+	}
+	pattern, _ := context.Get(r, patternKey).(string)
+	if pattern == "" {
+		pattern = METRICS_UNMATCHED
+	}
+	method := r.Method
+	if !metricsMethods[method] {
+		method = METRICS_OTHER_METHOD
+	}
+	key := requestKey{pattern: pattern, method: method, status: status}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h := m.requests[key]
+	if h == nil {
+		h = &histogram{}
+		m.requests[key] = h
+	}
+	h.observe(took.Seconds())
+}
+
+// templateError counts a template that failed to render.
+func (m *metrics) templateError(name string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.templateErrors[name]++
+}
+
+// observeQuery records how long a model.Table operation took. It's used as the DbSetup's OnQuery function.
+func (m *metrics) observeQuery(op string, took time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h := m.queries[op]
+	if h == nil {
+		h = &histogram{}
+		m.queries[op] = h
+	}
+	h.observe(took.Seconds())
+}
+
+// escapeLabel escapes a label value for the Prometheus text format.
+func escapeLabel(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+}
+
+// formatFloat writes a number the way Prometheus expects.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// writeHistogram writes the bucket, sum and count lines of a histogram. labels is the label list without braces.
+func writeHistogram(buf *bytes.Buffer, name string, labels string, h *histogram) {
+	var cumulative uint64
+	for i, bound := range METRICS_BUCKETS {
+		if h.counts != nil {
+			cumulative += h.counts[i]
+		}
+		fmt.Fprintf(buf, "%v_bucket{%v,le=\"%v\"} %v\n", name, labels, formatFloat(bound), cumulative)
+	}
+	fmt.Fprintf(buf, "%v_bucket{%v,le=\"+Inf\"} %v\n", name, labels, h.count)
+	fmt.Fprintf(buf, "%v_sum{%v} %v\n", name, labels, formatFloat(h.sum))
+	fmt.Fprintf(buf, "%v_count{%v} %v\n", name, labels, h.count)
+}
+
+// exposition returns all of the metrics in the Prometheus text format, sorted so the output is stable.
+func (m *metrics) exposition() []byte {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var buf bytes.Buffer
+
+	keys := make([]requestKey, 0, len(m.requests))
+	for key := range m.requests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.pattern != b.pattern {
+			return a.pattern < b.pattern
+		}
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		return a.status < b.status
+	})
+	labels := make([]string, len(keys))
+	for i, key := range keys {
+		labels[i] = fmt.Sprintf(`pattern="%v",method="%v",status="%v"`, escapeLabel(key.pattern), escapeLabel(key.method), key.status)
+	}
+
+	buf.WriteString("# HELP sawsij_http_requests_total Requests handled, by route pattern, method and status.\n")
+	buf.WriteString("# TYPE sawsij_http_requests_total counter\n")
+	for i, key := range keys {
+		fmt.Fprintf(&buf, "sawsij_http_requests_total{%v} %v\n", labels[i], m.requests[key].count)
+	}
+	buf.WriteString("# HELP sawsij_http_request_duration_seconds How long requests took, by route pattern, method and status.\n")
+	buf.WriteString("# TYPE sawsij_http_request_duration_seconds histogram\n")
+	for i, key := range keys {
+		writeHistogram(&buf, "sawsij_http_request_duration_seconds", labels[i], m.requests[key])
+	}
+
+	names := make([]string, 0, len(m.templateErrors))
+	for name := range m.templateErrors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	buf.WriteString("# HELP sawsij_template_errors_total Templates that failed to render, by template.\n")
+	buf.WriteString("# TYPE sawsij_template_errors_total counter\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "sawsij_template_errors_total{template=\"%v\"} %v\n", escapeLabel(name), m.templateErrors[name])
+	}
+
+	ops := make([]string, 0, len(m.queries))
+	for op := range m.queries {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	buf.WriteString("# HELP sawsij_db_query_duration_seconds How long model.Table operations took, by operation.\n")
+	buf.WriteString("# TYPE sawsij_db_query_duration_seconds histogram\n")
+	for _, op := range ops {
+		writeHistogram(&buf, "sawsij_db_query_duration_seconds", fmt.Sprintf(`op="%v"`, escapeLabel(op)), m.queries[op])
+	}
+	return buf.Bytes()
+}
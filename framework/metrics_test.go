@@ -0,0 +1,155 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// standupMetricsApp makes an App with the given metrics section in its config file.
+func standupMetricsApp(t *testing.T, metricsConfig string) (app *App, basePath string, err error) {
+	basePath = standupApp(t, "metrics", nil, map[string]string{"index.html": "hello", "broken.html": `<% template "nope" . %>`})
+	f, err := os.OpenFile(basePath+"/etc/config.yaml", os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(metricsConfig)
+	f.Close()
+	as := testRoleSetup()
+	(*as.Roles)["admin"] = testAdmin
+	app, err = NewApp(as, basePath)
+	return
+}
+
+func TestMetricsConfig(t *testing.T) {
+	tests := []struct {
+		config  string
+		valid   bool
+		enabled bool
+		path    string
+	}{
+		{"", true, false, ""},
+		{"metrics:\n  enabled: false\n", true, false, ""},
+		{"metrics:\n  enabled: true\n", false, false, ""},
+		{"metrics:\n  enabled: true\n  permission: users.edit\n", true, true, "/metrics"},
+		{"metrics:\n  enabled: true\n  path: /stats\n  roles: admin\n", true, true, "/stats"},
+		{"metrics:\n  enabled: true\n  roles: nobody\n", false, false, ""},
+		{"metrics:\n  enabled: true\n  listen: 127.0.0.1:9100\n", true, true, ""},
+	}
+	for _, test := range tests {
+		app, basePath, err := standupMetricsApp(t, test.config)
+		os.RemoveAll(basePath)
+		if (err == nil) != test.valid {
+			t.Errorf("%q: expected valid to be %v, got %v", test.config, test.valid, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if (app.metrics != nil) != test.enabled {
+			t.Errorf("%q: expected enabled to be %v", test.config, test.enabled)
+		} else if app.metrics != nil && app.metrics.path != test.path {
+			t.Errorf("%q: expected path %q, got %q", test.config, test.path, app.metrics.path)
+		}
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	app, basePath, err := standupMetricsApp(t, "metrics:\n  enabled: true\n  permission: users.edit\n")
+	defer os.RemoveAll(basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loginAs *authTestUser
+	app.Route(RouteConfig{Pattern: "/login", Permission: "site.view",
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			rs.Session.Values["user"] = loginAs
+			h.Redirect = "/"
+			return
+		}})
+	app.Route(RouteConfig{Pattern: "/", Permission: "site.view", Handler: testHandler})
+	app.Route(RouteConfig{Pattern: "/broken", Permission: "site.view", Handler: testHandler})
+
+	request := func(path string, cookie string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost"+path, nil)
+		if cookie != "" {
+			r.Header.Set("Cookie", cookie)
+		}
+		app.ServeHTTP(w, r)
+		return w
+	}
+	loginAs = &authTestUser{"member", []int64{testMember}}
+	member := request("/login", "").Header().Get("Set-Cookie")
+	loginAs = &authTestUser{"admin", []int64{testAdmin}}
+	admin := request("/login", "").Header().Get("Set-Cookie")
+
+	request("/", "")
+	request("/", "")
+	request("/broken", "")
+	request("/nowhere", "")
+	request("/static/missing.css", "")
+	for _, method := range []string{"BREW", "WHEN"} {
+		r, _ := http.NewRequest(method, "http://localhost/nowhere", nil)
+		app.ServeHTTP(httptest.NewRecorder(), r)
+	}
+	app.metrics.observeQuery("fetch", 30*time.Millisecond)
+
+	if w := request("/metrics", ""); w.Code != http.StatusFound {
+		t.Errorf("Expected a guest to be sent to log in, got %v", w.Code)
+	}
+	if w := request("/metrics", member); w.Code != http.StatusFound {
+		t.Errorf("Expected a member to be denied, got %v", w.Code)
+	}
+	w := request("/metrics", admin)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200 for an admin, got %v", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != METRICS_CONTENT_TYPE {
+		t.Errorf("Expected Content-Type %q, got %q", METRICS_CONTENT_TYPE, ct)
+	}
+	// The server on metrics.listen sends the same thing.
+	lw := httptest.NewRecorder()
+	app.metrics.ServeHTTP(lw, nil)
+	if ct := lw.Header().Get("Content-Type"); ct != METRICS_CONTENT_TYPE {
+		t.Errorf("Expected Content-Type %q from the metrics server, got %q", METRICS_CONTENT_TYPE, ct)
+	}
+	body := w.Body.String()
+	for _, line := range []string{
+		"# TYPE sawsij_http_requests_total counter",
+		`sawsij_http_requests_total{pattern="/",method="GET",status="200"} 2`,
+		`sawsij_http_requests_total{pattern="/broken",method="GET",status="500"} 1`,
+		`sawsij_http_requests_total{pattern="unmatched",method="GET",status="404"} 1`,
+		`sawsij_http_requests_total{pattern="unmatched",method="other",status="404"} 2`,
+		`sawsij_http_requests_total{pattern="/static/",method="GET",status="404"} 1`,
+		`sawsij_http_requests_total{pattern="/metrics",method="GET",status="302"} 2`,
+		`sawsij_http_request_duration_seconds_bucket{pattern="/",method="GET",status="200",le="+Inf"} 2`,
+		`sawsij_http_request_duration_seconds_count{pattern="/",method="GET",status="200"} 2`,
+		`sawsij_template_errors_total{template="broken.html"} 1`,
+		`sawsij_db_query_duration_seconds_bucket{op="fetch",le="0.025"} 0`,
+		`sawsij_db_query_duration_seconds_bucket{op="fetch",le="0.05"} 1`,
+		`sawsij_db_query_duration_seconds_bucket{op="fetch",le="10"} 1`,
+		`sawsij_db_query_duration_seconds_sum{op="fetch"} 0.03`,
+	} {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("Expected %q in:\n%v", line, body)
+		}
+	}
+}
+
+func TestMetricsLabels(t *testing.T) {
+	if escaped := escapeLabel("a\"b\\c\nd"); escaped != `a\"b\\c\nd` {
+		t.Errorf("Label not escaped: %q", escaped)
+	}
+	// A nil *metrics is what an App with metrics turned off has, and mustn't panic.
+	var m *metrics
+	m.templateError("index.html")
+	m.observeQuery("fetch", time.Second)
+}
@@ -0,0 +1,42 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"net/http"
+)
+
+// HandlerFunc is the signature of the handler functions that are supplied to Route() in a RouteConfig.
+type HandlerFunc func(*http.Request, *AppScope, *RequestScope) (HandlerResponse, error)
+
+// A Middleware wraps a handler function with some other behaviour. It's given the next function in the chain and returns a function
+// that will be called in its place. That function can look at the AppScope and RequestScope, skip calling next entirely and return its
+// own HandlerResponse (to deny a request, for example) or call next and change what comes back.
+//
+// A simple timing middleware looks like this:
+//
+//	func Timer(next framework.HandlerFunc) framework.HandlerFunc {
+//		return func(r *http.Request, a *framework.AppScope, rs *framework.RequestScope) (h framework.HandlerResponse, err error) {
+//			start := time.Now()
+//			h, err = next(r, a, rs)
+//			a.Log.Info("Request timed", "path", r.URL.Path, "took", time.Since(start))
+//			return
+//		}
+//	}
+//
+// Middleware set on the AppSetup runs for every route, before any middleware set on the RouteConfig.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// chain wraps h in each of the middlewares, so that the first middleware is the first to be called.
+func chain(h HandlerFunc, middlewares ...[]Middleware) HandlerFunc {
+	var all []Middleware
+	for _, m := range middlewares {
+		all = append(all, m...)
+	}
+	for i := len(all) - 1; i >= 0; i-- {
+		h = all[i](h)
+	}
+	return h
+}
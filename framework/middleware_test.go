@@ -0,0 +1,68 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func recordingMiddleware(name string, calls *[]string) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			*calls = append(*calls, name)
+			h, err = next(r, a, rs)
+			*calls = append(*calls, "/"+name)
+			return
+		}
+	}
+}
+
+func TestChainOrder(t *testing.T) {
+	var calls []string
+	handler := func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+		calls = append(calls, "handler")
+		return
+	}
+
+	global := []Middleware{recordingMiddleware("global", &calls)}
+	route := []Middleware{recordingMiddleware("route1", &calls), recordingMiddleware("route2", &calls)}
+	chain(handler, global, route)(nil, nil, nil)
+
+	expected := "global,route1,route2,handler,/route2,/route1,/global"
+	if got := strings.Join(calls, ","); got != expected {
+		t.Fatalf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestChainShortCircuit(t *testing.T) {
+	called := false
+	handler := func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+		called = true
+		return
+	}
+	gate := func(next HandlerFunc) HandlerFunc {
+		return func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			h.Redirect = "/denied"
+			return
+		}
+	}
+	post := func(next HandlerFunc) HandlerFunc {
+		return func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			h, err = next(r, a, rs)
+			h.Redirect += "?gated=1"
+			return
+		}
+	}
+
+	h, _ := chain(handler, []Middleware{post, gate})(nil, nil, nil)
+	if called {
+		t.Fatal("Handler should not have been called")
+	}
+	if h.Redirect != "/denied?gated=1" {
+		t.Fatalf("Expected redirect to be post-processed, got %q", h.Redirect)
+	}
+}
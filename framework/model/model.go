@@ -29,11 +29,11 @@ As currently implemented, both your table and your struct must have an identity
 package model
 
 import (
+	"bitbucket.org/jaybill/sawsij/framework/logging"
 	"database/sql"
 	"fmt"
 	"github.com/kylelemons/go-gypsy/yaml"
 	"io/ioutil"
-	"log"
 	"reflect"
 	"strconv"
 	"strings"
@@ -64,6 +64,8 @@ type Queries interface {
 	ConnString(string, string, string, string, string) string
 	P(int) string
 	ParseConnect(string) map[string]string
+	// IsDuplicateKey reports whether err is the database refusing a row because its key is already taken.
+	IsDuplicateKey(error) bool
 }
 
 // Table is the primary means of interaction with the database. It represents the access to a table, not the table itself.
@@ -72,6 +74,8 @@ type Queries interface {
 type Table struct {
 	Db     *DbSetup
 	Schema string
+	// Where queries and errors are logged. If it's nil, the DbSetup's Log is used.
+	Log logging.Logger
 }
 
 // A DbSetup is used to store a reference to the database connection and schema information.
@@ -80,6 +84,10 @@ type DbSetup struct {
 	DefaultSchema string
 	Schemas       []Schema
 	GetQueries    func() Queries
+	// Where tables using this DbSetup log, unless they have a Log of their own. If it's nil, logging.Default is used.
+	Log logging.Logger
+	// If it's set, OnQuery is called after every Table operation with the name of the operation, i.e. "fetch", and how long it took.
+	OnQuery func(op string, took time.Duration)
 }
 
 // A Schema is used to store schema information, like the schema name and what version it is.
@@ -95,8 +103,27 @@ type SawsijDbVersion struct {
 	RanOn     time.Time
 }
 
+// logger returns the Logger the table should use.
+func (m *Table) logger() logging.Logger {
+	if m.Log != nil {
+		return m.Log
+	}
+	if m.Db != nil && m.Db.Log != nil {
+		return m.Db.Log
+	}
+	return logging.Default
+}
+
+// timeQuery passes the time since start to the DbSetup's OnQuery function, if it has one. Call it with defer.
+func (m *Table) timeQuery(op string, start time.Time) {
+	if m.Db != nil && m.Db.OnQuery != nil {
+		m.Db.OnQuery(op, time.Since(start))
+	}
+}
+
 // Update expects a pointer to a struct that represents a row in your database. The "Id" field of the struct will be used in the where clause.
 func (m *Table) Update(data interface{}) (err error) {
+	defer m.timeQuery("update", time.Now())
 
 	rowInfo := m.getRowInfo(data, false)
 	holders := make([]string, len(rowInfo.Keys))
@@ -106,11 +133,11 @@ func (m *Table) Update(data interface{}) (err error) {
 	}
 
 	query := fmt.Sprintf(m.Db.GetQueries().Update(), rowInfo.TableName, strings.Join(holders, ","), rowInfo.Id)
-	log.Printf("Query: %q", query)
+	m.logger().Debug("Query", "query", query, "data", data)
 
 	_, err = m.Db.Db.Exec(query, rowInfo.Vals...)
 	if err != nil {
-		log.Print(err)
+		m.logger().Error("Query failed", "query", query, "error", err)
 	}
 
 	return
@@ -119,6 +146,7 @@ func (m *Table) Update(data interface{}) (err error) {
 // Insert expects a pointer to a struct that represents a row in your database. The "Id" field of the referenced struct will be populated with the
 // identity value if the row is successfully inserted.
 func (m *Table) Insert(data interface{}) (err error) {
+	defer m.timeQuery("insert", time.Now())
 	rowInfo := m.getRowInfo(data, false)
 
 	holders := make([]string, len(rowInfo.Keys))
@@ -129,29 +157,27 @@ func (m *Table) Insert(data interface{}) (err error) {
 
 	query := fmt.Sprintf(m.Db.GetQueries().Insert(), rowInfo.TableName, strings.Join(rowInfo.Keys, ","), strings.Join(holders, ","))
 
-	log.Printf("Query: %q", query)
-	log.Printf("Data: %+v", data)
+	m.logger().Debug("Query", "query", query, "data", data)
 
 	_, err = m.Db.Db.Exec(query, rowInfo.Vals...)
 	if err != nil {
-		log.Print(err)
+		m.logger().Error("Query failed", "query", query, "error", err)
 	} else {
 		if rowInfo.IdIndex != -1 {
-			log.Printf("Table name is [%v]", rowInfo.TableName)
 			idq := m.Db.GetQueries().LastInsertId(rowInfo.SequenceName)
-			log.Printf("Sequence query: %v", idq)
+			m.logger().Debug("Query", "query", idq, "table", rowInfo.TableName)
 			row := m.Db.Db.QueryRow(idq)
 			if err != nil {
-				log.Print(err)
+				m.logger().Error("Query failed", "query", idq, "error", err)
 			} else {
 				var id int64
 				err = row.Scan(&id)
 				if err != nil {
-					log.Print(err)
+					m.logger().Error("Query failed", "query", idq, "error", err)
 				} else {
 					s := reflect.ValueOf(data).Elem()
 					s.Field(rowInfo.IdIndex).SetInt(id)
-					log.Printf("Id was %v", id)
+					m.logger().Debug("Inserted", "table", rowInfo.TableName, "id", id)
 				}
 			}
 		}
@@ -161,6 +187,7 @@ func (m *Table) Insert(data interface{}) (err error) {
 
 // Insert expects a pointer to a struct that represents a row in your database. Assumes your Id field will be set in the struct.
 func (m *Table) InsertWithoutAutoId(data interface{}) (err error) {
+	defer m.timeQuery("insert", time.Now())
 	rowInfo := m.getRowInfo(data, true)
 
 	holders := make([]string, len(rowInfo.Keys))
@@ -170,10 +197,11 @@ func (m *Table) InsertWithoutAutoId(data interface{}) (err error) {
 	}
 
 	query := fmt.Sprintf(m.Db.GetQueries().Insert(), rowInfo.TableName, strings.Join(rowInfo.Keys, ","), strings.Join(holders, ","))
+	m.logger().Debug("Query", "query", query, "data", data)
 
 	_, err = m.Db.Db.Exec(query, rowInfo.Vals...)
 	if err != nil {
-		log.Print(err)
+		m.logger().Error("Query failed", "query", query, "error", err)
 	}
 
 	return
@@ -182,14 +210,15 @@ func (m *Table) InsertWithoutAutoId(data interface{}) (err error) {
 // Delete takes a pointer to a struct and deletes the row where the id in the table is the Id of the struct.
 // Note that you don't need to have acquired this struct from a row, passing in a pointer to something like {Id: 4} will totally work.
 func (m *Table) Delete(data interface{}) (err error) {
+	defer m.timeQuery("delete", time.Now())
 	rowInfo := m.getRowInfo(data, false)
 	if rowInfo.Id != -1 {
 		query := fmt.Sprintf(m.Db.GetQueries().Delete(), rowInfo.TableName, rowInfo.Id)
-		log.Printf("Query: %q", query)
+		m.logger().Debug("Query", "query", query)
 
 		_, err = m.Db.Db.Exec(query)
 		if err != nil {
-			log.Print(err)
+			m.logger().Error("Query failed", "query", query, "error", err)
 		}
 	}
 	return
@@ -198,14 +227,15 @@ func (m *Table) Delete(data interface{}) (err error) {
 // Delete where takes a pointer to a struct and a where clause and deletes from the table that matches the struct using the
 // supplied where clause
 func (m *Table) DeleteWhere(data interface{}, whereClause string) (err error) {
+	defer m.timeQuery("delete", time.Now())
 	rowInfo := m.getRowInfo(data, false)
 
 	query := fmt.Sprintf(m.Db.GetQueries().DeleteWhere(), rowInfo.TableName, whereClause)
-	log.Printf("Query: %q", query)
+	m.logger().Debug("Query", "query", query)
 
 	_, err = m.Db.Db.Exec(query)
 	if err != nil {
-		log.Print(err)
+		m.logger().Error("Query failed", "query", query, "error", err)
 	}
 
 	return
@@ -213,6 +243,7 @@ func (m *Table) DeleteWhere(data interface{}, whereClause string) (err error) {
 
 // Fetch returns a single row where the id in the table is the Id of the struct.
 func (m *Table) Fetch(data interface{}) (err error) {
+	defer m.timeQuery("fetch", time.Now())
 
 	rowInfo := m.getRowInfo(data, false)
 	cols := make([]interface{}, 0)
@@ -220,7 +251,7 @@ func (m *Table) Fetch(data interface{}) (err error) {
 	dataType := retRow.Type()
 	if rowInfo.Id != -1 {
 		query := fmt.Sprintf(m.Db.GetQueries().Fetch(), strings.Join(rowInfo.Keys, ","), rowInfo.TableName, rowInfo.Id)
-		log.Printf("Query: %q", query)
+		m.logger().Debug("Query", "query", query)
 		row := m.Db.Db.QueryRow(query)
 
 		for i := 0; i < dataType.NumField(); i++ {
@@ -231,7 +262,7 @@ func (m *Table) Fetch(data interface{}) (err error) {
 		}
 		err = row.Scan(cols...)
 		if err != nil {
-			log.Print(err)
+			m.logger().Error("Query failed", "query", query, "error", err)
 		}
 	}
 	return
@@ -264,7 +295,6 @@ func (m *Table) FetchByFields(data interface{}, fields map[string]interface{}, o
 		count++
 	}
 
-	log.Printf("Params are %+v", params)
 	q := Query{}
 	q.Order = order
 	q.Where = strings.Join(wparts, " AND ")
@@ -281,6 +311,7 @@ func (m *Table) FetchByFields(data interface{}, fields map[string]interface{}, o
 // FetchAll accepts a reference to a struct (generally "blank", though it doesn't matter), a Query and a set of query arguments and returns a set of rows that match
 // the query.
 func (m *Table) FetchAll(data interface{}, q Query, args ...interface{}) (ents []interface{}, err error) {
+	defer m.timeQuery("fetchAll", time.Now())
 	ents = make([]interface{}, 0)
 	rowInfo := m.getRowInfo(data, true)
 
@@ -305,7 +336,7 @@ func (m *Table) FetchAll(data interface{}, q Query, args ...interface{}) (ents [
 		query = fmt.Sprintf(m.Db.GetQueries().FetchAllOffset(), query, q.Offset)
 	}
 
-	log.Printf("Query: %q", query)
+	m.logger().Debug("Query", "query", query, "args", args)
 
 	rows, err := m.Db.Db.Query(query, args...)
 
@@ -320,13 +351,13 @@ func (m *Table) FetchAll(data interface{}, q Query, args ...interface{}) (ents [
 
 			err = rows.Scan(cols...)
 			if err != nil {
-				log.Print(err)
+				m.logger().Error("Scanning row failed", "query", query, "error", err)
 			}
 			ents = append(ents, ent.Interface())
 
 		}
 	} else {
-		log.Print(err)
+		m.logger().Error("Query failed", "query", query, "error", err)
 	}
 	return
 }
@@ -422,7 +453,7 @@ func RunScript(db *sql.DB, dbscript string) (err error) {
 
 			if query != "" && !isComment {
 
-				log.Printf("Query: %q", query)
+				logging.Default.Debug("Query", "query", query, "script", dbscript)
 				_, err = t.Exec(query)
 				if err != nil {
 					t.Rollback()
@@ -444,6 +475,7 @@ func RunScript(db *sql.DB, dbscript string) (err error) {
 // The "Id" fields of the referenced struct will be populated with the identity values if the rows
 // are successfully inserted. The inserts are done in a transaction and rolled back on the first error.
 func (m *Table) InsertBatch(items []interface{}) (err error) {
+	defer m.timeQuery("insertBatch", time.Now())
 	t, err := m.Db.Db.Begin()
 	for _, data := range items {
 
@@ -456,30 +488,28 @@ func (m *Table) InsertBatch(items []interface{}) (err error) {
 
 		query := fmt.Sprintf(m.Db.GetQueries().Insert(), rowInfo.TableName, strings.Join(rowInfo.Keys, ","), strings.Join(holders, ","))
 
-		log.Printf("Query: %q", query)
-		log.Printf("Data: %+v", data)
+		m.logger().Debug("Query", "query", query, "data", data)
 		_, err = t.Exec(query, rowInfo.Vals...)
 		if err != nil {
-			log.Print(err)
+			m.logger().Error("Query failed", "query", query, "error", err)
 			t.Rollback()
 			return err
 		} else {
 			if rowInfo.IdIndex != -1 {
-				log.Printf("Table name is [%v]", rowInfo.TableName)
 				idq := m.Db.GetQueries().LastInsertId(rowInfo.SequenceName)
-				log.Printf("Sequence query: %v", idq)
+				m.logger().Debug("Query", "query", idq, "table", rowInfo.TableName)
 				row := t.QueryRow(idq)
 				if err != nil {
-					log.Print(err)
+					m.logger().Error("Query failed", "query", idq, "error", err)
 				} else {
 					var id int64
 					err = row.Scan(&id)
 					if err != nil {
-						log.Print(err)
+						m.logger().Error("Query failed", "query", idq, "error", err)
 					} else {
 						s := reflect.ValueOf(data).Elem()
 						s.Field(rowInfo.IdIndex).SetInt(id)
-						log.Printf("Id was %v", id)
+						m.logger().Debug("Inserted", "table", rowInfo.TableName, "id", id)
 					}
 				}
 			}
@@ -3,6 +3,7 @@ package mysql
 import (
 	"bitbucket.org/jaybill/sawsij/framework/model"
 	"fmt"
+	"github.com/go-sql-driver/mysql"
 	"regexp"
 	"strings"
 )
@@ -100,3 +101,9 @@ func (q *Queries) ParseConnect(connectStr string) (connect map[string]string) {
 
 	return
 }
+
+// Duplicate entries are error 1062, ER_DUP_ENTRY.
+func (q *Queries) IsDuplicateKey(err error) bool {
+	myErr, ok := err.(*mysql.MySQLError)
+	return ok && myErr.Number == 1062
+}
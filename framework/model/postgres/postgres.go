@@ -107,3 +107,11 @@ func (q *Queries) ParseConnect(connectStr string) (connect map[string]string) {
 	return
 
 }
+
+// Unique key violations have SQLSTATE 23505. The driver's errors give fields by their one letter code, and "C" is the SQLSTATE.
+func (q *Queries) IsDuplicateKey(err error) bool {
+	pgErr, ok := err.(interface {
+		Get(k byte) string
+	})
+	return ok && pgErr.Get('C') == "23505"
+}
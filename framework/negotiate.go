@@ -0,0 +1,129 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// The media types that each negotiable return type answers to in an Accept header. The first one is the type the response is sent as.
+var returnTypeMedia = map[int][]string{
+	RT_HTML: []string{"text/html", "application/xhtml+xml"},
+	RT_JSON: []string{"application/json"},
+	RT_XML:  []string{"text/xml", "application/xml"},
+}
+
+// The URL extensions that pick a return type, as in "/posts/list.json".
+var returnTypeExtensions = map[string]int{
+	".html": RT_HTML,
+	".json": RT_JSON,
+	".xml":  RT_XML,
+}
+
+// splitExtension removes a known return type extension from the end of a URL path. If the path doesn't end with one, it is returned
+// unchanged and ext is blank.
+func splitExtension(urlPath string) (stripped string, ext string) {
+	stripped = urlPath
+	e := path.Ext(urlPath)
+	if _, ok := returnTypeExtensions[e]; ok && len(urlPath) > len(e) && !strings.HasSuffix(urlPath, "/"+e) {
+		stripped = urlPath[:len(urlPath)-len(e)]
+		ext = e
+	}
+	return
+}
+
+// An acceptRange is one media range from an Accept header, like "application/json;q=0.9".
+type acceptRange struct {
+	media string
+	q     float64
+}
+
+// parseAccept reads an Accept header into media ranges.
+func parseAccept(header string) (ranges []acceptRange) {
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		media := strings.ToLower(strings.TrimSpace(fields[0]))
+		if media == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		ranges = append(ranges, acceptRange{media, q})
+	}
+	return
+}
+
+// specificity returns how closely a media range from an Accept header matches a media type: 3 for an exact match, 2 for a
+// match like "text/*", 1 for "*/*" and 0 for no match at all.
+func specificity(mediaRange string, media string) int {
+	switch {
+	case mediaRange == media:
+		return 3
+	case strings.HasSuffix(mediaRange, "/*") && strings.HasPrefix(media, mediaRange[:len(mediaRange)-1]):
+		return 2
+	case mediaRange == "*/*" || mediaRange == "*":
+		return 1
+	}
+	return 0
+}
+
+// quality returns the quality the client gave a return type. It comes from the most specific range that matches any of the type's
+// media types, so "text/html;q=0, */*" rules out HTML even though "*/*" also matches it.
+func quality(ranges []acceptRange, returnType int) (q float64) {
+	best := 0
+	for _, media := range returnTypeMedia[returnType] {
+		for _, ar := range ranges {
+			if s := specificity(ar.media, media); s > best || (s == best && s > 0 && ar.q > q) {
+				best = s
+				q = ar.q
+			}
+		}
+	}
+	return
+}
+
+// negotiate picks a return type from allowed for a request. A URL extension like ".json" takes priority, otherwise the Accept header
+// is used. When the client likes more than one type equally, the one listed first in allowed wins. If none of the allowed types are
+// acceptable, ok is false.
+func negotiate(r *http.Request, ext string, allowed []int) (returnType int, ok bool) {
+	if ext != "" {
+		returnType = returnTypeExtensions[ext]
+		for _, a := range allowed {
+			if a == returnType {
+				ok = true
+				return
+			}
+		}
+		return
+	}
+
+	accept := r.Header.Get("Accept")
+	if strings.TrimSpace(accept) == "" {
+		if len(allowed) > 0 {
+			returnType, ok = allowed[0], true
+		}
+		return
+	}
+
+	ranges := parseAccept(accept)
+	best := 0.0
+	for _, a := range allowed {
+		if q := quality(ranges, a); q > best {
+			best = q
+			returnType, ok = a, true
+		}
+	}
+	return
+}
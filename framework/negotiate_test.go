@@ -0,0 +1,107 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestSplitExtension(t *testing.T) {
+	tests := map[string][2]string{
+		"/posts/list.json": {"/posts/list", ".json"},
+		"/posts/list.xml":  {"/posts/list", ".xml"},
+		"/posts/list":      {"/posts/list", ""},
+		"/posts/list.png":  {"/posts/list.png", ""},
+		"/.json":           {"/.json", ""},
+	}
+	for path, expected := range tests {
+		if stripped, ext := splitExtension(path); stripped != expected[0] || ext != expected[1] {
+			t.Errorf("%v: expected %v, got %v %v", path, expected, stripped, ext)
+		}
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	allowed := []int{RT_HTML, RT_JSON}
+	tests := []struct {
+		accept     string
+		ext        string
+		returnType int
+		ok         bool
+	}{
+		{"", "", RT_HTML, true},
+		{"application/json", "", RT_JSON, true},
+		{"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", "", RT_HTML, true},
+		{"application/json, text/javascript, */*; q=0.01", "", RT_JSON, true},
+		{"*/*", "", RT_HTML, true},
+		{"text/html;q=0, */*", "", RT_JSON, true},
+		{"application/xml", "", 0, false},
+		{"text/html", ".json", RT_JSON, true},
+		{"", ".xml", 0, false},
+	}
+
+	for _, test := range tests {
+		r, _ := http.NewRequest("GET", "http://localhost/", nil)
+		if test.accept != "" {
+			r.Header.Set("Accept", test.accept)
+		}
+		returnType, ok := negotiate(r, test.ext, allowed)
+		if ok != test.ok || (ok && returnType != test.returnType) {
+			t.Errorf("Accept %q ext %q: expected %v %v, got %v %v", test.accept, test.ext, test.returnType, test.ok, returnType, ok)
+		}
+	}
+}
+
+func TestRouteNegotiation(t *testing.T) {
+	basePath := standupApp(t, "negotiate", nil, map[string]string{"posts-list.html": "<html><% .posts %></html>"})
+	defer os.RemoveAll(basePath)
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app.Route(RouteConfig{Pattern: "/posts/list", Roles: []int{R_GUEST}, ReturnTypes: []int{RT_HTML, RT_JSON},
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			h.Init()
+			h.View["posts"] = "all"
+			return
+		}})
+
+	tests := []struct {
+		path   string
+		accept string
+		status int
+		body   string
+	}{
+		{"/posts/list", "", http.StatusOK, "<html>all</html>"},
+		{"/posts/list.json", "", http.StatusOK, `"all"`},
+		{"/posts/list", "application/json", http.StatusOK, `"all"`},
+		{"/posts/list.html", "application/json", http.StatusOK, "<html>all</html>"},
+		{"/posts/list", "application/xml", http.StatusNotAcceptable, ""},
+		{"/posts/list.xml", "", http.StatusNotAcceptable, ""},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost"+test.path, nil)
+		if test.accept != "" {
+			r.Header.Set("Accept", test.accept)
+		}
+		app.ServeHTTP(w, r)
+		if w.Code != test.status {
+			t.Errorf("%v %q: expected status %v, got %v", test.path, test.accept, test.status, w.Code)
+			continue
+		}
+		if test.body != "" && w.Body.String() != test.body {
+			t.Errorf("%v %q: expected %q, got %q", test.path, test.accept, test.body, w.Body.String())
+		}
+		if w.Header().Get("Vary") != "Accept" {
+			t.Errorf("%v %q: expected Vary header", test.path, test.accept)
+		}
+	}
+}
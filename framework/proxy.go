@@ -0,0 +1,75 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// trustedProxies are the addresses of reverse proxies whose X-Forwarded-For headers can be believed.
+type trustedProxies []*net.IPNet
+
+// parseTrustedProxies reads a list of IP addresses, like "10.0.0.1", and networks, like "10.0.0.0/8".
+func parseTrustedProxies(list []string) (tp trustedProxies, err error) {
+	for _, s := range list {
+		cidr := s
+		if !strings.Contains(s, "/") {
+			if ip := net.ParseIP(s); ip != nil && ip.To4() != nil {
+				cidr += "/32"
+			} else {
+				cidr += "/128"
+			}
+		}
+		_, network, perr := net.ParseCIDR(cidr)
+		if perr != nil {
+			err = &SawsijError{fmt.Sprintf("%q isn't an IP address or network", s)}
+			return
+		}
+		tp = append(tp, network)
+	}
+	return
+}
+
+// trusts reports whether ip is one of the proxies.
+func (tp trustedProxies) trusts(ip string) bool {
+	parsed := net.ParseIP(strings.TrimSpace(ip))
+	if parsed == nil {
+		return false
+	}
+	for _, network := range tp {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIp returns the address of the client that made the request. If the request came from a trusted proxy, X-Forwarded-For is read
+// from right to left, and the first address that isn't a trusted proxy is the client. Addresses a client put in the header itself are
+// never used, because they're to the left of one that isn't trusted.
+func (tp trustedProxies) clientIp(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	if !tp.trusts(ip) {
+		return ip
+	}
+	forwarded := strings.Split(strings.Join(r.Header["X-Forwarded-For"], ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(forwarded[i])
+		if hop == "" {
+			continue
+		}
+		ip = hop
+		if !tp.trusts(hop) {
+			break
+		}
+	}
+	return ip
+}
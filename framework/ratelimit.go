@@ -0,0 +1,325 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bitbucket.org/jaybill/sawsij/framework/model"
+	"database/sql"
+	"fmt"
+	"github.com/kylelemons/go-gypsy/yaml"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// What requests are counted by when they're rate limited.
+const (
+	// Each client IP address has its own limit.
+	RL_BY_IP = iota
+	// Each logged in user has their own limit. Guests are counted by IP address.
+	RL_BY_USER
+)
+
+// The table the database rate limit store keeps its buckets in, in the default schema.
+const RATE_LIMIT_TABLE = "sawsij_rate_limit"
+
+// A RateLimit lets each client make Requests requests every Per, in bursts of up to Burst. It's a token bucket: a client starts with
+// Burst tokens, every request takes one, and they come back at a steady rate. Requests made when there are none left get a 429
+// response with a Retry-After header.
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+	// The most requests that can be made at once. It's Requests if it isn't set.
+	Burst int
+	// RL_BY_IP or RL_BY_USER.
+	By int
+	// If Key is set, it's used instead of By to say who a request is counted against. Requests it returns an empty string for
+	// aren't limited.
+	Key func(r *http.Request, a *AppScope, user User) string
+	// Only requests with these methods are counted, i.e. []string{"POST"} to limit login attempts but not showing the form. If it's
+	// empty, every request is counted.
+	Methods []string
+}
+
+// valid checks that the limit makes sense.
+func (rl *RateLimit) valid() error {
+	if rl.Requests <= 0 || rl.Per <= 0 || rl.Burst < 0 {
+		return &SawsijError{fmt.Sprintf("Rate limit of %v requests per %v with a burst of %v isn't valid", rl.Requests, rl.Per, rl.Burst)}
+	}
+	if rl.By != RL_BY_IP && rl.By != RL_BY_USER {
+		return &SawsijError{fmt.Sprintf("Rate limit By must be RL_BY_IP or RL_BY_USER, not %v", rl.By)}
+	}
+	return nil
+}
+
+// rate returns how many tokens come back every second.
+func (rl *RateLimit) rate() float64 {
+	return float64(rl.Requests) / rl.Per.Seconds()
+}
+
+// burst returns the size of the bucket.
+func (rl *RateLimit) burst() int {
+	if rl.Burst == 0 {
+		return rl.Requests
+	}
+	return rl.Burst
+}
+
+// A RateLimitStore keeps the token buckets. The default one keeps them in memory, so each process has its own. Use
+// NewDbRateLimitStore() to share them between processes through the application's database.
+type RateLimitStore interface {
+	// Take removes a token from the bucket called key, which holds up to burst tokens and gets rate of them back every second. If the
+	// bucket is empty, it returns false and how long it will be until there's a token in it.
+	Take(key string, rate float64, burst int) (ok bool, retryAfter time.Duration, err error)
+}
+
+// takeToken works out what happens to a bucket that had tokens in it at last when a request is made at now. It returns the tokens
+// left in the bucket afterwards.
+func takeToken(tokens float64, last time.Time, now time.Time, rate float64, burst int) (left float64, ok bool, retryAfter time.Duration) {
+	if elapsed := now.Sub(last); elapsed > 0 {
+		tokens = math.Min(float64(burst), tokens+elapsed.Seconds()*rate)
+	}
+	if tokens >= 1 {
+		return tokens - 1, true, 0
+	}
+	return tokens, false, time.Duration((1 - tokens) / rate * float64(time.Second))
+}
+
+// A tokenBucket is a bucket kept by the memory store.
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+	rate    float64
+	burst   int
+}
+
+// memoryRateLimitStore keeps buckets in a map. Buckets that have filled up again are swept out now and then.
+type memoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+	// Where the time comes from. It's only replaced by tests.
+	now func() time.Time
+}
+
+// NewMemoryRateLimitStore returns a RateLimitStore that keeps its buckets in memory. It's what's used unless the config file or
+// AppSetup says otherwise.
+func NewMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{buckets: make(map[string]*tokenBucket), now: time.Now}
+}
+
+func (ms *memoryRateLimitStore) Take(key string, rate float64, burst int) (ok bool, retryAfter time.Duration, err error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	now := ms.now()
+	if now.Sub(ms.lastSweep) > time.Minute {
+		ms.sweep(now)
+	}
+	b := ms.buckets[key]
+	if b == nil {
+		b = &tokenBucket{tokens: float64(burst), updated: now}
+		ms.buckets[key] = b
+	}
+	b.rate, b.burst = rate, burst
+	b.tokens, ok, retryAfter = takeToken(b.tokens, b.updated, now, rate, burst)
+	b.updated = now
+	return
+}
+
+// sweep forgets buckets that would be full by now, since a new bucket is just the same.
+func (ms *memoryRateLimitStore) sweep(now time.Time) {
+	for key, b := range ms.buckets {
+		if b.tokens+now.Sub(b.updated).Seconds()*b.rate >= float64(b.burst) {
+			delete(ms.buckets, key)
+		}
+	}
+	ms.lastSweep = now
+}
+
+// dbRateLimitStore keeps buckets in the RATE_LIMIT_TABLE table.
+type dbRateLimitStore struct {
+	dbs *model.DbSetup
+}
+
+// NewDbRateLimitStore returns a RateLimitStore that keeps its buckets in the database, so every process using the database shares
+// them. The RATE_LIMIT_TABLE table has to exist in the default schema; the documentation for Run() has the SQL to create it. Each
+// row is a bucket, with updated_on in nanoseconds since 1970.
+func NewDbRateLimitStore(dbs *model.DbSetup) RateLimitStore {
+	return &dbRateLimitStore{dbs: dbs}
+}
+
+func (ds *dbRateLimitStore) Take(key string, rate float64, burst int) (ok bool, retryAfter time.Duration, err error) {
+	ok, retryAfter, err = ds.take(key, rate, burst)
+	if err == errBucketCreated {
+		// Another process made the bucket first, so take from that one.
+		ok, retryAfter, err = ds.take(key, rate, burst)
+	}
+	return
+}
+
+var errBucketCreated = &SawsijError{"The rate limit bucket was created by another process"}
+
+// take does one attempt at taking a token, locking the bucket's row until it's done.
+func (ds *dbRateLimitStore) take(key string, rate float64, burst int) (ok bool, retryAfter time.Duration, err error) {
+	q := ds.dbs.GetQueries()
+	table := q.TableName(ds.dbs.DefaultSchema, RATE_LIMIT_TABLE)
+	tx, err := ds.dbs.Db.Begin()
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	now := time.Now()
+	tokens := float64(burst)
+	var updated int64
+	err = tx.QueryRow(fmt.Sprintf("SELECT tokens, updated_on FROM %v WHERE bucket = %v FOR UPDATE", table, q.P(1)), key).Scan(&tokens, &updated)
+	exists := err == nil
+	if err == sql.ErrNoRows {
+		updated, err = now.UnixNano(), nil
+	}
+	if err != nil {
+		return
+	}
+
+	tokens, ok, retryAfter = takeToken(tokens, time.Unix(0, updated), now, rate, burst)
+	if exists {
+		_, err = tx.Exec(fmt.Sprintf("UPDATE %v SET tokens = %v, updated_on = %v WHERE bucket = %v", table, q.P(1), q.P(2), q.P(3)),
+			tokens, now.UnixNano(), key)
+	} else if _, err = tx.Exec(fmt.Sprintf("INSERT INTO %v (bucket, tokens, updated_on) VALUES (%v, %v, %v)", table, q.P(1), q.P(2), q.P(3)),
+		key, tokens, now.UnixNano()); err != nil && q.IsDuplicateKey(err) {
+		// On PostgreSQL the failed INSERT has aborted the transaction, so Take() tries again in a new one.
+		err = errBucketCreated
+	}
+	return
+}
+
+// limiter applies the global rate limit and the ones on routes.
+type limiter struct {
+	store  RateLimitStore
+	global *RateLimit
+}
+
+// newLimiter sets up rate limiting from the "server.rateLimit" section of the config file. The store is the one in the AppSetup if
+// there is one, otherwise server.rateLimit.store picks memory or db.
+func newLimiter(c *yaml.File, as *AppSetup, dbs *model.DbSetup) (l *limiter, err error) {
+	l = &limiter{store: as.RateLimitStore}
+	if l.store == nil {
+		store, serr := configString(c, "server.rateLimit.store", "memory")
+		if serr != nil {
+			return nil, serr
+		}
+		switch strings.ToLower(store) {
+		case "memory":
+			l.store = NewMemoryRateLimitStore()
+		case "db":
+			if dbs == nil {
+				return nil, &SawsijError{"Config value server.rateLimit.store is db, but there's no database"}
+			}
+			l.store = NewDbRateLimitStore(dbs)
+		default:
+			return nil, &SawsijError{fmt.Sprintf("Config value server.rateLimit.store must be memory or db, not %q", store)}
+		}
+	}
+
+	requests, err := configInt(c, "server.rateLimit.requests", 0)
+	if err != nil || requests == 0 {
+		return
+	}
+	global := &RateLimit{Requests: requests}
+	if global.Per, err = configDuration(c, "server.rateLimit.per", time.Minute); err != nil {
+		return
+	}
+	if global.Burst, err = configInt(c, "server.rateLimit.burst", 0); err != nil {
+		return
+	}
+	by, err := configString(c, "server.rateLimit.by", "ip")
+	if err != nil {
+		return
+	}
+	switch strings.ToLower(by) {
+	case "ip":
+		global.By = RL_BY_IP
+	case "user":
+		global.By = RL_BY_USER
+	default:
+		return nil, &SawsijError{fmt.Sprintf("Config value server.rateLimit.by must be ip or user, not %q", by)}
+	}
+	if err = global.valid(); err != nil {
+		return
+	}
+	l.global = global
+	return
+}
+
+// rateLimitKey returns who the request is counted against under rl, or an empty string if it isn't counted.
+func (app *App) rateLimitKey(rl *RateLimit, r *http.Request, user User) string {
+	if len(rl.Methods) > 0 {
+		counted := false
+		for _, method := range rl.Methods {
+			if strings.EqualFold(method, r.Method) {
+				counted = true
+			}
+		}
+		if !counted {
+			return ""
+		}
+	}
+	if rl.Key != nil {
+		return rl.Key(r, app.AppScope, user)
+	}
+	if rl.By == RL_BY_USER {
+		if name := username(user); name != "" {
+			return "user:" + name
+		}
+	}
+	return "ip:" + app.proxies.clientIp(r)
+}
+
+// rateLimited takes a token for the request from the global limit and the route's own, if they apply. If either is used up, it
+// returns true and how long the client should wait. When the store fails, the request is let through.
+func (app *App) rateLimited(r *http.Request, rcfg *RouteConfig, user User) (limited bool, retryAfter time.Duration) {
+	limits := []struct {
+		scope string
+		rl    *RateLimit
+	}{{"*", app.limiter.global}, {strings.Join(rcfg.Methods, ",") + " " + rcfg.Pattern, rcfg.RateLimit}}
+	for _, limit := range limits {
+		if limit.rl == nil {
+			continue
+		}
+		key := app.rateLimitKey(limit.rl, r, user)
+		if key == "" {
+			continue
+		}
+		ok, wait, err := app.limiter.store.Take(limit.scope+" "+key, limit.rl.rate(), limit.rl.burst())
+		if err != nil {
+			app.Log.Error("Rate limit store failed", "error", err)
+			continue
+		}
+		if !ok {
+			limited = true
+			if wait > retryAfter {
+				retryAfter = wait
+			}
+		}
+	}
+	return
+}
+
+// retryAfterSeconds turns a wait into the whole number of seconds sent in a Retry-After header.
+func retryAfterSeconds(wait time.Duration) int {
+	secs := int(math.Ceil(wait.Seconds()))
+	if secs < 1 {
+		secs = 1
+	}
+	return secs
+}
@@ -0,0 +1,159 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"github.com/kylelemons/go-gypsy/yaml"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestMemoryRateLimitStore(t *testing.T) {
+	now := time.Unix(1000, 0)
+	ms := NewMemoryRateLimitStore().(*memoryRateLimitStore)
+	ms.now = func() time.Time { return now }
+
+	// Two requests a second, in bursts of up to three.
+	take := func() (bool, time.Duration) {
+		ok, wait, err := ms.Take("a", 2, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ok, wait
+	}
+	for i := 0; i < 3; i++ {
+		if ok, _ := take(); !ok {
+			t.Fatalf("Expected request %v of the burst to be allowed", i+1)
+		}
+	}
+	if ok, wait := take(); ok || wait != 500*time.Millisecond {
+		t.Errorf("Expected to wait 500ms, got %v %v", ok, wait)
+	}
+	if ok, _, _ := ms.Take("b", 2, 3); !ok {
+		t.Error("Expected another key to have its own bucket")
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if ok, _ := take(); !ok {
+		t.Error("Expected a token to have come back")
+	}
+	if ok, _ := take(); ok {
+		t.Error("Expected the bucket to be empty again")
+	}
+
+	// Full buckets are forgotten.
+	now = now.Add(time.Hour)
+	take()
+	if len(ms.buckets) != 1 {
+		t.Errorf("Expected the full bucket to be swept, got %v buckets", len(ms.buckets))
+	}
+}
+
+func TestRateLimitConfig(t *testing.T) {
+	tests := []struct {
+		config string
+		valid  bool
+		global bool
+	}{
+		{"", true, false},
+		{"server:\n  rateLimit:\n    requests: 10\n", true, true},
+		{"server:\n  rateLimit:\n    requests: 10\n    per: 1s\n    by: user\n", true, true},
+		{"server:\n  rateLimit:\n    requests: 10\n    by: cookie\n", false, false},
+		{"server:\n  rateLimit:\n    store: redis\n", false, false},
+		{"server:\n  rateLimit:\n    store: db\n", false, false},
+	}
+	for _, test := range tests {
+		l, err := newLimiter(yaml.Config(test.config), &AppSetup{}, nil)
+		if (err == nil) != test.valid {
+			t.Errorf("%q: expected valid to be %v, got %v", test.config, test.valid, err)
+			continue
+		}
+		if err == nil && (l.global != nil) != test.global {
+			t.Errorf("%q: expected a global limit to be %v", test.config, test.global)
+		}
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	basePath := standupApp(t, "ratelimit", []string{"rateLimit:", "  requests: 5", "  per: 1h"}, map[string]string{"index.html": "hello"})
+	defer os.RemoveAll(basePath)
+	app, err := NewApp(testRoleSetup(), basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loginAs *authTestUser
+	app.Route(RouteConfig{Pattern: "/login", Permission: "site.view",
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			rs.Session.Values["user"] = loginAs
+			h.Redirect = "/"
+			return
+		}})
+	app.Route(RouteConfig{Pattern: "/", Permission: "site.view", Handler: testHandler})
+	app.Route(RouteConfig{Pattern: "/api", Permission: "site.view", Handler: testHandler, ReturnType: RT_JSON,
+		RateLimit: &RateLimit{Requests: 2, Per: time.Hour, By: RL_BY_USER}})
+	app.Route(RouteConfig{Pattern: "/keyed", Permission: "site.view", Handler: testHandler, ReturnType: RT_JSON,
+		RateLimit: &RateLimit{Requests: 1, Per: time.Hour, Key: func(r *http.Request, a *AppScope, u User) string {
+			return r.URL.Query().Get("key")
+		}}})
+
+	request := func(path string, ip string, cookie string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost"+path, nil)
+		r.RemoteAddr = ip + ":1234"
+		if cookie != "" {
+			r.Header.Set("Cookie", cookie)
+		}
+		app.ServeHTTP(w, r)
+		return w
+	}
+	loginAs = &authTestUser{"alice", []int64{testMember}}
+	alice := request("/login", "10.0.0.1", "").Header().Get("Set-Cookie")
+	loginAs = &authTestUser{"bob", []int64{testMember}}
+	bob := request("/login", "10.0.0.2", "").Header().Get("Set-Cookie")
+
+	// The route limit counts alice and bob separately, even from the same address.
+	for i := 0; i < 2; i++ {
+		if w := request("/api", "10.0.0.3", alice); w.Code != http.StatusOK {
+			t.Fatalf("Expected alice's request %v to be allowed, got %v", i+1, w.Code)
+		}
+	}
+	w := request("/api", "10.0.0.3", alice)
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected 429, got %v", w.Code)
+	}
+	if w.Header().Get("Retry-After") != "1800" {
+		t.Errorf("Expected Retry-After of 1800, got %q", w.Header().Get("Retry-After"))
+	}
+	if w.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("Expected a JSON error for a JSON route, got %q", w.Header().Get("Content-Type"))
+	}
+	if w := request("/api", "10.0.0.3", bob); w.Code != http.StatusOK {
+		t.Errorf("Expected bob to have a separate limit, got %v", w.Code)
+	}
+
+	// The global limit of 5 is counted by IP address, so 10.0.0.3 has one request left after the four above.
+	if w := request("/", "10.0.0.3", ""); w.Code != http.StatusOK {
+		t.Errorf("Expected the fifth request to be allowed, got %v", w.Code)
+	}
+	if w := request("/", "10.0.0.3", ""); w.Code != http.StatusTooManyRequests {
+		t.Errorf("Expected the global limit to be reached, got %v", w.Code)
+	}
+	if w := request("/static/site.css", "10.0.0.3", ""); w.Code == http.StatusTooManyRequests {
+		t.Error("Expected static files not to be limited")
+	}
+
+	if w := request("/keyed?key=x", "10.0.0.4", ""); w.Code != http.StatusOK {
+		t.Errorf("Expected the first keyed request to be allowed, got %v", w.Code)
+	}
+	if w := request("/keyed?key=x", "10.0.0.5", ""); w.Code != http.StatusTooManyRequests {
+		t.Errorf("Expected the key to be limited from any address, got %v", w.Code)
+	}
+	if w := request("/keyed", "10.0.0.5", ""); w.Code != http.StatusOK {
+		t.Errorf("Expected requests without a key not to be limited by the route, got %v", w.Code)
+	}
+}
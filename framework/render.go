@@ -0,0 +1,152 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// The root element used for XML responses when the RouteConfig doesn't specify one and the view has more than one entry.
+const DEFAULT_XML_ROOT = "response"
+
+// renderable takes the View from a HandlerResponse and returns what should actually be marshalled. If the map only has one entry,
+// the value of that entry is returned along with its key, otherwise the whole map is returned and the key is blank.
+func renderable(view map[string]interface{}) (v interface{}, key string) {
+	if len(view) == 1 {
+		for k, value := range view {
+			key = k
+			v = value
+		}
+		return
+	}
+	v = view
+	return
+}
+
+// marshalXml marshals v as an XML document with an element called root at the top. Unlike xml.Marshal, it can deal with maps
+// and slices of interface{} (like the ones returned by model.Table.FetchAll). Map entries become elements named after their keys,
+// in key order, and slice entries become elements named after the type of the entry, or "item" if the entry isn't a struct.
+// Structs are handed to encoding/xml as they are, so any xml struct tags will be honoured.
+func marshalXml(v interface{}, root string) (b []byte, err error) {
+	start := xml.StartElement{Name: xml.Name{Local: xmlElementName(root)}}
+	b, err = xml.Marshal(xmlStartValue{xmlValue{v}, start})
+	return
+}
+
+// xmlStartValue lets marshalXml control the name of the root element.
+type xmlStartValue struct {
+	value xmlValue
+	start xml.StartElement
+}
+
+func (x xmlStartValue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return x.value.MarshalXML(e, x.start)
+}
+
+// xmlValue wraps any value in a view so that maps and slices are written element by element.
+type xmlValue struct {
+	v interface{}
+}
+
+func (x xmlValue) MarshalXML(e *xml.Encoder, start xml.StartElement) (err error) {
+	rv := reflect.ValueOf(x.v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			rv = reflect.Value{}
+			break
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Invalid:
+		// nil values are written as empty elements
+		if err = e.EncodeToken(start); err != nil {
+			return
+		}
+		err = e.EncodeToken(start.End())
+	case reflect.Map:
+		keys := make([]string, 0, rv.Len())
+		values := make(map[string]reflect.Value, rv.Len())
+		for _, k := range rv.MapKeys() {
+			ks := fmt.Sprintf("%v", k.Interface())
+			keys = append(keys, ks)
+			values[ks] = rv.MapIndex(k)
+		}
+		sort.Strings(keys)
+
+		if err = e.EncodeToken(start); err != nil {
+			return
+		}
+		for _, k := range keys {
+			el := xml.StartElement{Name: xml.Name{Local: xmlElementName(k)}}
+			if err = e.EncodeElement(xmlValue{values[k].Interface()}, el); err != nil {
+				return
+			}
+		}
+		err = e.EncodeToken(start.End())
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			// encoding/xml already knows how to write []byte
+			err = e.EncodeElement(x.v, start)
+			return
+		}
+
+		if err = e.EncodeToken(start); err != nil {
+			return
+		}
+		for i := 0; i < rv.Len(); i++ {
+			item := rv.Index(i).Interface()
+			el := xml.StartElement{Name: xml.Name{Local: xmlItemName(item)}}
+			if err = e.EncodeElement(xmlValue{item}, el); err != nil {
+				return
+			}
+		}
+		err = e.EncodeToken(start.End())
+	default:
+		err = e.EncodeElement(x.v, start)
+	}
+	return
+}
+
+// xmlItemName returns the element name used for an entry in a slice. Structs are named after their type, everything else is an "item".
+func xmlItemName(item interface{}) (name string) {
+	name = "item"
+	t := reflect.TypeOf(item)
+	if t == nil {
+		return
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Struct && t.Name() != "" {
+		name = t.Name()
+	}
+	return
+}
+
+// xmlElementName turns an arbitrary string (like a View key) into a valid XML element name. Anything that isn't a letter, digit,
+// underscore, dash or dot is replaced with an underscore, and names that can't start an element are prefixed with one.
+func xmlElementName(s string) string {
+	if s == "" {
+		return "item"
+	}
+	name := []rune(s)
+	for i, r := range name {
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '.') {
+			name[i] = '_'
+		}
+	}
+	n := string(name)
+	if !(unicode.IsLetter(name[0]) || name[0] == '_') || strings.HasPrefix(strings.ToLower(n), "xml") {
+		n = "_" + n
+	}
+	return n
+}
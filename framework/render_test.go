@@ -0,0 +1,77 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"testing"
+	"time"
+)
+
+type xmlTestPost struct {
+	Id        int64
+	Title     string
+	CreatedOn time.Time
+}
+
+func TestRenderableUnwrapsSingleEntry(t *testing.T) {
+	v, key := renderable(map[string]interface{}{"posts": "a"})
+	if key != "posts" || v != "a" {
+		t.Fatalf("Expected single entry to be unwrapped, got %q: %v", key, v)
+	}
+
+	view := map[string]interface{}{"a": 1, "b": 2}
+	v, key = renderable(view)
+	if key != "" {
+		t.Fatalf("Expected no key for multiple entries, got %q", key)
+	}
+	if _, ok := v.(map[string]interface{}); !ok {
+		t.Fatalf("Expected whole map, got %T", v)
+	}
+}
+
+func TestMarshalXmlFetchAllResults(t *testing.T) {
+	created := time.Date(2013, 4, 1, 12, 0, 0, 0, time.UTC)
+	posts := []interface{}{
+		&xmlTestPost{Id: 1, Title: "First", CreatedOn: created},
+		&xmlTestPost{Id: 2, Title: "Second & last", CreatedOn: created},
+	}
+
+	b, err := marshalXml(posts, "posts")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "<posts>" +
+		"<xmlTestPost><Id>1</Id><Title>First</Title><CreatedOn>2013-04-01T12:00:00Z</CreatedOn></xmlTestPost>" +
+		"<xmlTestPost><Id>2</Id><Title>Second &amp; last</Title><CreatedOn>2013-04-01T12:00:00Z</CreatedOn></xmlTestPost>" +
+		"</posts>"
+	if string(b) != expected {
+		t.Fatalf("Expected %v, got %v", expected, string(b))
+	}
+}
+
+func TestMarshalXmlMap(t *testing.T) {
+	view := map[string]interface{}{
+		"title":    "Hello",
+		"tags":     []interface{}{"go", "xml"},
+		"2 counts": map[string]int{"b": 2, "a": 1},
+		"missing":  nil,
+	}
+
+	b, err := marshalXml(view, DEFAULT_XML_ROOT)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "<response>" +
+		"<_2_counts><a>1</a><b>2</b></_2_counts>" +
+		"<missing></missing>" +
+		"<tags><item>go</item><item>xml</item></tags>" +
+		"<title>Hello</title>" +
+		"</response>"
+	if string(b) != expected {
+		t.Fatalf("Expected %v, got %v", expected, string(b))
+	}
+}
@@ -0,0 +1,335 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"fmt"
+	"github.com/gorilla/context"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type contextKey int
+
+// Keys used to store request data in the gorilla context.
+const (
+	pathParamsKey contextKey = iota
+	routePathKey
+	extensionKey
+	// The username of the logged in user, for the access log.
+	usernameKey
+	// The pattern of the route, or the static mount path, that handled the request, for the metrics.
+	patternKey
+)
+
+// ParamTypes maps the constraint names that can be used in route patterns, like "{id:int}", to the regular expressions a URL
+// segment has to match. Any constraint that isn't in this map is treated as a regular expression itself, so "{year:[0-9]{4}}" works too.
+var ParamTypes = map[string]string{
+	"int":   `-?[0-9]+`,
+	"alpha": `[A-Za-z]+`,
+	"alnum": `[A-Za-z0-9]+`,
+	"hex":   `[A-Fa-f0-9]+`,
+	"slug":  `[A-Za-z0-9_-]+`,
+}
+
+// A segment is one slash-separated part of a route pattern. It's either a literal that has to match exactly or a named parameter.
+type segment struct {
+	literal string
+	param   string
+	match   *regexp.Regexp
+}
+
+// A route is a parsed pattern and the handlers that serve it.
+type route struct {
+	pattern  string
+	segments []segment
+	// Set if the pattern has named parameters. Those patterns have to match the whole path, while patterns without them match
+	// any path below them, so that key/value URL params keep working.
+	hasParams bool
+	// Handlers for specific HTTP methods.
+	handlers map[string]http.Handler
+	// The handler for any method that isn't in handlers, if one was registered without any methods.
+	anyHandler http.Handler
+	// Set if the route negotiates its return type, which lets it match paths ending in an extension like ".json".
+	extensions bool
+}
+
+// A router sends requests to the route whose pattern matches the request path. Unlike http.ServeMux, it understands named
+// parameters and will not hand a request to "/" just because nothing else matched.
+type router struct {
+	routes []*route
+	mounts map[string]http.Handler
+	// Tried when no route matches, before giving up with a 404. If it's an http.ServeMux, it's only used when it has a pattern that
+	// matches the request.
+	fallback http.Handler
+}
+
+func newRouter() *router {
+	return &router{mounts: make(map[string]http.Handler)}
+}
+
+// parsePattern splits a pattern into segments, compiling any parameter constraints.
+func parsePattern(pattern string) (rt *route, err error) {
+	if !strings.HasPrefix(pattern, "/") {
+		err = &SawsijError{fmt.Sprintf("Pattern %q must start with a slash", pattern)}
+		return
+	}
+
+	rt = &route{pattern: pattern}
+	seen := make(map[string]bool)
+
+	for _, part := range splitPath(pattern) {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			name := part[1 : len(part)-1]
+			expr := `[^/]+`
+			if i := strings.Index(name, ":"); i != -1 {
+				expr = name[i+1:]
+				name = name[:i]
+				if t, ok := ParamTypes[expr]; ok {
+					expr = t
+				}
+			}
+
+			if name == "" {
+				err = &SawsijError{fmt.Sprintf("Pattern %q has a parameter with no name", pattern)}
+				return
+			}
+			if seen[name] {
+				err = &SawsijError{fmt.Sprintf("Pattern %q uses parameter %q more than once", pattern, name)}
+				return
+			}
+			seen[name] = true
+
+			re, cerr := regexp.Compile("^(?:" + expr + ")$")
+			if cerr != nil {
+				err = &SawsijError{fmt.Sprintf("Pattern %q has an invalid constraint for %q: %v", pattern, name, cerr)}
+				return
+			}
+			rt.segments = append(rt.segments, segment{param: name, match: re})
+			rt.hasParams = true
+		} else if strings.ContainsAny(part, "{}") {
+			err = &SawsijError{fmt.Sprintf("Pattern %q has a parameter that isn't a whole path segment", pattern)}
+			return
+		} else {
+			rt.segments = append(rt.segments, segment{literal: part})
+		}
+	}
+	return
+}
+
+// splitPath returns the non-empty segments of a URL path.
+func splitPath(path string) (parts []string) {
+	for _, p := range strings.Split(path, "/") {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return
+}
+
+// match tests the route against the segments of a request path. It returns whether it matched, any named parameters and a score
+// used to pick the most specific route when more than one matches.
+func (rt *route) match(parts []string) (ok bool, params map[string]string, score int) {
+	// "/" only matches itself, otherwise it would match everything.
+	exact := rt.hasParams || len(rt.segments) == 0
+	if len(parts) < len(rt.segments) || (exact && len(parts) != len(rt.segments)) {
+		return
+	}
+
+	params = make(map[string]string)
+	for i, s := range rt.segments {
+		if s.match != nil {
+			if !s.match.MatchString(parts[i]) {
+				return false, nil, 0
+			}
+			params[s.param] = parts[i]
+		} else {
+			if s.literal != parts[i] {
+				return false, nil, 0
+			}
+			score += 2
+		}
+		score += 2
+	}
+
+	// Whole path matches beat prefix matches of the same length.
+	if len(parts) == len(rt.segments) {
+		score++
+	}
+	ok = true
+	return
+}
+
+// add registers a handler for a pattern and a set of HTTP methods. If no methods are given, the handler will be used for any
+// method that doesn't have a handler of its own. Calling add again with the same pattern and different methods adds to the route.
+func (rr *router) add(pattern string, methods []string, h http.Handler) (rt *route, err error) {
+	for _, existing := range rr.routes {
+		if existing.pattern == pattern {
+			rt = existing
+			break
+		}
+	}
+
+	if rt == nil {
+		rt, err = parsePattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		rt.handlers = make(map[string]http.Handler)
+		rr.routes = append(rr.routes, rt)
+	}
+
+	if len(methods) == 0 {
+		rt.anyHandler = h
+	}
+	for _, method := range methods {
+		rt.handlers[strings.ToUpper(method)] = h
+	}
+	return
+}
+
+// allowed returns the methods a route will answer, for use in the Allow header.
+func (rt *route) allowed() string {
+	methods := []string{"OPTIONS"}
+	for method := range rt.handlers {
+		if method != "OPTIONS" && method != "HEAD" {
+			methods = append(methods, method)
+		}
+	}
+	if _, ok := rt.handlers["GET"]; ok {
+		methods = append(methods, "HEAD")
+	} else if _, ok := rt.handlers["HEAD"]; ok {
+		methods = append(methods, "HEAD")
+	}
+	sort.Strings(methods)
+	return strings.Join(methods, ", ")
+}
+
+// handler picks the handler for a request method. HEAD requests are answered by the GET handler if there isn't a HEAD handler,
+// and OPTIONS requests are answered automatically if there isn't an OPTIONS handler. If the route doesn't accept the method,
+// the response is a 405.
+func (rt *route) handler(method string) http.Handler {
+	if h, ok := rt.handlers[method]; ok {
+		return h
+	}
+	if method == "HEAD" {
+		if h, ok := rt.handlers["GET"]; ok {
+			return h
+		}
+	}
+	if rt.anyHandler != nil {
+		return rt.anyHandler
+	}
+
+	allow := rt.allowed()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", allow)
+		if r.Method == "OPTIONS" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	})
+}
+
+// mount registers a handler for everything under prefix, exactly as it's passed in. Used for things like static files.
+func (rr *router) mount(prefix string, h http.Handler) {
+	rr.mounts[prefix] = h
+}
+
+// find returns the mounted handler or the route for a path, along with any named parameters from the path.
+func (rr *router) find(path string) (rt *route, mh http.Handler, params map[string]string) {
+	best := -1
+	for prefix, h := range rr.mounts {
+		if strings.HasPrefix(path, prefix) && len(prefix) > best {
+			best = len(prefix)
+			mh = h
+		}
+	}
+	if mh != nil {
+		return
+	}
+
+	parts := splitPath(path)
+	for _, candidate := range rr.routes {
+		if ok, p, score := candidate.match(parts); ok && score > best {
+			best = score
+			rt = candidate
+			params = p
+		}
+	}
+	return
+}
+
+// lookup finds the handler for a request, along with any named parameters from the path. If the path ends with an extension like
+// ".json" and the path without it matches a route that negotiates its return type, that route is used and the extension is kept in the
+// request context.
+func (rr *router) lookup(r *http.Request) (h http.Handler, params map[string]string) {
+	if stripped, ext := splitExtension(r.URL.Path); ext != "" {
+		if rt, _, p := rr.find(stripped); rt != nil && rt.extensions {
+			context.Set(r, routePathKey, stripped)
+			context.Set(r, extensionKey, ext)
+			return rt.handler(r.Method), p
+		}
+	}
+
+	rt, h, params := rr.find(r.URL.Path)
+	if rt != nil {
+		h = rt.handler(r.Method)
+	}
+	return
+}
+
+// ServeHTTP finds the handler for a request and calls it. If nothing matches, the response is a 404.
+func (rr *router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h, params := rr.lookup(r)
+
+	if h == nil {
+		if mux, ok := rr.fallback.(*http.ServeMux); ok {
+			if mh, pattern := mux.Handler(r); pattern != "" {
+				mh.ServeHTTP(w, r)
+				return
+			}
+		} else if rr.fallback != nil {
+			rr.fallback.ServeHTTP(w, r)
+			return
+		}
+		http.NotFound(w, r)
+		return
+	}
+
+	if len(params) > 0 {
+		context.Set(r, pathParamsKey, params)
+	}
+	h.ServeHTTP(w, r)
+}
+
+// getPathParams returns the named parameters the router found in the request path.
+func getPathParams(r *http.Request) (params map[string]string) {
+	if p, ok := context.GetOk(r, pathParamsKey); ok {
+		params = p.(map[string]string)
+	} else {
+		params = make(map[string]string)
+	}
+	return
+}
+
+// getRoutePath returns the path that was used to find the route for a request. It's the URL path without any return type extension.
+func getRoutePath(r *http.Request) string {
+	if p, ok := context.GetOk(r, routePathKey); ok {
+		return p.(string)
+	}
+	return r.URL.Path
+}
+
+// getExtension returns the return type extension, like ".json", that was removed from the request path, if there was one.
+func getExtension(r *http.Request) string {
+	if e, ok := context.GetOk(r, extensionKey); ok {
+		return e.(string)
+	}
+	return ""
+}
@@ -0,0 +1,114 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"fmt"
+	"github.com/gorilla/context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func namedHandler(name string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%v %v", name, getPathParams(r))
+	})
+}
+
+func TestParsePatternErrors(t *testing.T) {
+	bad := []string{"posts", "/posts/{}", "/posts/{id}/{id}", "/posts/{id:[}", "/posts/id{id}"}
+	for _, pattern := range bad {
+		if _, err := parsePattern(pattern); err == nil {
+			t.Errorf("Expected an error for pattern %q", pattern)
+		}
+	}
+}
+
+func TestRouterMatching(t *testing.T) {
+	rr := newRouter()
+	rr.add("/", nil, namedHandler("index"))
+	rr.add("/admin/users", nil, namedHandler("users"))
+	rr.add("/admin/users/edit", nil, namedHandler("edit"))
+	rr.add("/posts/{id:int}/comments/{slug}", nil, namedHandler("comment"))
+	rr.add("/posts/{id:int}", nil, namedHandler("post"))
+	rr.add("/posts/new", nil, namedHandler("new"))
+	rr.add("/archive/{year:[0-9]{4}}", nil, namedHandler("archive"))
+	rr.mount("/static/", namedHandler("static"))
+	h := context.ClearHandler(rr)
+
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{"/", http.StatusOK, "index map[]"},
+		{"/admin/users", http.StatusOK, "users map[]"},
+		{"/admin/users/", http.StatusOK, "users map[]"},
+		{"/admin/users/edit/id/14", http.StatusOK, "edit map[]"},
+		{"/admin/users/page/2", http.StatusOK, "users map[]"},
+		{"/posts/14", http.StatusOK, "post map[id:14]"},
+		{"/posts/new", http.StatusOK, "new map[]"},
+		{"/posts/14/comments/first-post", http.StatusOK, "comment map[id:14 slug:first-post]"},
+		{"/archive/2013", http.StatusOK, "archive map[year:2013]"},
+		{"/static/css/site.css", http.StatusOK, "static map[]"},
+		{"/posts/abc", http.StatusNotFound, ""},
+		{"/posts/14/extra", http.StatusNotFound, ""},
+		{"/archive/13", http.StatusNotFound, ""},
+		{"/nothing/here", http.StatusNotFound, ""},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost"+test.path, nil)
+		h.ServeHTTP(w, r)
+		if w.Code != test.status {
+			t.Errorf("%v: expected status %v, got %v", test.path, test.status, w.Code)
+			continue
+		}
+		if test.body != "" && w.Body.String() != test.body {
+			t.Errorf("%v: expected %q, got %q", test.path, test.body, w.Body.String())
+		}
+	}
+}
+
+func TestRouterMethods(t *testing.T) {
+	rr := newRouter()
+	rr.add("/admin/users/edit", []string{"GET"}, namedHandler("form"))
+	rr.add("/admin/users/edit", []string{"post"}, namedHandler("save"))
+	rr.add("/admin/users/delete", []string{"POST"}, namedHandler("delete"))
+	h := context.ClearHandler(rr)
+
+	tests := []struct {
+		method string
+		path   string
+		status int
+		body   string
+		allow  string
+	}{
+		{"GET", "/admin/users/edit/id/14", http.StatusOK, "form map[]", ""},
+		{"POST", "/admin/users/edit/id/14", http.StatusOK, "save map[]", ""},
+		{"HEAD", "/admin/users/edit", http.StatusOK, "form map[]", ""},
+		{"OPTIONS", "/admin/users/edit", http.StatusOK, "", "GET, HEAD, OPTIONS, POST"},
+		{"PUT", "/admin/users/edit", http.StatusMethodNotAllowed, "", "GET, HEAD, OPTIONS, POST"},
+		{"GET", "/admin/users/delete/id/14", http.StatusMethodNotAllowed, "", "OPTIONS, POST"},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(test.method, "http://localhost"+test.path, nil)
+		h.ServeHTTP(w, r)
+		if w.Code != test.status {
+			t.Errorf("%v %v: expected status %v, got %v", test.method, test.path, test.status, w.Code)
+			continue
+		}
+		if test.body != "" && w.Body.String() != test.body {
+			t.Errorf("%v %v: expected %q, got %q", test.method, test.path, test.body, w.Body.String())
+		}
+		if allow := w.Header().Get("Allow"); allow != test.allow {
+			t.Errorf("%v %v: expected Allow header %q, got %q", test.method, test.path, test.allow, allow)
+		}
+	}
+}
@@ -14,9 +14,12 @@ Check out http://sawsij.com for more information and documentation.
 package framework
 
 import (
+	"bitbucket.org/jaybill/sawsij/framework/logging"
 	"bitbucket.org/jaybill/sawsij/framework/model"
 	"bitbucket.org/jaybill/sawsij/framework/model/mysql"
 	"bitbucket.org/jaybill/sawsij/framework/model/postgres"
+	"bytes"
+	ctxpkg "context"
 	"database/sql"
 	"encoding/base64"
 	"encoding/json"
@@ -32,8 +35,12 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
 	"runtime"
-	"strings"
+	"runtime/debug"
+	"strconv"
+	"sync"
+	"syscall"
 	"time"
 )
 
@@ -49,8 +56,13 @@ type AppScope struct {
 	Db       *model.DbSetup
 	BasePath string
 	Setup    *AppSetup
+	// Where the application logs. It's set up from the "log" section of the config file. See Run().
+	Log logging.Logger
 	// Can be used to store arbitrary data in the application scope.
 	Custom *map[string]interface{}
+	// Where sessions are kept. It's picked by session.store in the config file.
+	store         sessions.Store
+	sessionCookie *sessionCookie
 }
 
 // A RequestScope is sent to handler functions and contains session and derived URL information.
@@ -64,6 +76,9 @@ type RequestScope struct {
 	UrlParamArray []string
 	// A map of URL parameters as a key value map. Will be populated if ParamAs field of the RouteConfig is set to PARAMS_MAP
 	UrlParamMap map[string]string
+	// Named parameters from the route pattern, so for "/posts/{id:int}" and the URL "/posts/14", PathParams["id"] will be "14".
+	// These are also added to UrlParamMap.
+	PathParams map[string]string
 }
 
 // The User interface describes the methods that the framework needs to interact with a user for the purposes of auth and session management.
@@ -71,7 +86,7 @@ type RequestScope struct {
 type User interface {
 	// How the framework determines if the user has supplied the correct password
 	TestPassword(password string, a *AppScope) bool
-	// How the framework determines what role the user has. Currently only has one role.
+	// How the framework determines what role the user has. Users with more than one role should also implement MultiRoleUser.
 	GetRole() int64
 	// If you're storing a password hash in your user object, implement ClearPasswordHash() so that it blanks that.
 	// Otherwise the hash will get stored in the session cookie, which is no good.
@@ -86,64 +101,93 @@ type User interface {
 // checked in any way and is solely for ease of use.
 // TemplateFuncs is a map of functions that can be called from your templates. If you make the keys the same as any of the built in functions,
 // you'll effectively override it.
+// Middleware is a list of functions that wrap every route's handler function. See the Middleware type for details.
+// RoleDefs says which permissions each role has and which other roles it inherits from. It's easiest to fill in with the Grant() and
+// Inherit() methods.
+// ShutdownHooks are called, in order, when the server shuts down, after in-flight requests have finished. Use them to flush any background
+// work. The database connection is closed by the framework after the hooks have run.
+// RateLimitStore keeps the buckets for rate limits. If it isn't set, server.rateLimit.store in the config file picks one.
+// HealthChecks are run, along with the built in database checks, when the readiness endpoint is asked for. See Run() for details.
 
 type AppSetup struct {
 	GetUser func(username string, a *AppScope) User
 
 	Roles         *map[string]int
+	RoleDefs      map[int]RoleDef
 	TemplateFuncs template.FuncMap
+	Middleware    []Middleware
+	ShutdownHooks []func(a *AppScope)
+	// Extra response compressors by content coding, like "br". gzip is built in.
+	Compressors    map[string]Compressor
+	HealthChecks   map[string]HealthCheck
+	RateLimitStore RateLimitStore
 }
 
-var store *sessions.CookieStore
-var appScope *AppScope
-var parsedTemplate *template.Template
+// An App is a single sawsij application. It owns its configuration, database handle, session store, templates and routes, so more than
+// one can run in the same process. An App is an http.Handler, which means it can be mounted inside another server, or tested with
+// net/http/httptest. The AppScope is embedded, so the config and database are available as app.Config and app.Db.
+//
+// Most applications only need one App and never deal with this type directly. The package level Configure(), Route(), SetCustom() and Run()
+// functions work with a default App that Configure() creates.
+type App struct {
+	*AppScope
+	templates *templateCache
+	// Set when server.cacheTemplates is false, so template files are checked for changes on each request.
+	reloadTemplates bool
+	router          *router
+	static          *staticFiles
+	compression     *compression
+	// Set when log.access.file is in the config file.
+	accessLog *accessLog
+	proxies   trustedProxies
+	// Set when metrics.enabled is true in the config file.
+	metrics *metrics
+	// Set unless server.health.enabled is false.
+	health  *health
+	limiter *limiter
+	// Set by server.devMode in the config file. Server errors show a page with debugging details instead of error.html.
+	devMode bool
+	servers []*http.Server
+	// Closed when Shutdown() has finished.
+	shutdownDone chan bool
+	shutdownOnce sync.Once
+}
+
+// The App used by the package level functions. Set by Configure().
+var defaultApp *App
+
+// getDefaultApp returns the default App, stopping the program if Configure() hasn't been called yet.
+func getDefaultApp() *App {
+	if defaultApp == nil {
+		log.Fatal("Configure() must be called before the application can be used.")
+	}
+	return defaultApp
+}
 
 // SetCustom is used to add custom data that will be placed in the AppScope. This can later be retrieved in handler functions.
 // You supply it with a function that returns a map, and it will set the AppScope that gets passed to handlers to that.
 // You should always call SetCustom *after* you call Configure, that way the AppScope your function recieves will
 // have all the configuration stuff, like database connections and the config file.
 func SetCustom(f func(a *AppScope) *map[string]interface{}) {
-	log.Print("Calling custom function")
-	appScope.Custom = f(appScope)
-	log.Print("Custom is now %+v", *appScope.Custom)
-	return
+	getDefaultApp().SetCustom(f)
 }
 
-func parseTemplates() {
-	viewPath := appScope.BasePath + "/templates"
-	templateDir, err := os.Open(viewPath)
-	if err != nil {
-		log.Print(err)
-	}
-
-	allFiles, err := templateDir.Readdirnames(0)
-	if err != nil {
-		log.Print(err)
-	}
-	templateExt := "html"
-	var templateFiles []string
-
-	for i := 0; i < len(allFiles); i++ {
-		if si := strings.Index(allFiles[i], templateExt); si != -1 {
-			if si == len(allFiles[i])-len(templateExt) {
-				templateFiles = append(templateFiles, viewPath+"/"+allFiles[i])
-			}
-		}
-	}
+// SetCustom does the same thing as the package level SetCustom(), for this App.
+func (app *App) SetCustom(f func(a *AppScope) *map[string]interface{}) {
+	app.Custom = f(app.AppScope)
+	app.Log.Debug("Custom data set", "custom", *app.Custom)
+	return
+}
 
-	if len(templateFiles) > 0 {
-		fnm := GetFuncMap()
-		if len(appScope.Setup.TemplateFuncs) > 0 {
-			for name, fn := range appScope.Setup.TemplateFuncs {
-				fnm[name] = fn
-			}
-		}
-		pt, err := template.New("dummy").Delims("<%", "%>").Funcs(fnm).ParseFiles(templateFiles...)
-		parsedTemplate = pt
-		if err != nil {
-			log.Printf("** TEMPLATE PARSE ERROR: %v", err)
-		}
+// templateFuncs returns the functions available to templates: the built in ones from GetFuncMap(), plus or overridden by the
+// TemplateFuncs in the AppSetup.
+func (app *App) templateFuncs() (fnm template.FuncMap) {
+	fnm = GetFuncMap()
+	fnm["asset"] = app.Asset
+	for name, fn := range app.Setup.TemplateFuncs {
+		fnm[name] = fn
 	}
+	return
 }
 
 // HandlerResponse is a struct that your handler functions return. It contains all the data needed to generate the response. If Redirect is set,
@@ -152,9 +196,12 @@ func parseTemplates() {
 // to simplify templates and JSON responses with only one entry.
 // Headers is an array of standard http headers that will be set on the response.
 // Modtime is the last modified time, which is only used when the RouteConfig's ReturnType is RT_RAW
+// Status is the HTTP status code of the response, i.e. http.StatusCreated. It defaults to 200, or 302 for a Redirect. It isn't used
+// for RT_RAW, where http.ServeContent picks the status. To send an error status, return an *HttpError like NotFound() instead.
 type HandlerResponse struct {
 	View     map[string](interface{})
 	Redirect string
+	Status   int
 	Header   http.Header
 	Content  io.ReadSeeker
 	Modtime  time.Time
@@ -165,23 +212,59 @@ func (h *HandlerResponse) Init() {
 	h.View = make(map[string]interface{})
 }
 
+// writeStatus writes the Status of the response, if one was set.
+func (h *HandlerResponse) writeStatus(w http.ResponseWriter) {
+	if h.Status != 0 {
+		w.WriteHeader(h.Status)
+	}
+}
+
 // RouteConfig is what is supplied to the Route() function to set up a route. More about how this is used in the documentation for the Route function.
 type RouteConfig struct {
-	// The URL pattern to be matched for this route, i.e. "/admin/users"
+	// The URL pattern to be matched for this route, i.e. "/admin/users" or "/posts/{id:int}/comments/{slug}"
 	Pattern string
+	// The HTTP methods this route accepts, i.e. []string{"GET", "POST"}. If it's empty, any method is accepted. Requests with other
+	// methods get a 405 response with an Allow header. HEAD is answered by the GET handler and OPTIONS is answered automatically.
+	// You can call Route() more than once with the same pattern and different methods to use a different handler for each.
+	Methods []string
 	// A function that will handle this route.
 	Handler func(*http.Request, *AppScope, *RequestScope) (HandlerResponse, error)
-	// An array of role (ints) that are allowed to access this route.
+	// Middleware that wraps the handler for this route only. It runs after any Middleware set on the AppSetup.
+	Middleware []Middleware
+	// An array of role (ints) that are allowed to access this route. Ignored if Permission is set.
 	Roles []int
+	// The permission a user needs to access this route, i.e. "users.edit". Permissions are given to roles in AppSetup.RoleDefs.
+	Permission string
 	// Setting this to framework.RT_JSON or framework.RT_HTML will force the return type and ignore any URL hints. Setting this to framework.RT_RAW
 	// will use http.ServeContent to pass whatever is returned in HandlerResponse.Content (useful for sending binary data like images)
 	ReturnType int
+	// If ReturnTypes is set, the return type is picked from it for each request, and ReturnType is ignored. A URL extension picks the type,
+	// so "/posts/list.json" gets JSON from the "/posts/list" route. Without an extension, the Accept header is used. If the client doesn't
+	// express a preference, the first type in the list is used. Requests for a type that isn't in the list get a 406 response.
+	// Only RT_HTML, RT_JSON and RT_XML can be negotiated.
+	ReturnTypes []int
 	// How parameters will be specified on the URL. Will default to PARAMS_MAP, a key value map. Can be set to PARAMS_ARRAY to return
 	// an ordered array of values
 	ParamsAs int
 	// If TemplateFilename is set, it will be used instead of template name derived from then pattern-based naming convention.
-	// The specified template must exist in the [app_root]/templates folder.
+	// The specified template must exist in the [app_root]/templates folder. Templates in subfolders are named with their path,
+	// i.e. "admin/users/edit.html".
 	TemplateFilename string
+	// The layout to render the page in, i.e. "layouts/admin.html". It replaces any layout the template declares itself.
+	Layout string
+	// Requests with methods other than GET, HEAD, OPTIONS and TRACE must carry the session's CSRF token, either in a form field added to
+	// the template with <% csrfField . %> or in the X-CSRF-Token header, or they get a 403 response. Set CsrfExempt to turn the check off
+	// for this route, i.e. for a JSON API that authenticates with its own tokens rather than the session cookie.
+	CsrfExempt bool
+	// Limits how often each client can use this route, on top of any server.rateLimit in the config file. Throttled requests get a
+	// 429 response with a Retry-After header.
+	RateLimit *RateLimit
+	// If RecentLogin is set, logged in users must have given their password within this long to use the route, i.e. 10 * time.Minute
+	// for deleting users. Otherwise they're sent to "/login/dest/..." to give it again and then come back.
+	RecentLogin time.Duration
+	// The name of the root element when ReturnType is RT_XML. If it isn't set, the key of the View entry will be used when the
+	// View only has one entry, and "response" will be used otherwise.
+	XmlRoot string
 }
 
 // Route takes route config and sets up a handler. This is the primary means by which applications interact with the framework.
@@ -196,31 +279,71 @@ type RouteConfig struct {
 // Note that these are strings, so you'll need to convert them to whatever types you need. If you just need an Int id, there's a useful utility function,
 // sawsij.GetIntId()
 //
+// Patterns can also have named parameters in curly braces, which take up a whole path segment, like "/posts/{id:int}/comments/{slug}".
+// The part after the colon is optional and constrains what the segment can contain. It can be one of the names in ParamTypes ("int", "alpha",
+// "alnum", "hex" or "slug") or a regular expression. The values end up in the PathParams map of the RequestScope. A pattern with named
+// parameters has to match the whole URL, so no key/value params can follow it. If no pattern matches a URL, the response is a 404.
+//
 // The template filename to be used is based on the pattern, with slashes being converted to dashes. So "/admin" looks for "[app_root_dir]/templates/admin.html"
 // and "/posts/list" will look for "[app_root_dir]/templates/posts-list.html". The pattern "/" will look for "[app_root_dir]/index.html".
+// Templates can also be kept in subfolders named after the pattern: if "[app_root_dir]/templates/posts/list.html" (or "posts/list/index.html")
+// exists, it's used instead.
+//
+// A template can be rendered inside a layout. The page declares its layout on its first line and fills in the layout's blocks:
+//
+//	<% layout "layouts/main.html" %>
+//	<% define "content" %><h1>Posts</h1>...<% end %>
+//
+// where layouts/main.html contains the common markup and marks the places pages fill in with <% block "content" . %><% end %>.
+// The Layout field of RouteConfig picks a layout for a route, overriding what the page declares.
+//
+// Access to a route is controlled by its Permission, or by its list of Roles if no Permission is set. Guests who aren't allowed in are
+// sent to "/login/dest/..." so they can come back after logging in, and logged in users are sent to "/denied". On routes with
+// RecentLogin, logged in users who haven't given their password lately are sent to "/login/dest/..." as well. A user's roles come from
+// GetRoles() if it implements MultiRoleUser and GetRole() otherwise. Templates can check permissions with <% if can . "users.edit" %>,
+// and the current user's permissions are in .global.permissions.
+//
+// If the handler returns an error, the response status comes from the error when it's an *HttpError (see NotFound(), Forbidden(),
+// Validation() and friends) and is 500 otherwise. HTML routes render the error with "[app_root_dir]/templates/error.html", JSON routes
+// get a body like {"error":{"status":404,"message":"Not Found"}} and XML routes get the same thing as an <error> element.
+// A panic in the handler, its middleware or the template is recovered and treated like an error, so the client gets a 500. When
+// server.devMode is true in the config file, 500s on HTML routes show a page with the stack trace, the failing template line and a dump
+// of the request and session instead of error.html. Never turn devMode on in production.
 //
 // You generally call Route() once per pattern after you've called Configure() and before you call Run().
 func Route(rcfg RouteConfig) {
+	getDefaultApp().Route(rcfg)
+}
 
-	var slashRoute string = ""
-	if p := strings.LastIndex(rcfg.Pattern, "/"); p != len(rcfg.Pattern)-1 {
-		slashRoute = rcfg.Pattern + "/"
+// Route does the same thing as the package level Route(), for this App.
+func (app *App) Route(rcfg RouteConfig) {
+	if rcfg.RateLimit != nil {
+		if err := rcfg.RateLimit.valid(); err != nil {
+			app.fatal("Adding route failed", "pattern", rcfg.Pattern, "error", err)
+		}
 	}
 
 	fn := func(w http.ResponseWriter, r *http.Request) {
-		log.Printf("Request method from handler: %q", r.Method)
+		var returnType int
+		global := make(map[string]interface{})
+		context.Set(r, patternKey, rcfg.Pattern)
 
-		cacheTemplates, err := appScope.Config.Get("server.cacheTemplates")
-		if err != nil {
-			log.Print(err)
-		} else {
-			if cacheTemplates != "true" {
-				parseTemplates()
-			}
+		if app.compression.enabled {
+			cw := app.compression.wrap(w, r)
+			// Deferred first, so it runs after an error page for a panic has been written.
+			defer cw.Close()
+			w = cw
 		}
 
-		log.Printf("URL path: %v", r.URL.Path)
-		var returnType int
+		// A panic anywhere in the handler, middleware or template is turned into a 500 response rather than a dropped connection.
+		defer func() {
+			if p := recover(); p != nil {
+				app.renderError(w, r, returnType, &panicError{p, debug.Stack()}, global)
+			}
+		}()
+
+		app.Log.Debug("Request", "method", r.Method, "path", r.URL.Path, "pattern", rcfg.Pattern)
+		var err error
 
 		if rcfg.ReturnType == 0 {
 			returnType = RT_HTML
@@ -228,27 +351,65 @@ func Route(rcfg RouteConfig) {
 			returnType = rcfg.ReturnType
 		}
 
-		global := make(map[string]interface{})
-		session, _ := store.Get(r, "session")
-		role := R_GUEST // Set to guest by default
+		if len(rcfg.ReturnTypes) > 0 {
+			w.Header().Add("Vary", "Accept")
+			var acceptable bool
+			returnType, acceptable = negotiate(r, getExtension(r), rcfg.ReturnTypes)
+			if !acceptable {
+				http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+				return
+			}
+		}
+		urlPath := getRoutePath(r)
+
+		session, _ := app.store.Get(r, app.sessionCookie.name)
+		if app.sessionCookie.expire(session, time.Now()) {
+			// The user, if there was one, has to log in again.
+			app.Log.Info("Session expired", "path", r.URL.Path)
+		}
+		var user User // nil for guests
 		su := session.Values["user"]
 
-		log.Printf("User: %+v", su)
-		log.Printf("Session vals: %+v", session.Values)
+		app.Log.Debug("Session", "user", su, "session", session.Values)
 		if su != nil {
-			u := su.(User)
-			role = int(u.GetRole())
+			user = su.(User)
+			context.Set(r, usernameKey, username(user))
 		}
 
-		log.Printf("pattern: %v roles that can see this: %v user role: %v", rcfg.Pattern, rcfg.Roles, role)
+		if limited, wait := app.rateLimited(r, &rcfg, user); limited {
+			app.Log.Warn("Rate limited", "method", r.Method, "path", r.URL.Path, "ip", app.proxies.clientIp(r))
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(wait)))
+			app.renderError(w, r, returnType, TooManyRequests("Too many requests. Please wait a moment and try again."), global)
+			return
+		}
+		roles := UserRoles(user)
+		perms := app.Setup.Permissions(roles)
+		global["permissions"] = perms
+
+		var permitted bool
+		if rcfg.Permission != "" {
+			app.Log.Debug("Checking permission", "pattern", rcfg.Pattern, "permission", rcfg.Permission, "roles", roles)
+			permitted = perms[rcfg.Permission]
+		} else {
+			app.Log.Debug("Checking roles", "pattern", rcfg.Pattern, "allowed", rcfg.Roles, "roles", roles)
+			for _, role := range roles {
+				if InArray(role, rcfg.Roles) {
+					permitted = true
+				}
+			}
+		}
 
 		var handlerResults HandlerResponse
 
-		if !InArray(role, rcfg.Roles) {
+		if permitted && user != nil && rcfg.RecentLogin > 0 && !loggedInSince(session.Values, rcfg.RecentLogin, time.Now()) {
+			app.Log.Debug("Recent login required", "user", username(user), "uri", r.URL.RequestURI())
+			dest := base64.URLEncoding.EncodeToString([]byte(r.URL.RequestURI()))
+			handlerResults.Redirect = fmt.Sprintf("/login/dest/%v", dest)
+		} else if !permitted {
 			// This user does not have the right role
 			if su == nil {
 				// User isn't logged in, send to login page, passing along desired destination
-				log.Printf("Request URI for redirect: %v", r.URL.RequestURI())
+				app.Log.Debug("Login required", "uri", r.URL.RequestURI())
 				dest := base64.URLEncoding.EncodeToString([]byte(r.URL.RequestURI()))
 				handlerResults.Redirect = fmt.Sprintf("/login/dest/%v", dest)
 			} else {
@@ -258,34 +419,80 @@ func Route(rcfg RouteConfig) {
 			}
 		} else {
 			// Everything is ok. Proceed normally.
-			reqScope := RequestScope{Session: session}
+			reqScope := RequestScope{Session: session, PathParams: getPathParams(r)}
 			switch rcfg.ParamsAs {
 			case PARAMS_ARRAY:
-				reqScope.UrlParamArray = GetUrlParamsArray(rcfg.Pattern, r.URL.Path)
+				if len(reqScope.PathParams) == 0 {
+					reqScope.UrlParamArray = GetUrlParamsArray(rcfg.Pattern, urlPath)
+				}
 			default:
-				reqScope.UrlParamMap = GetUrlParamsMap(rcfg.Pattern, r.URL.Path)
+				if len(reqScope.PathParams) == 0 {
+					reqScope.UrlParamMap = GetUrlParamsMap(rcfg.Pattern, urlPath)
+				} else {
+					reqScope.UrlParamMap = make(map[string]string)
+				}
+				for key, value := range reqScope.PathParams {
+					reqScope.UrlParamMap[key] = value
+				}
 			}
 
 			global["user"] = session.Values["user"]
 
 			if flashes := session.Flashes(); len(flashes) > 0 {
-				log.Printf("Setting error flashes to %+v", flashes[0])
+				app.Log.Debug("Flash", "flash", flashes[0])
 				global["flash"] = flashes[0]
 			}
 
-			// Call the supplied handler function and get the results back.
-			handlerResults, err = rcfg.Handler(r, appScope, &reqScope)
-			session.Save(r, w)
+			token, terr := csrfToken(session)
+			if terr != nil {
+				app.Log.Error("Creating CSRF token failed", "error", terr)
+			} else if global[csrfSessionKey], terr = maskCsrfToken(token); terr != nil {
+				app.Log.Error("Masking CSRF token failed", "error", terr)
+			}
+
+			if !rcfg.CsrfExempt && !validCsrf(r, token) {
+				app.Log.Warn("Rejecting request without a valid CSRF token", "method", r.Method, "path", r.URL.Path)
+				err = Forbidden("This form has expired or didn't come from this site. Please go back, reload the page and try again.")
+			} else {
+				// Call the supplied handler function, wrapped in any middleware, and get the results back.
+				handler := chain(rcfg.Handler, app.Setup.Middleware, rcfg.Middleware)
+				handlerResults, err = handler(r, app.AppScope, &reqScope)
+			}
+			if _, ok := session.Values[csrfSessionKey]; !ok && session.Values != nil && session.Options.MaxAge >= 0 {
+				// The handler renewed the session, so anything it renders needs the new token.
+				if token, terr = csrfToken(session); terr == nil {
+					global[csrfSessionKey], terr = maskCsrfToken(token)
+				}
+				if terr != nil {
+					app.Log.Error("Creating CSRF token failed", "error", terr)
+				}
+			}
+		}
+
+		// Saved on redirects too, so a session that expire() emptied replaces the old one.
+		if serr := session.Save(r, w); serr != nil {
+			// Otherwise a login, or whatever else the handler put in the session, would be lost without a word.
+			app.Log.Error("Saving session failed", "error", serr)
+			app.renderError(w, r, returnType, serr, global)
+			return
+		}
 
+		// Add "global" template variables
+		if app.Setup.Roles != nil {
+			global["roles"] = *app.Setup.Roles
 		}
+		global["url"] = rcfg.Pattern
 
 		if handlerResults.Redirect != "" {
-			http.Redirect(w, r, handlerResults.Redirect, http.StatusFound)
+			status := http.StatusFound
+			if handlerResults.Status >= 300 && handlerResults.Status < 400 {
+				status = handlerResults.Status
+			}
+			http.Redirect(w, r, handlerResults.Redirect, status)
 		} else {
 
 			if err != nil {
-				log.Print(err)
-				http.Error(w, "An error occured. See log for details.", http.StatusInternalServerError)
+				app.renderError(w, r, returnType, err, global)
 			} else {
 
 				for key, values := range handlerResults.Header {
@@ -298,45 +505,39 @@ func Route(rcfg RouteConfig) {
 
 				switch returnType {
 				case RT_XML:
-					//TODO Return actual XML here (issue #6)
 					w.Header().Add("Content-Type", "text/xml")
-					fmt.Fprintf(w, "%s", xml.Header)
-					log.Print("returning xml")
-					type Response struct {
-						Error string
+
+					iToRender, key := renderable(handlerResults.View)
+					root := rcfg.XmlRoot
+					if root == "" {
+						if key != "" {
+							app.Log.Debug("Handler returned a single value, rendering it alone", "key", key)
+							root = key
+						} else {
+							root = DEFAULT_XML_ROOT
+						}
 					}
-					r := Response{Error: "NOT YET IMPLEMENTED"}
-					b, err := xml.Marshal(r)
+
+					b, err := marshalXml(iToRender, root)
 					if err != nil {
-						log.Print(err)
+						app.Log.Error("Encoding XML failed", "pattern", rcfg.Pattern, "error", err)
 					} else {
-						fmt.Fprintf(w, "%s", b)
+						handlerResults.writeStatus(w)
+						fmt.Fprintf(w, "%s%s", xml.Header, b)
 					}
 				case RT_JSON:
 					w.Header().Add("Content-Type", "application/json")
-					log.Print("returning json")
-
-					var iToRender interface{}
-					if len(handlerResults.View) == 1 {
-
-						var keystring string
-
-						for key, value := range handlerResults.View {
-							if _, ok := value.(interface{}); ok {
-								keystring = key
-							}
-						}
-						log.Printf("handler returned single value array. returning value of %q", keystring)
 
-						iToRender = handlerResults.View[keystring]
-					} else {
-						iToRender = handlerResults.View
+					iToRender, key := renderable(handlerResults.View)
+					if key != "" {
+						app.Log.Debug("Handler returned a single value, rendering it alone", "key", key)
 					}
 
 					b, err := json.Marshal(iToRender)
 					if err != nil {
-						log.Print(err)
+						app.Log.Error("Encoding JSON failed", "pattern", rcfg.Pattern, "error", err)
 					} else {
+						handlerResults.writeStatus(w)
 						fmt.Fprintf(w, "%s", b)
 					}
 
@@ -344,17 +545,16 @@ func Route(rcfg RouteConfig) {
 
 					http.ServeContent(w, r, "", handlerResults.Modtime, handlerResults.Content)
 				default:
+					if app.reloadTemplates {
+						app.templates.reload()
+					}
 					var templateFilename string
 					if rcfg.TemplateFilename == "" {
-						templateFilename = GetTemplateName(rcfg.Pattern) + ".html"
+						templateFilename = app.templates.pageFor(rcfg.Pattern)
 					} else {
 						templateFilename = rcfg.TemplateFilename
 					}
-					log.Printf("Using template file %v", templateFilename)
-					// Add "global" template variables
-					global["roles"] = *appScope.Setup.Roles
-					global["url"] = rcfg.Pattern
-					log.Printf("URL sent to template: %v", global["url"])
+					app.Log.Debug("Rendering template", "template", templateFilename, "url", global["url"])
 					if len(global) > 0 {
 						if handlerResults.View == nil {
 							handlerResults.Init()
@@ -362,17 +562,24 @@ func Route(rcfg RouteConfig) {
 						handlerResults.View["global"] = global
 					}
 
-					defer func() {
-						if err := recover(); err != nil {
-							log.Print(err)
-							parseTemplates() // parse templates again so we can throw any errors
-							return
-						}
-					}()
-					err = parsedTemplate.ExecuteTemplate(w, templateFilename, handlerResults.View)
+					tmpl, name, terr := app.templates.lookup(templateFilename, rcfg.Layout)
+					if terr != nil {
+						app.metrics.templateError(templateFilename)
+						app.renderError(w, r, returnType, terr, global)
+						return
+					}
+
+					// Render into a buffer first, so a template that fails halfway through doesn't leave half a page behind the error.
+					var buf bytes.Buffer
+					err = tmpl.ExecuteTemplate(&buf, name, handlerResults.View)
 					if err != nil {
-						log.Printf("** TEMPLATE EXECUTION ERROR: %v", err)
+						app.Log.Error("Template execution failed", "template", templateFilename, "error", err)
+						app.metrics.templateError(templateFilename)
+						app.renderError(w, r, returnType, err, global)
+						return
 					}
+					handlerResults.writeStatus(w)
+					buf.WriteTo(w)
 
 				}
 			}
@@ -380,28 +587,25 @@ func Route(rcfg RouteConfig) {
 		}
 	}
 
-	http.HandleFunc(rcfg.Pattern, fn)
-
-	if slashRoute != "" {
-		http.HandleFunc(slashRoute, fn)
+	rt, err := app.router.add(rcfg.Pattern, rcfg.Methods, http.HandlerFunc(fn))
+	if err != nil {
+		app.fatal("Adding route failed", "pattern", rcfg.Pattern, "error", err)
+	}
+	if len(rcfg.ReturnTypes) > 0 {
+		rt.extensions = true
 	}
 
 	return
 }
 
-func staticHandler(w http.ResponseWriter, r *http.Request) {
-	log.Printf("Serving static resource %q - method: %q", r.URL.Path, r.Method)
-	http.ServeFile(w, r, appScope.BasePath+r.URL.Path)
-}
-
 // Configure gets the application base path from a command line argument unless you specify it.  It then reads the config file at [app_root_dir]/etc/config.yaml.
 // It then attempts to grab a handle to the database, which it sticks into the appScope.
 // It will also set up a static handler for any files in [app_root_dir]/static, which can be used to serve up images, CSS and JavaScript.
-// Configure is the first thing your application will call in its "main" method.
-func Configure(as *AppSetup, basePath string) (a *AppScope, err error) {
+// The static files are served at /static/ unless server.static.path says otherwise. See Run() for the other static settings.
+// Configure is the first thing your application will call in its "main" method. The App it returns is used by the package level Route(),
+// SetCustom() and Run() functions. Any errors are fatal.
+func Configure(as *AppSetup, basePath string) (app *App, err error) {
 	migrateAndExit := false
-	a = &AppScope{Setup: as}
-	appScope = a
 	log.Printf("Basepath is currently %q", basePath)
 	if basePath == "" {
 
@@ -409,9 +613,7 @@ func Configure(as *AppSetup, basePath string) (a *AppScope, err error) {
 			log.Fatal("No basepath file specified.")
 		}
 
-		appScope.BasePath = string(os.Args[1])
-	} else {
-		appScope.BasePath = basePath
+		basePath = string(os.Args[1])
 	}
 
 	if len(os.Args) == 3 {
@@ -423,103 +625,115 @@ func Configure(as *AppSetup, basePath string) (a *AppScope, err error) {
 		}
 	}
 
-	configFilename := appScope.BasePath + "/etc/config.yaml"
+	app, err = newApp(as, basePath, migrateAndExit)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	log.Print("Using config file [" + configFilename + "]")
+	// Anything registered directly with the net/http package still gets a chance to handle requests no route matched.
+	app.router.fallback = http.DefaultServeMux
+	defaultApp = app
+
+	return
+}
+
+// NewApp creates an App using the application in basePath. It reads the config file at [basePath]/etc/config.yaml, connects to the database
+// and checks the schema versions, sets up the static handler and parses the templates, just like Configure(). Unlike Configure(), it doesn't
+// look at the command line or set the default App, and it returns errors rather than stopping the program.
+func NewApp(as *AppSetup, basePath string) (app *App, err error) {
+	return newApp(as, basePath, false)
+}
+
+func newApp(as *AppSetup, basePath string, migrateAndExit bool) (app *App, err error) {
+	a := &AppScope{Setup: as, BasePath: basePath}
+
+	configFilename := a.BasePath + "/etc/config.yaml"
 
 	c, err := yaml.ReadFile(configFilename)
 	if err != nil {
-		log.Fatal(err)
+		return
 	}
-	appScope.Config = c
+	a.Config = c
+
+	a.Log, err = newLogger(c)
+	if err != nil {
+		return
+	}
+	a.Log.Info("Using config file", "file", configFilename)
 
 	driver, err := c.Get("database.driver")
 
 	if err != nil {
-		log.Fatal(err)
+		return
 	}
 
 	if driver != "none" {
 
 		connect, err := c.Get("database.connect")
 		if err != nil {
-			log.Fatal(err)
+			return nil, err
 		}
 
 		db, err := sql.Open(driver, connect)
 		if err != nil {
-			log.Fatal(err)
+			return nil, err
 		}
 
-		dBconfigFilename := appScope.BasePath + "/etc/dbversions.yaml"
+		dBconfigFilename := a.BasePath + "/etc/dbversions.yaml"
 		defaultSchema, allSchemas, err := model.ParseDbVersionsFile(dBconfigFilename)
-		appScope.Db = &model.DbSetup{Db: db, DefaultSchema: defaultSchema, Schemas: allSchemas}
+		a.Db = &model.DbSetup{Db: db, DefaultSchema: defaultSchema, Schemas: allSchemas, Log: a.Log}
 		switch driver {
 		case "postgres":
-			appScope.Db.GetQueries = postgres.GetQueries
+			a.Db.GetQueries = postgres.GetQueries
 		case "mysql":
-			appScope.Db.GetQueries = mysql.GetQueries
+			a.Db.GetQueries = mysql.GetQueries
 		default:
-			log.Fatal("Database driver not supported.")
+			return nil, &SawsijError{"Database driver not supported."}
 		}
 
 		if err == nil {
 
 			for _, schema := range allSchemas {
 
-				// Count the tables in the schema. If it's empty, make the dbversion 0.
-				q := fmt.Sprintf(appScope.Db.GetQueries().TableCount(), schema.Name)
-				r := db.QueryRow(q)
-				var tc int64 = 0
-				err = r.Scan(&tc)
+				dbversion, err := schemaVersion(a.Db, schema.Name)
 				if err != nil {
-					log.Fatal(err)
+					return nil, err
 				}
-				var dbversion int64 = 0
 
-				if tc != 0 {
-					query := fmt.Sprintf(appScope.Db.GetQueries().DbVersion(), schema.Name)
-					row := db.QueryRow(query)
-					err = row.Scan(&dbversion)
-					if err != nil {
-						log.Fatal(err)
-					}
-				}
-
-				log.Printf("Schema: %v App: %v Db: %v", schema.Name, schema.Version, dbversion)
+				a.Log.Info("Checking schema version", "schema", schema.Name, "app", schema.Version, "db", dbversion)
 				if schema.Version != dbversion {
 
 					if migrateAndExit {
-						dbs := &model.DbSetup{Db: db}
-						dbs.GetQueries = appScope.Db.GetQueries
+						dbs := &model.DbSetup{Db: db, Log: a.Log}
+						dbs.GetQueries = a.Db.GetQueries
 						t := &model.Table{Db: dbs, Schema: schema.Name}
-						log.Printf("Running database migration on %q", schema.Name)
+						a.Log.Info("Running database migration", "schema", schema.Name)
 						for i := dbversion + 1; i <= schema.Version; i++ {
-							scriptfile := fmt.Sprintf("%v/sql/changes/%v_%v_%04d.sql", appScope.BasePath, driver, schema.Name, i)
-							log.Printf("Running script %v", scriptfile)
+							scriptfile := fmt.Sprintf("%v/sql/changes/%v_%v_%04d.sql", a.BasePath, driver, schema.Name, i)
+							a.Log.Info("Running script", "script", scriptfile)
 
 							err = model.RunScript(db, scriptfile)
 							if err != nil {
-								log.Fatal(err)
+								return nil, err
 							}
 							dbv := &model.SawsijDbVersion{VersionId: i, RanOn: time.Now()}
 							t.Insert(dbv)
-							log.Printf("Inserted record: %+v", dbv)
+							a.Log.Info("Recorded schema version", "schema", schema.Name, "version", dbv.VersionId)
 
 						}
 
 					} else {
-						log.Fatal("Schema/App version mismatch. Please run migrate to update the database.")
+						return nil, &SawsijError{"Schema/App version mismatch. Please run migrate to update the database."}
 					}
 
 				}
 
 				if migrateAndExit {
-					viewfile := fmt.Sprintf("%v/sql/objects/%v_%v_views.sql", appScope.BasePath, driver, schema.Name)
-					log.Printf("Running script %v", viewfile)
+					viewfile := fmt.Sprintf("%v/sql/objects/%v_%v_views.sql", a.BasePath, driver, schema.Name)
+					a.Log.Info("Running script", "script", viewfile)
 					err = model.RunScript(db, viewfile)
 					if err != nil {
-						log.Fatal(err)
+						return nil, err
 					}
 
 				}
@@ -528,41 +742,291 @@ func Configure(as *AppSetup, basePath string) (a *AppScope, err error) {
 
 			if migrateAndExit {
 
-				log.Print("All schemas updated. Exiting.")
+				a.Log.Info("All schemas updated. Exiting.")
 				os.Exit(0)
 			}
 		}
 	}
 
-	key, err := c.Get("encryption.key")
+	key, err := configString(c, "encryption.key", "")
 	if err != nil {
-		log.Fatal(err)
+		return
+	}
+
+	app = &App{AppScope: a, router: newRouter(), shutdownDone: make(chan bool)}
+	if a.sessionCookie, err = newSessionCookie(c); err != nil {
+		return nil, err
+	}
+	codecs, err := sessionCodecs(c, key, a.sessionCookie)
+	if err != nil {
+		return nil, err
+	}
+	if app.store, err = newSessionStore(c, app.BasePath, a.Db, codecs, a.sessionCookie); err != nil {
+		return nil, err
+	}
+
+	app.devMode, err = configBool(c, "server.devMode", false)
+	if err != nil {
+		return nil, err
+	}
+	if app.devMode {
+		a.Log.Warn("Development mode is on. Error pages will show debugging details.")
+	}
+
+	app.static, err = newStaticFiles(c, app.BasePath+"/static", a.Log)
+	if err != nil {
+		return nil, err
 	}
+	a.Log.Info("Serving static files", "dir", app.static.dir, "path", app.static.mount)
+	app.router.mount(app.static.mount, app.static)
 
-	store = sessions.NewCookieStore([]byte(key))
+	app.compression, err = newCompression(c, as.Compressors)
+	if err != nil {
+		return nil, err
+	}
 
-	log.Print("Static dir is [" + appScope.BasePath + "/static" + "]")
-	http.HandleFunc("/static/", staticHandler)
+	proxies, err := configList(c, "server.trustedProxies")
+	if err != nil {
+		return nil, err
+	}
+	if app.proxies, err = parseTrustedProxies(proxies); err != nil {
+		return nil, err
+	}
+	if app.accessLog, err = newAccessLog(c, app.BasePath); err != nil {
+		return nil, err
+	}
 
-	parseTemplates()
+	cacheTemplates, err := configBool(c, "server.cacheTemplates", true)
+	if err != nil {
+		return nil, err
+	}
+	app.reloadTemplates = !cacheTemplates
+	app.templates = newTemplateCache(app.BasePath+"/templates", app.templateFuncs(), a.Log)
+
+	if app.metrics, err = newMetrics(c, app); err != nil {
+		return nil, err
+	}
+	if app.metrics != nil && a.Db != nil {
+		a.Db.OnQuery = app.metrics.observeQuery
+	}
+	if app.health, err = newHealth(c, app); err != nil {
+		return nil, err
+	}
+	if app.limiter, err = newLimiter(c, as, a.Db); err != nil {
+		return nil, err
+	}
+
+	return
+}
 
+// schemaVersion returns the version of a schema recorded in the database. A schema with no tables in it is at version 0.
+func schemaVersion(dbs *model.DbSetup, schema string) (dbversion int64, err error) {
+	// Count the tables in the schema. If it's empty, make the dbversion 0.
+	q := fmt.Sprintf(dbs.GetQueries().TableCount(), schema)
+	var tc int64 = 0
+	err = dbs.Db.QueryRow(q).Scan(&tc)
+	if err != nil || tc == 0 {
+		return
+	}
+	query := fmt.Sprintf(dbs.GetQueries().DbVersion(), schema)
+	err = dbs.Db.QueryRow(query).Scan(&dbversion)
 	return
 }
 
+// ServeHTTP sends the request to the route that matches it. It makes App an http.Handler.
+func (app *App) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if app.accessLog == nil && app.metrics == nil {
+		context.ClearHandler(app.router).ServeHTTP(w, r)
+		return
+	}
+	context.ClearHandler(http.HandlerFunc(app.serveLogged)).ServeHTTP(w, r)
+}
+
 // Run will start a web server on the port specified in the config file, using the configuration in the config file and the routes specified by any Route() calls
 // that have been previously made. This is generally the last line of your application's "main" method.
+//
+// The server can be tuned with these settings in the "server" section of the config file. Durations can be written like "30s" or "2m":
+//
+//	cacheTemplates: true   # when false, templates are parsed again whenever a file in templates/ changes
+//	readTimeout: 30s       # how long to wait for a client to send a whole request
+//	writeTimeout: 60s      # how long a response can take to write
+//	idleTimeout: 120s      # how long to keep an idle keep-alive connection open
+//	maxHeaderBytes: 1048576
+//	shutdownTimeout: 30s   # how long to wait for in-flight requests when shutting down
+//	devMode: false         # show stack traces, request and session dumps and failing template lines on error pages
+//
+// Files in the static folder are served with these settings from the "static" part of the "server" section:
+//
+//	static:
+//	  path: /static/                        # the URL path static files are served under
+//	  cacheControl: public, max-age=3600    # the Cache-Control header for static files; none is sent if it's not set
+//	  etags: true                           # send an ETag header made from each file's contents
+//
+// Folders are never listed. If a file has a precompressed copy next to it, like site.css.br or site.css.gz, that's sent instead to
+// clients that accept the encoding. The "asset" template function gives fingerprinted URLs for static files, which are sent with
+// a Cache-Control header that lets them be cached forever. See App.Asset().
+//
+// Responses from routes are compressed for clients that accept it, using the "compression" part of the "server" section:
+//
+//	compression:
+//	  enabled: true
+//	  minSize: 1024           # responses smaller than this many bytes aren't compressed
+//	  level: -1               # the gzip level, from 1 (fastest) to 9 (smallest); -1 is the default
+//	  encodings: br, gzip     # in order of preference; br is skipped unless AppSetup.Compressors has one for it
+//	  types: text/html, application/json, application/xml, text/xml
+//
+// Only the content types listed are compressed, so RT_RAW content like images and archives is sent as it is. So is anything that
+// already has a Content-Encoding. Without types, DEFAULT_COMPRESSION_TYPES are used.
+//
+// The framework, and model.Tables that use the AppScope's Db, log to AppScope.Log. It's set up by the "log" section of the config file:
+//
+//	log:
+//	  level: info       # debug, info, warn or error; debug logs every request and query
+//	  format: text      # or json, for one JSON object per line
+//	  redact: ssn       # more field names to write as [REDACTED], on top of logging.DEFAULT_REDACT
+//	  access:
+//	    file: log/access.log   # relative to the application directory, or "-" for standard output
+//	    format: combined       # or common
+//
+// When log.access.file is set, every request is written to it in Common or Combined Log Format, with the logged in user's name and
+// the time taken in microseconds on the end. Sending the process SIGHUP reopens the file, so it works with logrotate. If the
+// application is behind reverse proxies, list them in server.trustedProxies, i.e. "10.0.0.1, 10.1.0.0/16". The client address is
+// then taken from X-Forwarded-For when a request comes from one of them.
+//
+// Request counts and latencies by route pattern and status, template errors and model.Table query times can be exposed in the
+// Prometheus text format. They're set up by the "metrics" section of the config file:
+//
+//	metrics:
+//	  enabled: true
+//	  path: /metrics           # served by the application, to users with the permission or one of the roles below
+//	  permission: metrics.view
+//	  roles: admin             # names from AppSetup.Roles
+//	  listen: 127.0.0.1:9100   # or served by a separate server, i.e. one only reachable from a private network
+//
+// Set listen and leave out path to keep the metrics off the public server altogether.
+//
+// For load balancers, /healthz answers as long as the process is up, and /readyz only when the application can serve requests: the
+// database answers a ping, every schema is at the version in dbversions.yaml, and the HealthChecks in the AppSetup pass. Both send
+// JSON, like {"status":"ok","checks":{"database":{"status":"ok","latencyMs":0.4}}}, with a 503 when something has failed. They're set
+// up by the "health" part of the "server" section:
+//
+//	health:
+//	  enabled: true
+//	  livePath: /healthz
+//	  readyPath: /readyz
+//	  timeout: 5s          # a check that takes longer than this fails
+//
+// Every route can be rate limited for each client with the "rateLimit" part of the "server" section, and routes can have tighter limits
+// of their own with RouteConfig.RateLimit. Static files and the health endpoints aren't limited.
+//
+//	rateLimit:
+//	  requests: 600   # requests each client can make every "per"; there's no global limit without this
+//	  per: 1m
+//	  burst: 100      # requests that can be made at once; defaults to requests
+//	  by: ip          # or user, to count logged in users by username and guests by IP address
+//	  store: memory   # or db, to share limits between servers through the sawsij_rate_limit table
+//
+// New applications have the sawsij_rate_limit table. For older ones, add a migration that creates it in the default schema. On
+// PostgreSQL:
+//
+//	CREATE TABLE "myapp"."sawsij_rate_limit" (
+//	    "bucket" varchar(255) NOT NULL,
+//	    "tokens" double precision NOT NULL,
+//	    "updated_on" int8 NOT NULL,
+//	    PRIMARY KEY("bucket")
+//	);
+//
+// And on MySQL, where the schema is a prefix of the table name:
+//
+//	CREATE TABLE `myapp_sawsij_rate_limit` (
+//	    `bucket` VARCHAR (255) NOT NULL,
+//	    `tokens` DOUBLE NOT NULL,
+//	    `updated_on` BIGINT NOT NULL,
+//	    PRIMARY KEY (`bucket`)
+//	);
+//
+// Sessions are kept in a signed, encrypted cookie unless the "session" section of the config file says otherwise. The other stores
+// only put a session ID in the cookie and keep the values on the server, so they can be as big as they need to be and
+// AppScope.RevokeSessions() can log a user out everywhere:
+//
+//	session:
+//	  store: db               # cookie, db (the sawsij_session table), file or memory (lost on restart, one process only)
+//	  path: sessions          # the folder for the file store, relative to the application directory
+//	  lifetime: 168h          # how long a session can last, however busy it is; no limit if it's not set
+//	  idleTimeout: 2h         # how long a session lasts unused; every request starts it again. 720h if it's not set
+//	  cleanupInterval: 10m    # how often expired sessions are deleted from the db and file stores
+//	  cookie:
+//	    name: session
+//	    domain: example.com   # only set this to share the session with subdomains
+//	    path: /
+//	    secure: true          # defaults to true when server.tls.cert is set
+//	    httpOnly: true
+//	    sameSite: lax         # lax, strict or none; none needs secure
+//
+// When a session expires, its values are thrown away, so a user who was logged in is sent to /login/dest/... like any guest.
+//
+// The db store needs the sawsij_session table in the default schema, which new applications have. For older ones, add a migration
+// that creates it. On PostgreSQL:
+//
+//	CREATE TABLE "myapp"."sawsij_session" (
+//	    "id" varchar(64) NOT NULL,
+//	    "username" varchar(255) NOT NULL,
+//	    "data" text NOT NULL,
+//	    "expires_on" int8 NOT NULL,
+//	    PRIMARY KEY("id")
+//	);
+//	CREATE INDEX "sawsij_session_username" ON "myapp"."sawsij_session" ("username");
+//
+// And on MySQL:
+//
+//	CREATE TABLE `myapp_sawsij_session` (
+//	    `id` VARCHAR (64) NOT NULL,
+//	    `username` VARCHAR (255) NOT NULL,
+//	    `data` MEDIUMTEXT NOT NULL,
+//	    `expires_on` BIGINT NOT NULL,
+//	    PRIMARY KEY (`id`),
+//	    INDEX `sawsij_session_username` (`username`)
+//	);
+//
+// Session cookies are signed and encrypted with the keys in encryption.sessionKeys, which "sawsijcmd keygen" makes. New cookies use
+// the first key and cookies made with any of them are accepted, so to change keys, add a new one at the top and remove the old one
+// after the sessions it made have expired. Without the list, the keys are derived from encryption.key.
+//
+//	encryption:
+//	  sessionKeys:
+//	    - Q2hhbmdlIG1lIQ...   # newest
+//	    - T2xkIGtleSBoZXJl...
+//
+// When the process gets SIGINT or SIGTERM, the server stops accepting connections and waits up to shutdownTimeout for in-flight requests
+// to finish. Then the ShutdownHooks in the AppSetup are called, the database connection is closed and Run returns.
+//
+// If server.tls.cert and server.tls.key are set, the server uses HTTPS. Setting server.tls.redirectListen starts a second, plain HTTP
+// listener that redirects everything to HTTPS. When the process gets SIGHUP, the certificate and key are read again, so renewed
+// certificates can be picked up without a restart. The TLS settings go in a "tls" section inside "server":
+//
+//	tls:
+//	  cert: etc/server.crt        # relative paths are relative to the application base path
+//	  key: etc/server.key
+//	  minVersion: 1.2             # one of 1.0, 1.1, 1.2 or 1.3
+//	  ciphers:                    # optional, names as used by crypto/tls. Only applies to TLS 1.2 and earlier.
+//	    - TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256
+//	  redirectListen: :80
 func Run() {
+	getDefaultApp().Run()
+}
+
+// Run does the same thing as the package level Run(), for this App.
+func (app *App) Run() {
 
-	log.Printf("Number of processors: %d", runtime.NumCPU())
+	app.Log.Info("Starting", "processors", runtime.NumCPU())
 
 	runtime.GOMAXPROCS(runtime.NumCPU())
 	listen := ""
-	listen, err := appScope.Config.Get("server.listen")
+	listen, err := app.Config.Get("server.listen")
 	if err != nil {
-		port, err := appScope.Config.Get("server.port")
+		port, err := app.Config.Get("server.port")
 		if err != nil {
-			log.Print(err)
-			log.Fatal("Config file must specify 'listen' or 'port'.")
+			app.fatal("Config file must specify 'listen' or 'port'.", "error", err)
 		} else {
 			listen = fmt.Sprintf(":%v", port)
 		}
@@ -570,6 +1034,166 @@ func Run() {
 
 	}
 
-	log.Printf("Listening on %v", listen)
-	log.Fatal(http.ListenAndServe(listen, context.ClearHandler(http.DefaultServeMux)))
+	srv, err := app.newServer(listen, app)
+	if err != nil {
+		app.fatal("Setting up the server failed", "error", err)
+	}
+
+	tlsConfig, certs, err := app.tlsConfig()
+	if err != nil {
+		app.fatal("Setting up TLS failed", "error", err)
+	}
+	if tlsConfig != nil {
+		srv.TLSConfig = tlsConfig
+
+		redirectListen, err := configString(app.Config, "server.tls.redirectListen", "")
+		if err != nil {
+			app.fatal("Reading server.tls.redirectListen failed", "error", err)
+		}
+		if redirectListen != "" {
+			rsrv, err := app.newServer(redirectListen, redirectToHttps(listen))
+			if err != nil {
+				app.fatal("Setting up the HTTPS redirect server failed", "error", err)
+			}
+			go func() {
+				app.Log.Info("Redirecting HTTP requests to HTTPS", "listen", redirectListen)
+				err := rsrv.ListenAndServe()
+				if err != http.ErrServerClosed {
+					app.fatal("HTTPS redirect server failed", "listen", redirectListen, "error", err)
+				}
+			}()
+		}
+
+	}
+
+	if app.metrics != nil && app.metrics.listen != "" {
+		msrv, err := app.newServer(app.metrics.listen, app.metrics)
+		if err != nil {
+			app.fatal("Setting up the metrics server failed", "error", err)
+		}
+		go func() {
+			app.Log.Info("Serving metrics", "listen", app.metrics.listen)
+			err := msrv.ListenAndServe()
+			if err != http.ErrServerClosed {
+				app.fatal("Metrics server failed", "listen", app.metrics.listen, "error", err)
+			}
+		}()
+	}
+
+	if ss, ok := app.store.(*serverStore); ok {
+		interval, err := configDuration(app.Config, "session.cleanupInterval", 10*time.Minute)
+		if err != nil {
+			app.fatal("Reading session.cleanupInterval failed", "error", err)
+		}
+		go ss.expireEvery(interval, app)
+	}
+
+	// SIGHUP reloads the certificate and reopens the access log, so certificates can be renewed and logs rotated without a restart.
+	go func() {
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		for range hup {
+			if certs != nil {
+				app.Log.Info("Reloading certificate", "cert", certs.certFile)
+				if err := certs.reload(); err != nil {
+					app.Log.Error("Could not reload certificate, keeping the old one", "cert", certs.certFile, "error", err)
+				}
+			}
+			if app.accessLog != nil {
+				app.Log.Info("Reopening access log", "file", app.accessLog.path)
+				if err := app.accessLog.reopen(); err != nil {
+					app.Log.Error("Could not reopen access log", "file", app.accessLog.path, "error", err)
+				}
+			}
+		}
+	}()
+
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+		sig := <-signals
+		app.Log.Info("Shutting down", "signal", sig)
+		err := app.Shutdown()
+		if err != nil {
+			app.Log.Error("Shutdown failed", "error", err)
+		}
+	}()
+
+	if tlsConfig != nil {
+		app.Log.Info("Listening for HTTPS", "listen", listen)
+		err = srv.ListenAndServeTLS("", "")
+	} else {
+		app.Log.Info("Listening", "listen", listen)
+		err = srv.ListenAndServe()
+	}
+	if err != http.ErrServerClosed {
+		app.fatal("Server failed", "listen", listen, "error", err)
+	}
+	<-app.shutdownDone
+	app.Log.Info("Shutdown complete.")
+}
+
+// newServer creates an http.Server for addr using the timeouts in the config file. The server is tracked by the App, so Shutdown()
+// will stop it.
+func (app *App) newServer(addr string, h http.Handler) (srv *http.Server, err error) {
+	srv = &http.Server{Addr: addr, Handler: h}
+
+	if srv.ReadTimeout, err = configDuration(app.Config, "server.readTimeout", 30*time.Second); err != nil {
+		return
+	}
+	if srv.WriteTimeout, err = configDuration(app.Config, "server.writeTimeout", 60*time.Second); err != nil {
+		return
+	}
+	if srv.IdleTimeout, err = configDuration(app.Config, "server.idleTimeout", 120*time.Second); err != nil {
+		return
+	}
+	if srv.MaxHeaderBytes, err = configInt(app.Config, "server.maxHeaderBytes", http.DefaultMaxHeaderBytes); err != nil {
+		return
+	}
+
+	app.servers = append(app.servers, srv)
+	return
+}
+
+// Shutdown gracefully stops any servers started by Run(), waiting up to server.shutdownTimeout for in-flight requests to finish. It then
+// calls the ShutdownHooks in the AppSetup and closes the database connection. Run() calls it when the process is told to stop, but you
+// can call it yourself too. Only the first call does anything.
+func (app *App) Shutdown() (err error) {
+	app.shutdownOnce.Do(func() {
+		err = app.shutdown()
+		close(app.shutdownDone)
+	})
+	return
+}
+
+func (app *App) shutdown() (err error) {
+	grace, err := configDuration(app.Config, "server.shutdownTimeout", 30*time.Second)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := ctxpkg.WithTimeout(ctxpkg.Background(), grace)
+	defer cancel()
+	for _, srv := range app.servers {
+		if serr := srv.Shutdown(ctx); serr != nil {
+			app.Log.Error("Server did not shut down cleanly", "listen", srv.Addr, "error", serr)
+			srv.Close()
+			err = serr
+		}
+	}
+
+	for _, hook := range app.Setup.ShutdownHooks {
+		hook(app.AppScope)
+	}
+
+	if app.accessLog != nil {
+		app.accessLog.close()
+	}
+
+	if app.Db != nil && app.Db.Db != nil {
+		if derr := app.Db.Db.Close(); derr != nil {
+			app.Log.Error("Closing the database failed", "error", derr)
+		}
+	}
+	return
 }
@@ -7,11 +7,15 @@ package framework
 import (
 	"flag"
 	"github.com/kylelemons/go-gypsy/yaml"
+	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
+	"time"
 )
 
 var workDir string = ""
@@ -134,3 +138,148 @@ func TestRouteAndConfigure(t *testing.T) {
 	Route(RouteConfig{Pattern: "/", Handler: testHandler, Roles: make([]int, 0)})
 	teardown(t)
 }
+
+// standupApp writes a minimal application with no database into a temporary directory and returns its path. Any lines in
+// serverConfig are added to the server section of the config file.
+func standupApp(t *testing.T, name string, serverConfig []string, templates map[string]string) (basePath string) {
+	var configFile = `
+server:
+  port: 8066
+  cacheTemplates: true
+@@SERVER@@
+database:
+  driver: none
+
+encryption:
+  salt: 213asjdhaskjh213
+  key: sakjdhuh23i123123
+`
+	var extra string
+	for _, line := range serverConfig {
+		extra += "  " + line + "\n"
+	}
+	configFile = strings.Replace(configFile, "@@SERVER@@", extra, -1)
+	basePath = os.TempDir() + "/sawsijtestapp-" + name
+	err := os.RemoveAll(basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dir := range []string{"/etc", "/static", "/templates"} {
+		err = os.MkdirAll(basePath+dir, os.FileMode(0777))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = WriteStringToFile(configFile, basePath+"/etc/config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for filename, content := range templates {
+		err = WriteStringToFile(content, basePath+"/templates/"+filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return
+}
+
+func TestTwoApps(t *testing.T) {
+	apps := make([]*App, 2)
+	for i, name := range []string{"one", "two"} {
+		basePath := standupApp(t, name, nil, map[string]string{"index.html": name + " <% .val %>"})
+		defer os.RemoveAll(basePath)
+
+		app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		value := i
+		app.Route(RouteConfig{Pattern: "/", Roles: []int{R_GUEST}, Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			h.Init()
+			h.View["val"] = value
+			return
+		}})
+		apps[i] = app
+	}
+
+	for i, expected := range []string{"one 0", "two 1"} {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost/", nil)
+		apps[i].ServeHTTP(w, r)
+		if w.Body.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, w.Body.String())
+		}
+
+		w = httptest.NewRecorder()
+		r, _ = http.NewRequest("GET", "http://localhost/missing", nil)
+		apps[i].ServeHTTP(w, r)
+		if w.Code != http.StatusNotFound {
+			t.Errorf("Expected 404, got %v", w.Code)
+		}
+	}
+}
+
+func TestShutdownDrainsRequests(t *testing.T) {
+	basePath := standupApp(t, "shutdown", []string{"shutdownTimeout: 5s"}, map[string]string{"index.html": "<% .val %>"})
+	defer os.RemoveAll(basePath)
+
+	hookCalled := false
+	as := &AppSetup{Roles: &map[string]int{}}
+	as.ShutdownHooks = append(as.ShutdownHooks, func(a *AppScope) {
+		hookCalled = true
+	})
+
+	app, err := NewApp(as, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	started := make(chan bool)
+	app.Route(RouteConfig{Pattern: "/", Roles: []int{R_GUEST}, Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+		started <- true
+		time.Sleep(200 * time.Millisecond)
+		h.Init()
+		h.View["val"] = "finished"
+		return
+	}})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, err := app.newServer(l.Addr().String(), app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if srv.ReadTimeout != 30*time.Second {
+		t.Errorf("Expected default read timeout, got %v", srv.ReadTimeout)
+	}
+	go srv.Serve(l)
+
+	body := make(chan string)
+	go func() {
+		res, err := http.Get("http://" + l.Addr().String() + "/")
+		if err != nil {
+			body <- err.Error()
+			return
+		}
+		defer res.Body.Close()
+		b, _ := ioutil.ReadAll(res.Body)
+		body <- string(b)
+	}()
+
+	<-started
+	err = app.Shutdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := <-body; b != "finished" {
+		t.Errorf("Expected in-flight request to finish, got %q", b)
+	}
+	if !hookCalled {
+		t.Error("Shutdown hook was not called")
+	}
+}
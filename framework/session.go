@@ -0,0 +1,763 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bitbucket.org/jaybill/sawsij/framework/model"
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/gob"
+	"fmt"
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+	"github.com/kylelemons/go-gypsy/yaml"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The table the db session store keeps sessions in, in the default schema.
+const SESSION_TABLE = "sawsij_session"
+
+// What a session ID looks like. Anything else in a cookie is ignored.
+var sessionIdPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{43}$`)
+
+// A sessionBackend is where a server side session store keeps sessions. Each session is saved with the name of its user, so all of
+// a user's sessions can be deleted at once.
+type sessionBackend interface {
+	// load returns the data saved for a session, or nil if there isn't a session with that id or it has expired.
+	load(id string) (data []byte, err error)
+	// insert saves a session with a new id.
+	insert(id string, user string, data []byte, expires time.Time) error
+	// update saves a session that's already there. If it has gone, i.e. because it was revoked while a request was using it,
+	// nothing is saved and found is false.
+	update(id string, user string, data []byte, expires time.Time) (found bool, err error)
+	delete(id string) error
+	deleteUser(user string) error
+	deleteExpired(now time.Time) error
+}
+
+// Keys in the session values the framework keeps the session's age and when the user last gave their password in, as Unix times.
+const (
+	sessionCreatedKey = "_created"
+	sessionSeenKey    = "_seen"
+	sessionAuthKey    = "_auth"
+)
+
+// The keys the framework puts in every session. A session with nothing else in it, like a guest's, isn't kept on the server.
+var sessionBookkeepingKeys = map[interface{}]bool{sessionCreatedKey: true, sessionSeenKey: true, csrfSessionKey: true}
+
+// onlyBookkeeping reports whether values holds nothing but the framework's own keys.
+func onlyBookkeeping(values map[interface{}]interface{}) bool {
+	for key := range values {
+		if !sessionBookkeepingKeys[key] {
+			return false
+		}
+	}
+	return true
+}
+
+// sessionCookie is how the session cookie is written and how long a session lasts, from the "session" section of the config file.
+type sessionCookie struct {
+	name     string
+	domain   string
+	path     string
+	secure   bool
+	httpOnly bool
+	sameSite http.SameSite
+	// How long a session can last from when it started, however busy it is. There's no limit if it's 0.
+	lifetime time.Duration
+	// How long a session lasts without being used. Each request starts it again. There's no limit if it's 0.
+	idleTimeout time.Duration
+}
+
+// newSessionCookie reads the session cookie settings. The cookie is Secure by default when the server uses HTTPS.
+func newSessionCookie(c *yaml.File) (sc *sessionCookie, err error) {
+	sc = &sessionCookie{}
+	if sc.name, err = configString(c, "session.cookie.name", "session"); err != nil {
+		return
+	}
+	if sc.domain, err = configString(c, "session.cookie.domain", ""); err != nil {
+		return
+	}
+	if sc.path, err = configString(c, "session.cookie.path", "/"); err != nil {
+		return
+	}
+	cert, err := configString(c, "server.tls.cert", "")
+	if err != nil {
+		return
+	}
+	if sc.secure, err = configBool(c, "session.cookie.secure", cert != ""); err != nil {
+		return
+	}
+	if sc.httpOnly, err = configBool(c, "session.cookie.httpOnly", true); err != nil {
+		return
+	}
+	sameSite, err := configString(c, "session.cookie.sameSite", "lax")
+	if err != nil {
+		return
+	}
+	switch strings.ToLower(sameSite) {
+	case "lax":
+		sc.sameSite = http.SameSiteLaxMode
+	case "strict":
+		sc.sameSite = http.SameSiteStrictMode
+	case "none":
+		if !sc.secure {
+			err = &SawsijError{"Config value session.cookie.sameSite can only be none when session.cookie.secure is true"}
+			return
+		}
+		sc.sameSite = http.SameSiteNoneMode
+	default:
+		err = &SawsijError{fmt.Sprintf("Config value session.cookie.sameSite must be lax, strict or none, not %q", sameSite)}
+		return
+	}
+	if sc.lifetime, err = configDuration(c, "session.lifetime", 0); err != nil {
+		return
+	}
+	sc.idleTimeout, err = configDuration(c, "session.idleTimeout", 30*24*time.Hour)
+	return
+}
+
+// options returns the gorilla options a new session starts with.
+func (sc *sessionCookie) options() *sessions.Options {
+	return &sessions.Options{Path: sc.path, Domain: sc.domain, MaxAge: sc.maxAge(), Secure: sc.secure, HttpOnly: sc.httpOnly}
+}
+
+// maxAge returns the longest a session can go unused, in seconds. Expiring sessions is done by expire(), so a session with no limits
+// still gets a long lived cookie rather than one the browser throws away when it closes.
+func (sc *sessionCookie) maxAge() int {
+	switch {
+	case sc.idleTimeout > 0:
+		return int(sc.idleTimeout.Seconds())
+	case sc.lifetime > 0:
+		return int(sc.lifetime.Seconds())
+	}
+	return int((30 * 24 * time.Hour).Seconds())
+}
+
+// write sends the session cookie. A negative maxAge deletes it.
+func (sc *sessionCookie) write(w http.ResponseWriter, name string, value string, maxAge int) {
+	cookie := &http.Cookie{Name: name, Value: value, Path: sc.path, Domain: sc.domain, MaxAge: maxAge, Secure: sc.secure,
+		HttpOnly: sc.httpOnly, SameSite: sc.sameSite}
+	if maxAge > 0 {
+		cookie.Expires = time.Now().Add(time.Duration(maxAge) * time.Second)
+	} else if maxAge < 0 {
+		cookie.Expires = time.Unix(1, 0)
+	}
+	http.SetCookie(w, cookie)
+}
+
+// sessionTime reads one of the times the framework keeps in the session values.
+func sessionTime(values map[interface{}]interface{}, key string) time.Time {
+	if unix, ok := values[key].(int64); ok {
+		return time.Unix(unix, 0)
+	}
+	return time.Time{}
+}
+
+// expire starts the session again, empty, if it's older than the lifetime or has been idle longer than the idle timeout, and
+// reports whether it did. Otherwise the idle timeout starts again from now. Either way, the session's MaxAge is set to how long it
+// has left, so the cookie and any server side copy go away when it does.
+func (sc *sessionCookie) expire(session *sessions.Session, now time.Time) (expired bool) {
+	created := sessionTime(session.Values, sessionCreatedKey)
+	seen := sessionTime(session.Values, sessionSeenKey)
+	if !created.IsZero() && (sc.lifetime > 0 && now.Sub(created) >= sc.lifetime || sc.idleTimeout > 0 && now.Sub(seen) >= sc.idleTimeout) {
+		expired = true
+		for key := range session.Values {
+			delete(session.Values, key)
+		}
+	}
+	if created.IsZero() || expired {
+		created = now
+		session.Values[sessionCreatedKey] = now.Unix()
+	}
+	session.Values[sessionSeenKey] = now.Unix()
+
+	left := sc.maxAge()
+	if sc.lifetime > 0 {
+		if remaining := int(created.Add(sc.lifetime).Sub(now).Seconds()); remaining < left {
+			left = remaining
+		}
+	}
+	if left < 1 {
+		left = 1
+	}
+	session.Options.MaxAge = left
+	return
+}
+
+// The length of a session key before it's base64 encoded: a 32 byte key that signs cookies followed by a 32 byte AES-256 key that
+// encrypts them.
+const SESSION_KEY_LENGTH = 64
+
+// NewSessionKey returns a random key for the encryption.sessionKeys list in the config file. "sawsijcmd keygen" prints one.
+func NewSessionKey() (key string, err error) {
+	b := make([]byte, SESSION_KEY_LENGTH)
+	if _, err = rand.Read(b); err != nil {
+		return
+	}
+	key = base64.StdEncoding.EncodeToString(b)
+	return
+}
+
+// sessionCodecs returns the codecs that sign and encrypt session cookies, one for each key in encryption.sessionKeys. Cookies are
+// written with the first key and read with any of them, so keys can be rotated by adding a new one at the top of the list and
+// removing the old one once the sessions it wrote have expired. If there's no list, both keys are derived from encryption.key.
+func sessionCodecs(c *yaml.File, key string, sc *sessionCookie) (codecs []securecookie.Codec, err error) {
+	keys, err := configList(c, "encryption.sessionKeys")
+	if err != nil {
+		return
+	}
+	var pairs [][]byte
+	for i, k := range keys {
+		b, derr := base64.StdEncoding.DecodeString(k)
+		if derr != nil || len(b) != SESSION_KEY_LENGTH {
+			return nil, &SawsijError{fmt.Sprintf("Session key %v in encryption.sessionKeys must be %v bytes, base64 encoded. Use \"sawsijcmd keygen\" to make one.", i+1, SESSION_KEY_LENGTH)}
+		}
+		pairs = append(pairs, b[:32], b[32:])
+	}
+	if len(pairs) == 0 {
+		if key == "" {
+			return nil, &SawsijError{"Config value encryption.key or encryption.sessionKeys must be set"}
+		}
+		pairs = append(pairs, deriveKey(key, "session hash"), deriveKey(key, "session block"))
+	}
+	codecs = securecookie.CodecsFromPairs(pairs...)
+	for _, codec := range codecs {
+		if secure, ok := codec.(*securecookie.SecureCookie); ok {
+			// The cookie is signed again whenever it's saved, so this is the idle timeout.
+			secure.MaxAge(sc.maxAge())
+		}
+	}
+	return
+}
+
+// deriveKey makes a 32 byte key for purpose out of secret, so one secret can give several keys that don't reveal each other.
+func deriveKey(secret string, purpose string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+// A cookieStore keeps the whole session in a signed, encrypted cookie. It's gorilla's CookieStore, but it writes the cookie with the
+// sessionCookie settings, which gorilla's options don't all cover.
+type cookieStore struct {
+	codecs []securecookie.Codec
+	cookie *sessionCookie
+}
+
+func (cs *cookieStore) Get(r *http.Request, name string) (*sessions.Session, error) {
+	return sessions.GetRegistry(r).Get(cs, name)
+}
+
+// New returns the session in the request's cookie, or a new one if there's no cookie or it can't be read.
+func (cs *cookieStore) New(r *http.Request, name string) (session *sessions.Session, err error) {
+	session = sessions.NewSession(cs, name)
+	session.Options = cs.cookie.options()
+	session.IsNew = true
+	if cookie, cerr := r.Cookie(name); cerr == nil {
+		if err = securecookie.DecodeMulti(name, cookie.Value, &session.Values, cs.codecs...); err == nil {
+			session.IsNew = false
+		}
+	}
+	return
+}
+
+// Save sends the session in the cookie. A session with a negative MaxAge is deleted.
+func (cs *cookieStore) Save(r *http.Request, w http.ResponseWriter, session *sessions.Session) (err error) {
+	if session.Options.MaxAge < 0 {
+		cs.cookie.write(w, session.Name(), "", -1)
+		return
+	}
+	encoded, err := securecookie.EncodeMulti(session.Name(), session.Values, cs.codecs...)
+	if err != nil {
+		return
+	}
+	cs.cookie.write(w, session.Name(), encoded, session.Options.MaxAge)
+	return
+}
+
+// A serverStore is a sessions.Store that only puts an encrypted session ID in the cookie. The values are kept on the server, so they
+// aren't limited by cookie size or seen by the client. Sessions that only hold bookkeeping, like a guest's CSRF token, are kept in
+// the cookie instead until there's something else to keep, so clients that never log in don't leave sessions behind.
+type serverStore struct {
+	backend sessionBackend
+	codecs  []securecookie.Codec
+	cookie  *sessionCookie
+}
+
+// newSessionStore sets up the session store picked by session.store in the config file: cookie, which is the default, db, file or
+// memory. codecs sign and encrypt the cookies.
+func newSessionStore(c *yaml.File, basePath string, dbs *model.DbSetup, codecs []securecookie.Codec, sc *sessionCookie) (store sessions.Store, err error) {
+	kind, err := configString(c, "session.store", "cookie")
+	if err != nil {
+		return
+	}
+
+	ss := &serverStore{codecs: codecs, cookie: sc}
+	switch strings.ToLower(kind) {
+	case "cookie":
+		return &cookieStore{codecs: codecs, cookie: sc}, nil
+	case "memory":
+		ss.backend = &memorySessionBackend{sessions: make(map[string]*storedSession)}
+	case "file":
+		dir, derr := configString(c, "session.path", "sessions")
+		if derr != nil {
+			return nil, derr
+		}
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(basePath, dir)
+		}
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			return
+		}
+		ss.backend = &fileSessionBackend{dir: dir}
+	case "db":
+		if dbs == nil {
+			return nil, &SawsijError{"Config value session.store is db, but there's no database"}
+		}
+		ss.backend = &dbSessionBackend{dbs: dbs}
+	default:
+		return nil, &SawsijError{fmt.Sprintf("Config value session.store must be cookie, db, file or memory, not %q", kind)}
+	}
+	return ss, nil
+}
+
+// newSessionId returns a random session ID.
+func newSessionId() (id string, err error) {
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// sessionUser returns the name of the user logged in to a session, or an empty string for a guest.
+func sessionUser(values map[interface{}]interface{}) string {
+	if u, ok := values["user"].(User); ok {
+		return username(u)
+	}
+	return ""
+}
+
+func (ss *serverStore) Get(r *http.Request, name string) (*sessions.Session, error) {
+	return sessions.GetRegistry(r).Get(ss, name)
+}
+
+// New returns the session named by the request's cookie, or a new one if there's no cookie or the session has gone.
+func (ss *serverStore) New(r *http.Request, name string) (session *sessions.Session, err error) {
+	session = sessions.NewSession(ss, name)
+	session.Options = ss.cookie.options()
+	session.IsNew = true
+
+	cookie, cerr := r.Cookie(name)
+	if cerr != nil {
+		return
+	}
+	var id string
+	if err = securecookie.DecodeMulti(name, cookie.Value, &id, ss.codecs...); err != nil {
+		// A guest's cookie holds their values rather than an ID.
+		values := make(map[interface{}]interface{})
+		if gerr := securecookie.DecodeMulti(name, cookie.Value, &values, ss.codecs...); gerr == nil && onlyBookkeeping(values) {
+			session.Values = values
+			session.IsNew = false
+			err = nil
+		}
+		return
+	}
+	if !sessionIdPattern.MatchString(id) {
+		return
+	}
+	data, err := ss.backend.load(id)
+	if err != nil || data == nil {
+		return
+	}
+	if err = gob.NewDecoder(bytes.NewReader(data)).Decode(&session.Values); err != nil {
+		return
+	}
+	session.ID = id
+	session.IsNew = false
+	return
+}
+
+// Save writes the session to the backend and sends its ID in the cookie. A session with a negative MaxAge is deleted.
+func (ss *serverStore) Save(r *http.Request, w http.ResponseWriter, session *sessions.Session) (err error) {
+	if session.Options.MaxAge < 0 {
+		if session.ID != "" {
+			err = ss.backend.delete(session.ID)
+		}
+		ss.cookie.write(w, session.Name(), "", -1)
+		return
+	}
+	if session.ID == "" && onlyBookkeeping(session.Values) {
+		encoded, eerr := securecookie.EncodeMulti(session.Name(), session.Values, ss.codecs...)
+		if eerr != nil {
+			return eerr
+		}
+		ss.cookie.write(w, session.Name(), encoded, session.Options.MaxAge)
+		return
+	}
+	created := session.ID == ""
+	if created {
+		if session.ID, err = newSessionId(); err != nil {
+			return
+		}
+	}
+
+	var buf bytes.Buffer
+	if err = gob.NewEncoder(&buf).Encode(session.Values); err != nil {
+		return
+	}
+	expires := time.Now().Add(time.Duration(session.Options.MaxAge) * time.Second)
+	if created {
+		err = ss.backend.insert(session.ID, sessionUser(session.Values), buf.Bytes(), expires)
+	} else {
+		found, uerr := ss.backend.update(session.ID, sessionUser(session.Values), buf.Bytes(), expires)
+		if uerr == nil && !found {
+			// The session was revoked while this request had it, so it stays gone.
+			ss.cookie.write(w, session.Name(), "", -1)
+			return
+		}
+		err = uerr
+	}
+	if err != nil {
+		return
+	}
+	encoded, err := securecookie.EncodeMulti(session.Name(), session.ID, ss.codecs...)
+	if err != nil {
+		return
+	}
+	ss.cookie.write(w, session.Name(), encoded, session.Options.MaxAge)
+	return
+}
+
+// expireEvery deletes expired sessions from the backend every interval, until the App has shut down.
+func (ss *serverStore) expireEvery(interval time.Duration, app *App) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			if err := ss.backend.deleteExpired(now); err != nil {
+				app.Log.Error("Deleting expired sessions failed", "error", err)
+			}
+		case <-app.shutdownDone:
+			return
+		}
+	}
+}
+
+// RevokeSessions logs a user out everywhere by deleting all of their sessions. It needs a server side session store, so it returns
+// an error when session.store is cookie.
+func (a *AppScope) RevokeSessions(username string) error {
+	ss, ok := a.store.(*serverStore)
+	if !ok {
+		return &SawsijError{"Sessions kept in cookies can't be revoked. Set session.store to db, file or memory."}
+	}
+	if username == "" {
+		return &SawsijError{"Can't revoke sessions without a username"}
+	}
+	a.Log.Info("Revoking sessions", "user", username)
+	return ss.backend.deleteUser(username)
+}
+
+// LogIn puts user in the session, calling ClearPasswordHash() first, and records that they just gave their password, which is what
+// RecentLogin on a route checks. The session is renewed first, so a login handler of your own should call this rather than setting
+// Values["user"] itself.
+func (rs *RequestScope) LogIn(user User) (err error) {
+	if err = rs.RenewSession(); err != nil {
+		return
+	}
+	user.ClearPasswordHash()
+	rs.Session.Values["user"] = user
+	rs.Session.Values[sessionAuthKey] = time.Now().Unix()
+	return
+}
+
+// RenewSession keeps the session's values but gives it a new ID and CSRF token, so an ID that someone else planted in the browser
+// or saw earlier can't be used to ride on what the session is allowed to do now (session fixation). LogIn() does it; call it
+// yourself whenever the user's privileges change in some other way. Sessions kept in cookies have no ID, but still get a new token.
+func (rs *RequestScope) RenewSession() (err error) {
+	session := rs.Session
+	if ss, ok := session.Store().(*serverStore); ok && session.ID != "" {
+		if err = ss.backend.delete(session.ID); err != nil {
+			return
+		}
+		session.ID = ""
+	}
+	delete(session.Values, csrfSessionKey)
+	session.Values[sessionCreatedKey] = time.Now().Unix()
+	return
+}
+
+// DestroySession throws away everything in the session, deletes any server side copy and tells the browser to delete the cookie.
+func (rs *RequestScope) DestroySession() {
+	for key := range rs.Session.Values {
+		delete(rs.Session.Values, key)
+	}
+	rs.Session.Options.MaxAge = -1
+}
+
+// loggedInSince reports whether the session's user gave their password within the last d.
+func loggedInSince(values map[interface{}]interface{}, d time.Duration, now time.Time) bool {
+	auth := sessionTime(values, sessionAuthKey)
+	return !auth.IsZero() && now.Sub(auth) < d
+}
+
+// A storedSession is a session as the memory and file backends keep it.
+type storedSession struct {
+	User    string
+	Data    []byte
+	Expires time.Time
+}
+
+// memorySessionBackend keeps sessions in a map, so they're lost when the process stops and can't be shared between processes.
+type memorySessionBackend struct {
+	mu       sync.Mutex
+	sessions map[string]*storedSession
+}
+
+func (mb *memorySessionBackend) load(id string) (data []byte, err error) {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	if s := mb.sessions[id]; s != nil && time.Now().Before(s.Expires) {
+		data = s.Data
+	}
+	return
+}
+
+func (mb *memorySessionBackend) insert(id string, user string, data []byte, expires time.Time) error {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	mb.sessions[id] = &storedSession{User: user, Data: data, Expires: expires}
+	return nil
+}
+
+func (mb *memorySessionBackend) update(id string, user string, data []byte, expires time.Time) (found bool, err error) {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	if s := mb.sessions[id]; s != nil && time.Now().Before(s.Expires) {
+		mb.sessions[id] = &storedSession{User: user, Data: data, Expires: expires}
+		found = true
+	}
+	return
+}
+
+func (mb *memorySessionBackend) delete(id string) error {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	delete(mb.sessions, id)
+	return nil
+}
+
+func (mb *memorySessionBackend) deleteUser(user string) error {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	for id, s := range mb.sessions {
+		if s.User == user {
+			delete(mb.sessions, id)
+		}
+	}
+	return nil
+}
+
+func (mb *memorySessionBackend) deleteExpired(now time.Time) error {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	for id, s := range mb.sessions {
+		if !now.Before(s.Expires) {
+			delete(mb.sessions, id)
+		}
+	}
+	return nil
+}
+
+// fileSessionBackend keeps each session in a gob encoded file named after its ID.
+type fileSessionBackend struct {
+	dir string
+	// Held while files are written or removed, so a file is never read half written by this process.
+	mu sync.RWMutex
+}
+
+func (fb *fileSessionBackend) read(name string) (s *storedSession, err error) {
+	b, err := ioutil.ReadFile(filepath.Join(fb.dir, name))
+	if err != nil {
+		return
+	}
+	s = &storedSession{}
+	err = gob.NewDecoder(bytes.NewReader(b)).Decode(s)
+	return
+}
+
+// each calls fn with every session file.
+func (fb *fileSessionBackend) each(fn func(name string, s *storedSession) error) error {
+	files, err := ioutil.ReadDir(fb.dir)
+	if err != nil {
+		return err
+	}
+	for _, fi := range files {
+		if !sessionIdPattern.MatchString(fi.Name()) {
+			continue
+		}
+		s, err := fb.read(fi.Name())
+		if err != nil {
+			continue
+		}
+		if err = fn(fi.Name(), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fb *fileSessionBackend) load(id string) (data []byte, err error) {
+	fb.mu.RLock()
+	defer fb.mu.RUnlock()
+	s, err := fb.read(id)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err == nil && time.Now().Before(s.Expires) {
+		data = s.Data
+	}
+	return
+}
+
+func (fb *fileSessionBackend) insert(id string, user string, data []byte, expires time.Time) error {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	return fb.write(id, &storedSession{User: user, Data: data, Expires: expires})
+}
+
+func (fb *fileSessionBackend) update(id string, user string, data []byte, expires time.Time) (found bool, err error) {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	s, err := fb.read(id)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil || !time.Now().Before(s.Expires) {
+		return
+	}
+	return true, fb.write(id, &storedSession{User: user, Data: data, Expires: expires})
+}
+
+// write saves a session file. It's called with mu held.
+func (fb *fileSessionBackend) write(id string, s *storedSession) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		return err
+	}
+	// Written to a temporary file and renamed, so other processes never see half a session either.
+	tmp := filepath.Join(fb.dir, "."+id)
+	if err := ioutil.WriteFile(tmp, buf.Bytes(), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(fb.dir, id))
+}
+
+func (fb *fileSessionBackend) delete(id string) error {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	if err := os.Remove(filepath.Join(fb.dir, id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (fb *fileSessionBackend) deleteUser(user string) error {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	return fb.each(func(name string, s *storedSession) error {
+		if s.User == user {
+			return os.Remove(filepath.Join(fb.dir, name))
+		}
+		return nil
+	})
+}
+
+func (fb *fileSessionBackend) deleteExpired(now time.Time) error {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	return fb.each(func(name string, s *storedSession) error {
+		if !now.Before(s.Expires) {
+			return os.Remove(filepath.Join(fb.dir, name))
+		}
+		return nil
+	})
+}
+
+// dbSessionBackend keeps sessions in the SESSION_TABLE table, so every process using the database shares them. The SQL to create
+// the table is in the documentation for Run(). data is the base64 encoded session values, and expires_on is in nanoseconds since
+// 1970.
+type dbSessionBackend struct {
+	dbs *model.DbSetup
+}
+
+// query fills the table name and placeholders into a query. The table is %[1]v and the placeholders are %[2]v to %[7]v.
+func (db *dbSessionBackend) query(q string) string {
+	queries := db.dbs.GetQueries()
+	args := []interface{}{queries.TableName(db.dbs.DefaultSchema, SESSION_TABLE)}
+	for i := 1; i <= 6; i++ {
+		args = append(args, queries.P(i))
+	}
+	return fmt.Sprintf(q, args...)
+}
+
+func (db *dbSessionBackend) load(id string) (data []byte, err error) {
+	var encoded string
+	err = db.dbs.Db.QueryRow(db.query("SELECT data FROM %[1]v WHERE id = %[2]v AND expires_on > %[3]v"), id,
+		time.Now().UnixNano()).Scan(&encoded)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return
+	}
+	return base64.StdEncoding.DecodeString(encoded)
+}
+
+func (db *dbSessionBackend) insert(id string, user string, data []byte, expires time.Time) (err error) {
+	_, err = db.dbs.Db.Exec(db.query("INSERT INTO %[1]v (id, username, data, expires_on) VALUES (%[2]v, %[3]v, %[4]v, %[5]v)"),
+		id, user, base64.StdEncoding.EncodeToString(data), expires.UnixNano())
+	return
+}
+
+// update relies on expires_on changing every time, since MySQL only counts rows that were changed as affected.
+func (db *dbSessionBackend) update(id string, user string, data []byte, expires time.Time) (found bool, err error) {
+	res, err := db.dbs.Db.Exec(db.query("UPDATE %[1]v SET username = %[2]v, data = %[3]v, expires_on = %[4]v WHERE id = %[5]v AND expires_on > %[6]v"),
+		user, base64.StdEncoding.EncodeToString(data), expires.UnixNano(), id, time.Now().UnixNano())
+	if err != nil {
+		return
+	}
+	n, err := res.RowsAffected()
+	found = n > 0
+	return
+}
+
+func (db *dbSessionBackend) delete(id string) (err error) {
+	_, err = db.dbs.Db.Exec(db.query("DELETE FROM %[1]v WHERE id = %[2]v"), id)
+	return
+}
+
+func (db *dbSessionBackend) deleteUser(user string) (err error) {
+	_, err = db.dbs.Db.Exec(db.query("DELETE FROM %[1]v WHERE username = %[2]v"), user)
+	return
+}
+
+func (db *dbSessionBackend) deleteExpired(now time.Time) (err error) {
+	_, err = db.dbs.Db.Exec(db.query("DELETE FROM %[1]v WHERE expires_on <= %[2]v"), now.UnixNano())
+	return
+}
@@ -0,0 +1,577 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bitbucket.org/jaybill/sawsij/framework/logging"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+	"github.com/kylelemons/go-gypsy/yaml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSessionStoreConfig(t *testing.T) {
+	tests := []struct {
+		config string
+		valid  bool
+		server bool
+	}{
+		{"", true, false},
+		{"session:\n  store: cookie\n", true, false},
+		{"session:\n  store: memory\n  idleTimeout: 1h\n", true, true},
+		{"session:\n  store: db\n", false, false},
+		{"session:\n  store: redis\n", false, false},
+		{"session:\n  idleTimeout: forever\n", false, false},
+	}
+	for _, test := range tests {
+		c := yaml.Config(test.config)
+		sc, err := newSessionCookie(c)
+		if err != nil {
+			if test.valid {
+				t.Errorf("%q: %v", test.config, err)
+			}
+			continue
+		}
+		codecs, err := sessionCodecs(c, "sakjdhuh23i123123", sc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		store, err := newSessionStore(c, os.TempDir(), nil, codecs, sc)
+		if (err == nil) != test.valid {
+			t.Errorf("%q: expected valid to be %v, got %v", test.config, test.valid, err)
+			continue
+		}
+		if _, ok := store.(*serverStore); err == nil && ok != test.server {
+			t.Errorf("%q: expected a server side store to be %v, got %T", test.config, test.server, store)
+		}
+	}
+}
+
+func TestServerSessions(t *testing.T) {
+	for _, kind := range []string{"memory", "file"} {
+		basePath := standupApp(t, "sessions", nil, map[string]string{"index.html": "<% .global.user %>"})
+		f, err := os.OpenFile(basePath+"/etc/config.yaml", os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.WriteString("session:\n  store: " + kind + "\n")
+		f.Close()
+
+		app, err := NewApp(testRoleSetup(), basePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var loginAs *authTestUser
+		app.Route(RouteConfig{Pattern: "/login", Permission: "site.view",
+			Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+				rs.Session.Values["user"] = loginAs
+				h.Redirect = "/"
+				return
+			}})
+		app.Route(RouteConfig{Pattern: "/", Permission: "posts.comment", Handler: testHandler})
+
+		login := func(u *authTestUser) string {
+			loginAs = u
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("GET", "http://localhost/login", nil)
+			app.ServeHTTP(w, r)
+			return strings.Split(w.Header().Get("Set-Cookie"), ";")[0]
+		}
+		get := func(cookie string) *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("GET", "http://localhost/", nil)
+			r.Header.Set("Cookie", cookie)
+			app.ServeHTTP(w, r)
+			return w
+		}
+		phone := login(&authTestUser{"alice", []int64{testMember}})
+		laptop := login(&authTestUser{"alice", []int64{testMember}})
+		other := login(&authTestUser{"bob", []int64{testMember}})
+
+		if len(phone) > 200 {
+			t.Errorf("%v: expected only a session ID in the cookie, got %v bytes", kind, len(phone))
+		}
+		if w := get(phone); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "alice") {
+			t.Errorf("%v: expected alice to be logged in, got %v %q", kind, w.Code, w.Body.String())
+		}
+
+		if err = app.RevokeSessions("alice"); err != nil {
+			t.Fatal(err)
+		}
+		for _, cookie := range []string{phone, laptop} {
+			if w := get(cookie); w.Code != http.StatusFound {
+				t.Errorf("%v: expected a revoked session to be sent to log in, got %v", kind, w.Code)
+			}
+		}
+		if w := get(other); w.Code != http.StatusOK {
+			t.Errorf("%v: expected bob to still be logged in, got %v", kind, w.Code)
+		}
+
+		ss := app.store.(*serverStore)
+		if err = ss.backend.deleteExpired(time.Now().Add(ss.cookie.idleTimeout + time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+		if w := get(other); w.Code != http.StatusFound {
+			t.Errorf("%v: expected an expired session to be gone, got %v", kind, w.Code)
+		}
+		if kind == "file" {
+			if files, _ := ioutil.ReadDir(basePath + "/sessions"); len(files) != 0 {
+				t.Errorf("Expected expired session files to be deleted, found %v", len(files))
+			}
+		}
+		os.RemoveAll(basePath)
+	}
+}
+
+func TestRevokeCookieSessions(t *testing.T) {
+	basePath := standupApp(t, "cookiesessions", nil, map[string]string{"index.html": "hello"})
+	defer os.RemoveAll(basePath)
+	app, err := NewApp(testRoleSetup(), basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = app.RevokeSessions("alice"); err == nil {
+		t.Error("Expected an error revoking sessions kept in cookies")
+	}
+}
+
+func TestSessionCookie(t *testing.T) {
+	tests := []struct {
+		config string
+		valid  bool
+		cookie string
+	}{
+		{"", true, "session=x; Path=/; Max-Age=2592000; HttpOnly; SameSite=Lax"},
+		{"server:\n  tls:\n    cert: etc/server.crt\n", true, "session=x; Path=/; Max-Age=2592000; HttpOnly; Secure; SameSite=Lax"},
+		{"session:\n  idleTimeout: 1h\n  cookie:\n    name: sid\n    domain: example.com\n    path: /app\n    httpOnly: false\n    sameSite: Strict\n",
+			true, "sid=x; Path=/app; Domain=example.com; Max-Age=3600; SameSite=Strict"},
+		{"session:\n  cookie:\n    secure: true\n    sameSite: none\n", true, "session=x; Path=/; Max-Age=2592000; HttpOnly; Secure; SameSite=None"},
+		{"session:\n  cookie:\n    sameSite: none\n", false, ""},
+		{"session:\n  cookie:\n    sameSite: sometimes\n", false, ""},
+	}
+	for _, test := range tests {
+		sc, err := newSessionCookie(yaml.Config(test.config))
+		if (err == nil) != test.valid {
+			t.Errorf("%q: expected valid to be %v, got %v", test.config, test.valid, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		w := httptest.NewRecorder()
+		sc.write(w, sc.name, "x", sc.maxAge())
+		cookie := w.Header().Get("Set-Cookie")
+		// Leave out Expires, which depends on the time.
+		parts := strings.Split(cookie, "; ")
+		for i, part := range parts {
+			if strings.HasPrefix(part, "Expires=") {
+				parts = append(parts[:i], parts[i+1:]...)
+				break
+			}
+		}
+		if strings.Join(parts, "; ") != test.cookie {
+			t.Errorf("%q: expected cookie %q, got %q", test.config, test.cookie, cookie)
+		}
+	}
+}
+
+func TestSessionExpiry(t *testing.T) {
+	sc := &sessionCookie{lifetime: 8 * time.Hour, idleTimeout: time.Hour}
+	start := time.Unix(1000000, 0)
+	session := sessions.NewSession(nil, "session")
+
+	if sc.expire(session, start) {
+		t.Error("Expected a new session not to be expired")
+	}
+	if session.Options.MaxAge != 3600 {
+		t.Errorf("Expected the cookie to last for the idle timeout, got %v", session.Options.MaxAge)
+	}
+	session.Values["user"] = "alice"
+
+	// Each request within the idle timeout keeps the session going.
+	now := start
+	for i := 0; i < 9; i++ {
+		now = now.Add(50 * time.Minute)
+		if sc.expire(session, now) {
+			t.Fatalf("Expected the session to still be going after %v", now.Sub(start))
+		}
+	}
+	now = now.Add(10 * time.Minute)
+	sc.expire(session, now)
+	if session.Options.MaxAge != 1200 {
+		t.Errorf("Expected the cookie to last until the end of the lifetime, got %v", session.Options.MaxAge)
+	}
+
+	// The lifetime ends it however busy it is.
+	now = start.Add(8 * time.Hour)
+	if !sc.expire(session, now) {
+		t.Error("Expected the session to be expired at the end of its lifetime")
+	}
+	if session.Values["user"] != nil {
+		t.Error("Expected an expired session to be emptied")
+	}
+
+	session.Values["user"] = "alice"
+	if !sc.expire(session, now.Add(time.Hour)) {
+		t.Error("Expected the session to be expired after being idle")
+	}
+}
+
+func TestSessionKeys(t *testing.T) {
+	sc := &sessionCookie{idleTimeout: time.Hour}
+	oldKey, _ := NewSessionKey()
+	newKey, _ := NewSessionKey()
+	tests := []struct {
+		config string
+		valid  bool
+	}{
+		{"encryption:\n  sessionKeys:\n    - " + oldKey + "\n", true},
+		{"encryption:\n  sessionKeys:\n    - " + newKey + "\n    - " + oldKey + "\n", true},
+		{"encryption:\n  sessionKeys:\n    - c2hvcnQ=\n", false},
+		{"encryption:\n  sessionKeys:\n    - not base64!\n", false},
+	}
+	for _, test := range tests {
+		_, err := sessionCodecs(yaml.Config(test.config), "", sc)
+		if (err == nil) != test.valid {
+			t.Errorf("%q: expected valid to be %v, got %v", test.config, test.valid, err)
+		}
+	}
+	if _, err := sessionCodecs(yaml.Config(""), "", sc); err == nil {
+		t.Error("Expected an error with no keys at all")
+	}
+
+	old, _ := sessionCodecs(yaml.Config(tests[0].config), "", sc)
+	rotated, _ := sessionCodecs(yaml.Config(tests[1].config), "", sc)
+	values := map[interface{}]interface{}{"user": "alice"}
+	encoded, err := securecookie.EncodeMulti("session", values, old...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The cookie is base64 of "date|value|mac", and value is base64 too.
+	raw, _ := base64.URLEncoding.DecodeString(encoded)
+	if parts := strings.SplitN(string(raw), "|", 3); len(parts) != 3 {
+		t.Errorf("Expected three parts in the cookie, got %q", raw)
+	} else if value, _ := base64.URLEncoding.DecodeString(parts[1]); strings.Contains(string(value), "alice") {
+		t.Error("Expected the cookie to be encrypted")
+	}
+
+	// Cookies made with the old key are still read after a new one is added.
+	decoded := make(map[interface{}]interface{})
+	if err = securecookie.DecodeMulti("session", encoded, &decoded, rotated...); err != nil || decoded["user"] != "alice" {
+		t.Errorf("Expected a cookie made with the old key to be read, got %v %v", decoded, err)
+	}
+	// New cookies use the new key, so they can't be read with only the old one.
+	encoded, _ = securecookie.EncodeMulti("session", values, rotated...)
+	if err = securecookie.DecodeMulti("session", encoded, &decoded, old...); err == nil {
+		t.Error("Expected new cookies to be made with the new key")
+	}
+}
+
+func TestSessionRenewal(t *testing.T) {
+	for _, kind := range []string{"cookie", "memory"} {
+		basePath := standupApp(t, "renewal", nil, map[string]string{
+			"index.html":  "<% if .global.user %><% .global.user.Username %><% end %>",
+			"delete.html": "deleted",
+		})
+		f, err := os.OpenFile(basePath+"/etc/config.yaml", os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.WriteString("session:\n  store: " + kind + "\n")
+		f.Close()
+
+		app, err := NewApp(testRoleSetup(), basePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		app.Route(RouteConfig{Pattern: "/login", Permission: "site.view",
+			Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+				alice := &authTestUser{"alice", []int64{testMember}}
+				if r.FormValue("legacy") != "" {
+					// Logged in the way handlers did before LogIn().
+					rs.Session.Values["user"] = alice
+				} else {
+					err = rs.LogIn(alice)
+				}
+				h.Redirect = "/"
+				return
+			}})
+		app.Route(RouteConfig{Pattern: "/", Permission: "site.view", Handler: testHandler})
+		app.Route(RouteConfig{Pattern: "/delete", Permission: "posts.comment", Handler: testHandler, RecentLogin: time.Minute})
+		app.Route(RouteConfig{Pattern: "/logout", Permission: "site.view", Handler: LogoutHandler})
+
+		get := func(path string, cookie string) *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("GET", "http://localhost"+path, nil)
+			r.Header.Set("Cookie", cookie)
+			app.ServeHTTP(w, r)
+			return w
+		}
+		cookieOf := func(w *httptest.ResponseRecorder) string {
+			return strings.Split(w.Header().Get("Set-Cookie"), ";")[0]
+		}
+
+		// A guest session someone planted in the browser doesn't become logged in when the user logs in with it.
+		planted := cookieOf(get("/", ""))
+		alice := cookieOf(get("/login", planted))
+		if alice == planted {
+			t.Errorf("%v: expected a new cookie after logging in", kind)
+		}
+		if w := get("/", planted); strings.Contains(w.Body.String(), "alice") {
+			t.Errorf("%v: expected the planted session not to be logged in", kind)
+		}
+		if w := get("/", alice); !strings.Contains(w.Body.String(), "alice") {
+			t.Errorf("%v: expected the new session to be logged in, got %q", kind, w.Body.String())
+		}
+
+		if w := get("/delete", alice); w.Code != http.StatusOK {
+			t.Errorf("%v: expected a recent login to be let through, got %v", kind, w.Code)
+		}
+		legacy := cookieOf(get("/login?legacy=1", ""))
+		if w := get("/delete", legacy); w.Code != http.StatusFound || !strings.HasPrefix(w.Header().Get("Location"), "/login/dest/") {
+			t.Errorf("%v: expected a login without a password time to be asked for it again, got %v %q", kind, w.Code, w.Header().Get("Location"))
+		}
+		if w := get("/", legacy); w.Code != http.StatusOK {
+			t.Errorf("%v: expected other routes not to need a recent login, got %v", kind, w.Code)
+		}
+
+		w := get("/logout", alice)
+		if !strings.Contains(w.Header().Get("Set-Cookie"), "Max-Age=0") {
+			t.Errorf("%v: expected logging out to delete the cookie, got %q", kind, w.Header().Get("Set-Cookie"))
+		}
+		if kind == "memory" {
+			// The server side copy is gone too, so the old cookie is no good.
+			if w := get("/", alice); strings.Contains(w.Body.String(), "alice") {
+				t.Errorf("%v: expected the session to be destroyed", kind)
+			}
+		}
+		os.RemoveAll(basePath)
+	}
+}
+
+func TestLoggedInSince(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	values := map[interface{}]interface{}{}
+	if loggedInSince(values, time.Hour, now) {
+		t.Error("Expected a session without a login time not to be a recent login")
+	}
+	values[sessionAuthKey] = now.Add(-30 * time.Minute).Unix()
+	if !loggedInSince(values, time.Hour, now) {
+		t.Error("Expected a login 30 minutes ago to be within an hour")
+	}
+	if loggedInSince(values, 10*time.Minute, now) {
+		t.Error("Expected a login 30 minutes ago not to be within 10 minutes")
+	}
+}
+
+func TestGuestSessionsNotStored(t *testing.T) {
+	basePath := standupApp(t, "guestsessions", nil, map[string]string{"index.html": "hello"})
+	defer os.RemoveAll(basePath)
+	f, err := os.OpenFile(basePath+"/etc/config.yaml", os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("session:\n  store: memory\n")
+	f.Close()
+
+	app, err := NewApp(testRoleSetup(), basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app.Route(RouteConfig{Pattern: "/", Permission: "site.view", Handler: testHandler})
+	app.Route(RouteConfig{Pattern: "/token", Permission: "site.view", ReturnType: RT_JSON,
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			h.Init()
+			h.View["token"] = rs.Session.Values[csrfSessionKey]
+			return
+		}})
+	app.Route(RouteConfig{Pattern: "/login", Permission: "site.view",
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			err = rs.LogIn(&authTestUser{"alice", []int64{testMember}})
+			h.Redirect = "/"
+			return
+		}})
+	get := func(path string, cookie string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost"+path, nil)
+		if cookie != "" {
+			r.Header.Set("Cookie", cookie)
+		}
+		app.ServeHTTP(w, r)
+		return w
+	}
+	backend := app.store.(*serverStore).backend.(*memorySessionBackend)
+
+	for i := 0; i < 100; i++ {
+		if w := get("/", ""); w.Code != http.StatusOK {
+			t.Fatalf("Expected 200, got %v", w.Code)
+		}
+	}
+	if len(backend.sessions) != 0 {
+		t.Errorf("Expected guests not to be stored, got %v sessions", len(backend.sessions))
+	}
+
+	// A guest keeps the same CSRF token from one request to the next, from the cookie.
+	w := get("/token", "")
+	guest := strings.Split(w.Header().Get("Set-Cookie"), ";")[0]
+	if second := get("/token", guest); second.Body.String() != w.Body.String() {
+		t.Errorf("Expected the guest's token to be kept, got %q then %q", w.Body.String(), second.Body.String())
+	}
+
+	get("/login", guest)
+	if len(backend.sessions) != 1 {
+		t.Errorf("Expected a session to be stored once there's a user, got %v", len(backend.sessions))
+	}
+}
+
+// failingSessionBackend is a session backend whose database has gone away.
+type failingSessionBackend struct{ memorySessionBackend }
+
+func (fb *failingSessionBackend) insert(id string, user string, data []byte, expires time.Time) error {
+	return errors.New("database is down")
+}
+
+func TestSessionSaveFails(t *testing.T) {
+	basePath := standupApp(t, "savefails", nil, map[string]string{"index.html": "hello"})
+	defer os.RemoveAll(basePath)
+	f, err := os.OpenFile(basePath+"/etc/config.yaml", os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("session:\n  store: memory\n")
+	f.Close()
+
+	app, err := NewApp(testRoleSetup(), basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var logged bytes.Buffer
+	app.Log = logging.New(&logged, logging.LEVEL_ERROR, false)
+	app.store.(*serverStore).backend = &failingSessionBackend{memorySessionBackend{sessions: make(map[string]*storedSession)}}
+	app.Route(RouteConfig{Pattern: "/login", Permission: "site.view",
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			err = rs.LogIn(&authTestUser{"alice", []int64{testMember}})
+			h.Redirect = "/"
+			return
+		}})
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "http://localhost/login", nil)
+	app.ServeHTTP(w, r)
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Expected a 500 when the session can't be saved, got %v", w.Code)
+	}
+	if !strings.Contains(logged.String(), "Saving session failed") {
+		t.Errorf("Expected the failure to be logged, got %q", logged.String())
+	}
+}
+
+func TestExpiredSessionReplacedOnRedirect(t *testing.T) {
+	basePath := standupApp(t, "expiredredirect", nil, map[string]string{"index.html": "hello"})
+	defer os.RemoveAll(basePath)
+	f, err := os.OpenFile(basePath+"/etc/config.yaml", os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("session:\n  store: memory\n  idleTimeout: 1h\n")
+	f.Close()
+
+	app, err := NewApp(testRoleSetup(), basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app.Route(RouteConfig{Pattern: "/login", Permission: "site.view",
+		Handler: func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+			err = rs.LogIn(&authTestUser{"alice", []int64{testMember}})
+			// As if the last request was two hours ago.
+			rs.Session.Values[sessionSeenKey] = time.Now().Add(-2 * time.Hour).Unix()
+			h.Redirect = "/"
+			return
+		}})
+	app.Route(RouteConfig{Pattern: "/", Permission: "posts.comment", Handler: testHandler})
+
+	get := func(path string, cookie string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost"+path, nil)
+		r.Header.Set("Cookie", cookie)
+		app.ServeHTTP(w, r)
+		return w
+	}
+	cookie := strings.Split(get("/login", "").Header().Get("Set-Cookie"), ";")[0]
+
+	w := get("/", cookie)
+	if w.Code != http.StatusFound || !strings.HasPrefix(w.Header().Get("Location"), "/login/dest/") {
+		t.Fatalf("Expected an expired session to be sent to log in, got %v %q", w.Code, w.Header().Get("Location"))
+	}
+	if w.Header().Get("Set-Cookie") == "" {
+		t.Error("Expected the expired session's cookie to be replaced")
+	}
+	backend := app.store.(*serverStore).backend.(*memorySessionBackend)
+	for id, s := range backend.sessions {
+		if s.User != "" {
+			t.Errorf("Expected the expired session %v not to keep its user, got %q", id, s.User)
+		}
+	}
+}
+
+func TestRevokeDuringRequest(t *testing.T) {
+	for _, kind := range []string{"memory", "file"} {
+		basePath, err := ioutil.TempDir("", "sawsijrevoke")
+		if err != nil {
+			t.Fatal(err)
+		}
+		c := yaml.Config("session:\n  store: " + kind + "\n")
+		sc, _ := newSessionCookie(c)
+		codecs, err := sessionCodecs(c, "sakjdhuh23i123123", sc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		store, err := newSessionStore(c, basePath, nil, codecs, sc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ss := store.(*serverStore)
+
+		r, _ := http.NewRequest("GET", "http://localhost/", nil)
+		session, _ := ss.New(r, "session")
+		session.Values["user"] = &authTestUser{"alice", []int64{testMember}}
+		w := httptest.NewRecorder()
+		if err = ss.Save(r, w, session); err != nil {
+			t.Fatal(err)
+		}
+
+		// A request loads the session, then it's revoked before the request saves it again.
+		r, _ = http.NewRequest("GET", "http://localhost/", nil)
+		r.Header.Set("Cookie", strings.Split(w.Header().Get("Set-Cookie"), ";")[0])
+		session, _ = ss.New(r, "session")
+		if session.ID == "" {
+			t.Fatalf("%v: expected the session to be loaded", kind)
+		}
+		if err = ss.backend.deleteUser("alice"); err != nil {
+			t.Fatal(err)
+		}
+		session.Values["seen"] = true
+		w = httptest.NewRecorder()
+		if err = ss.Save(r, w, session); err != nil {
+			t.Fatal(err)
+		}
+		if data, _ := ss.backend.load(session.ID); data != nil {
+			t.Errorf("%v: expected a revoked session not to be saved again", kind)
+		}
+		if !strings.Contains(w.Header().Get("Set-Cookie"), "Max-Age=0") {
+			t.Errorf("%v: expected the cookie of a revoked session to be deleted, got %q", kind, w.Header().Get("Set-Cookie"))
+		}
+		os.RemoveAll(basePath)
+	}
+}
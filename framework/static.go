@@ -0,0 +1,255 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bitbucket.org/jaybill/sawsij/framework/logging"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/gorilla/context"
+	"github.com/kylelemons/go-gypsy/yaml"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// The Cache-Control header sent with fingerprinted asset URLs. They change whenever the file does, so they can be cached forever.
+	STATIC_IMMUTABLE_CACHE_CONTROL = "public, max-age=31536000, immutable"
+	// How many characters of the content hash go into a fingerprinted asset URL.
+	staticFingerprintLength = 12
+)
+
+// The precompressed variants of a static file that are looked for, in order of preference. A file called site.css.br is sent
+// instead of site.css to clients that accept brotli.
+var staticEncodings = []struct {
+	coding    string
+	extension string
+}{
+	{"br", ".br"},
+	{"gzip", ".gz"},
+}
+
+// Matches a fingerprinted asset name, i.e. "css/site.0123456789ab.css", capturing the name, the fingerprint and the extension.
+var staticFingerprint = regexp.MustCompile(`^(.+)\.([0-9a-f]{12})(\.[^./]+)?$`)
+
+// A staticHash is the content hash of a file, along with the size and modification time it had when it was hashed.
+type staticHash struct {
+	size    int64
+	modTime time.Time
+	sum     string
+}
+
+// staticFiles serves the files in an application's static folder. It can be used by many requests at once.
+type staticFiles struct {
+	dir string
+	// The URL path the files are served under, with slashes at both ends.
+	mount        string
+	cacheControl string
+	etags        bool
+	log          logging.Logger
+
+	mu sync.Mutex
+	// Content hashes by file name, used for ETags and fingerprints. An entry is worked out again when its file changes.
+	hashes map[string]staticHash
+}
+
+// newStaticFiles sets up serving for the files in dir, using the settings in the "server.static" section of the config file.
+// Problems are logged to l.
+func newStaticFiles(c *yaml.File, dir string, l logging.Logger) (sf *staticFiles, err error) {
+	sf = &staticFiles{dir: dir, log: l, hashes: make(map[string]staticHash)}
+	mount, err := configString(c, "server.static.path", "/static/")
+	if err != nil {
+		return
+	}
+	sf.mount = "/" + strings.Trim(strings.TrimSpace(mount), "/") + "/"
+	if sf.mount == "//" {
+		err = &SawsijError{"Config value server.static.path can't be the root of the site"}
+		return
+	}
+	if sf.cacheControl, err = configString(c, "server.static.cacheControl", ""); err != nil {
+		return
+	}
+	sf.etags, err = configBool(c, "server.static.etags", true)
+	return
+}
+
+// cleanName turns a path relative to the static folder into a clean, slash separated name that can't point outside it. It returns
+// an empty string for the folder itself and for hidden files, which are never served.
+func cleanName(name string) string {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	for _, part := range strings.Split(name, "/") {
+		if strings.HasPrefix(part, ".") {
+			return ""
+		}
+	}
+	return name
+}
+
+// stat returns information about the named file, which must be a clean name.
+func (sf *staticFiles) stat(name string) (os.FileInfo, error) {
+	return os.Stat(filepath.Join(sf.dir, filepath.FromSlash(name)))
+}
+
+// hash returns the hex encoded SHA-256 of the named file, which fi describes. Hashes are kept until the file changes.
+func (sf *staticFiles) hash(name string, fi os.FileInfo) (sum string, err error) {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	if h, ok := sf.hashes[name]; ok && h.size == fi.Size() && h.modTime.Equal(fi.ModTime()) {
+		sum = h.sum
+		return
+	}
+
+	f, err := os.Open(filepath.Join(sf.dir, filepath.FromSlash(name)))
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	hasher := sha256.New()
+	if _, err = io.Copy(hasher, f); err != nil {
+		return
+	}
+	sum = hex.EncodeToString(hasher.Sum(nil))
+	sf.hashes[name] = staticHash{size: fi.Size(), modTime: fi.ModTime(), sum: sum}
+	return
+}
+
+// url returns the fingerprinted URL of the named file, i.e. "/static/css/site.0123456789ab.css" for "css/site.css". If the file
+// can't be read, the error is logged and the plain URL is returned.
+func (sf *staticFiles) url(name string) string {
+	name = cleanName(name)
+	fi, err := sf.stat(name)
+	if err == nil && fi.IsDir() {
+		err = &SawsijError{"it's a folder"}
+	}
+	var sum string
+	if err == nil {
+		sum, err = sf.hash(name, fi)
+	}
+	if err != nil {
+		sf.log.Warn("Can't fingerprint static file", "file", name, "error", err)
+		return sf.mount + name
+	}
+	ext := path.Ext(name)
+	return sf.mount + strings.TrimSuffix(name, ext) + "." + sum[:staticFingerprintLength] + ext
+}
+
+// acceptsEncoding reports whether the Accept-Encoding header value allows the given content coding, i.e. "gzip". The coding's own
+// entry wins over "*", wherever they are in the list.
+func acceptsEncoding(header string, coding string) bool {
+	wildcard := false
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		if name != coding && name != "*" {
+			continue
+		}
+		accepted := true
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(param[2:], 64); err == nil && q == 0 {
+					accepted = false
+				}
+			}
+		}
+		if name == coding {
+			return accepted
+		}
+		wildcard = accepted
+	}
+	return wildcard
+}
+
+// ServeHTTP sends the static file named by the part of the request path after the mount path. Folders are never listed. A request
+// for a fingerprinted name gets the current file, and if the fingerprint matches it, a Cache-Control header that lets it be kept
+// forever. When a .br or .gz file sits next to the one asked for and the client accepts that encoding, it's sent instead.
+func (sf *staticFiles) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	context.Set(r, patternKey, sf.mount)
+	if r.Method != "GET" && r.Method != "HEAD" {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := cleanName(strings.TrimPrefix(r.URL.Path, sf.mount))
+	cacheControl := sf.cacheControl
+	fi, err := sf.stat(name)
+	if err != nil {
+		if m := staticFingerprint.FindStringSubmatch(name); m != nil {
+			plain := m[1] + m[3]
+			if fi, err = sf.stat(plain); err == nil && !fi.IsDir() {
+				name = plain
+				if sum, herr := sf.hash(name, fi); herr == nil && sum[:staticFingerprintLength] == m[2] {
+					cacheControl = STATIC_IMMUTABLE_CACHE_CONTROL
+				}
+			}
+		}
+	}
+	if name == "" || err != nil || fi.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+
+	served, servedInfo, encoding, vary := name, fi, "", false
+	for _, enc := range staticEncodings {
+		efi, eerr := sf.stat(name + enc.extension)
+		if eerr != nil || efi.IsDir() {
+			continue
+		}
+		vary = true
+		if encoding == "" && acceptsEncoding(r.Header.Get("Accept-Encoding"), enc.coding) {
+			served, servedInfo, encoding = name+enc.extension, efi, enc.coding
+		}
+	}
+	if vary {
+		w.Header().Add("Vary", "Accept-Encoding")
+	}
+
+	f, err := os.Open(filepath.Join(sf.dir, filepath.FromSlash(served)))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+
+	ctype := mime.TypeByExtension(path.Ext(name))
+	if ctype == "" && encoding != "" {
+		// Sniffing the compressed bytes would get it wrong.
+		ctype = "application/octet-stream"
+	}
+	if ctype != "" {
+		w.Header().Set("Content-Type", ctype)
+	}
+	if encoding != "" {
+		w.Header().Set("Content-Encoding", encoding)
+	}
+	if cacheControl != "" {
+		w.Header().Set("Cache-Control", cacheControl)
+	}
+	if sf.etags {
+		// The hash is of the bytes being sent, so each encoding gets its own ETag.
+		if sum, herr := sf.hash(served, servedInfo); herr == nil {
+			w.Header().Set("ETag", `"`+sum[:32]+`"`)
+		}
+	}
+	http.ServeContent(w, r, name, servedInfo.ModTime(), f)
+}
+
+// Asset returns the URL of a file in the static folder with a fingerprint of its contents in the name, so "css/site.css" becomes
+// something like "/static/css/site.0123456789ab.css". The URL changes whenever the file does, so it's served with a Cache-Control
+// header that lets browsers and proxies keep it forever. In a template it's used as <link href="<% asset "css/site.css" %>" ...>
+// Used by the template parser as "asset"
+func (app *App) Asset(name string) string {
+	return app.static.url(name)
+}
@@ -0,0 +1,126 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+// getStatic requests path from app with the given Accept-Encoding and If-None-Match headers and returns the recorded response.
+func getStatic(app *App, path string, acceptEncoding string, ifNoneMatch string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "http://localhost"+path, nil)
+	if acceptEncoding != "" {
+		r.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	if ifNoneMatch != "" {
+		r.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	app.ServeHTTP(w, r)
+	return w
+}
+
+func TestStaticFiles(t *testing.T) {
+	config := []string{"static:", "  path: /assets", "  cacheControl: public, max-age=60"}
+	basePath := standupApp(t, "static", config, map[string]string{"index.html": `<% asset "css/site.css" %>`})
+	defer os.RemoveAll(basePath)
+
+	files := map[string]string{
+		"css/site.css":    "body { color: red; }",
+		"css/site.css.gz": "gzipped",
+		"css/site.css.br": "brotli",
+		"js/app.js":       "alert(1);",
+		".secret":         "hidden",
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(basePath+"/static/"+name[:strings.LastIndex(name, "/")+1], 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := WriteStringToFile(content, basePath+"/static/"+name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app.Route(RouteConfig{Pattern: "/", Handler: testHandler, Roles: []int{R_GUEST}})
+
+	w := getStatic(app, "/assets/js/app.js", "", "")
+	if w.Code != http.StatusOK || w.Body.String() != files["js/app.js"] {
+		t.Fatalf("Expected app.js, got %v %q", w.Code, w.Body.String())
+	}
+	if cc := w.Header().Get("Cache-Control"); cc != "public, max-age=60" {
+		t.Errorf("Expected configured Cache-Control, got %q", cc)
+	}
+	etag := w.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("Expected an ETag")
+	}
+	if w = getStatic(app, "/assets/js/app.js", "", etag); w.Code != http.StatusNotModified {
+		t.Errorf("Expected 304 for a matching ETag, got %v", w.Code)
+	}
+
+	encodings := []struct {
+		accept   string
+		body     string
+		encoding string
+	}{
+		{"", files["css/site.css"], ""},
+		{"gzip, deflate", "gzipped", "gzip"},
+		{"gzip, br", "brotli", "br"},
+		{"gzip, br;q=0", "gzipped", "gzip"},
+		{"*;q=0, gzip", "gzipped", "gzip"},
+		{"br;q=0, *", "gzipped", "gzip"},
+		{"gzip;q=0, *, br;q=0", files["css/site.css"], ""},
+	}
+	etags := make(map[string]bool)
+	for _, e := range encodings {
+		w = getStatic(app, "/assets/css/site.css", e.accept, "")
+		if w.Body.String() != e.body || w.Header().Get("Content-Encoding") != e.encoding {
+			t.Errorf("Accept-Encoding %q: expected %q encoded as %q, got %q as %q", e.accept, e.body, e.encoding, w.Body.String(),
+				w.Header().Get("Content-Encoding"))
+		}
+		if !strings.HasPrefix(w.Header().Get("Content-Type"), "text/css") || w.Header().Get("Vary") != "Accept-Encoding" {
+			t.Errorf("Accept-Encoding %q: wrong headers %v", e.accept, w.Header())
+		}
+		etags[w.Header().Get("ETag")] = true
+	}
+	if len(etags) != 3 {
+		t.Errorf("Expected a different ETag for each encoding, got %v", etags)
+	}
+
+	for _, path := range []string{"/assets/", "/assets/css", "/assets/.secret", "/assets/../etc/config.yaml", "/static/js/app.js"} {
+		if w = getStatic(app, path, "", ""); w.Code != http.StatusNotFound {
+			t.Errorf("%v: expected 404, got %v", path, w.Code)
+		}
+	}
+
+	w = getStatic(app, "/", "", "")
+	url := w.Body.String()
+	if !strings.HasPrefix(url, "/assets/css/site.") || !strings.HasSuffix(url, ".css") || len(url) != len("/assets/css/site..css")+12 {
+		t.Fatalf("Expected a fingerprinted URL, got %q", url)
+	}
+	w = getStatic(app, url, "", "")
+	if w.Body.String() != files["css/site.css"] || w.Header().Get("Cache-Control") != STATIC_IMMUTABLE_CACHE_CONTROL {
+		t.Errorf("Expected site.css with an immutable Cache-Control, got %q %v", w.Body.String(), w.Header())
+	}
+	w = getStatic(app, "/assets/css/site.0123456789ab.css", "", "")
+	if w.Code != http.StatusOK || w.Header().Get("Cache-Control") != "public, max-age=60" {
+		t.Errorf("Expected a stale fingerprint to get the current file and the normal Cache-Control, got %v %v", w.Code, w.Header())
+	}
+
+	if err = WriteStringToFile("body { color: blue; }", basePath+"/static/css/site.css"); err != nil {
+		t.Fatal(err)
+	}
+	if changed := getStatic(app, "/", "", "").Body.String(); changed == url {
+		t.Errorf("Expected the fingerprint to change with the file, still %q", changed)
+	}
+}
@@ -84,6 +84,13 @@ func Truncate(input string, length int, cont string) (output string) {
 	return
 }
 
+// Layout declares the layout a page is rendered in, i.e. <% layout "layouts/main.html" %>. It has to be the first thing in the template.
+// The page then fills in the blocks of the layout with define, like <% define "content" %>...<% end %>. Layout itself outputs nothing;
+// the declaration is read when the templates are loaded. Used by the template parser as "layout"
+func Layout(name string) string {
+	return ""
+}
+
 // GetFuncMap returns a template.FuncMap which will be passed to the template parser.
 func GetFuncMap() (fnm template.FuncMap) {
 	fnm = make(template.FuncMap)
@@ -93,5 +100,8 @@ func GetFuncMap() (fnm template.FuncMap) {
 	fnm["round"] = Round
 	fnm["equal"] = Compare
 	fnm["notequal"] = NotEqual
+	fnm["csrfField"] = CsrfField
+	fnm["can"] = HasPermission
+	fnm["layout"] = Layout
 	return
 }
@@ -0,0 +1,240 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bitbucket.org/jaybill/sawsij/framework/logging"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Matches a layout declaration at the start of a template, i.e. <% layout "layouts/main.html" %>
+var layoutDeclaration = regexp.MustCompile(`^\s*<%-?\s*layout\s+"([^"]+)"\s*-?%>`)
+
+// A templateSet is everything parsed from the templates folder at one time.
+type templateSet struct {
+	// Every template that doesn't declare a layout. Pages without a layout are executed from here.
+	base *template.Template
+	// An unexecuted copy of base, which page sets are cloned from. html/template doesn't allow cloning once a template has run.
+	proto *template.Template
+	// The source of every template, by name.
+	sources map[string]string
+	// The layout each page declared, by page name.
+	layouts map[string]string
+	// Sets made of proto plus one page, so that the blocks a page defines don't clash with other pages. Built when first needed.
+	pages map[string]*template.Template
+}
+
+// A templateCache holds the parsed templates of an application. It can be used by many requests at once. When reloading is on,
+// the templates are parsed again whenever a file in the templates folder is added, removed or changed.
+type templateCache struct {
+	dir   string
+	funcs template.FuncMap
+	log   logging.Logger
+
+	mu sync.RWMutex
+	// The last templates that parsed without errors. They're kept when a later parse fails.
+	set *templateSet
+	// The error from the last parse, or nil if it worked.
+	err error
+	// What the template files looked like when they were last parsed. See fingerprint().
+	stamp string
+}
+
+// newTemplateCache returns a cache for the templates in dir. The templates are parsed straight away; if that fails, the error is
+// logged to l and kept in the cache.
+func newTemplateCache(dir string, funcs template.FuncMap, l logging.Logger) (tc *templateCache) {
+	tc = &templateCache{dir: dir, funcs: funcs, log: l}
+	files, stamp := tc.fingerprint()
+	tc.mu.Lock()
+	tc.parse(files, stamp)
+	tc.mu.Unlock()
+	return
+}
+
+// fingerprint lists the template files, including those in subfolders, by name. It also returns a string made from their names,
+// sizes and modification times, which changes when any of them do.
+func (tc *templateCache) fingerprint() (files map[string]string, stamp string) {
+	files = make(map[string]string)
+	var parts []string
+	err := filepath.Walk(tc.dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() || !strings.HasSuffix(fi.Name(), "html") {
+			return nil
+		}
+		rel, err := filepath.Rel(tc.dir, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		files[name] = path
+		parts = append(parts, fmt.Sprintf("%v:%v:%v", name, fi.Size(), fi.ModTime().UnixNano()))
+		return nil
+	})
+	if err != nil {
+		return nil, err.Error()
+	}
+	sort.Strings(parts)
+	stamp = strings.Join(parts, "|")
+	return
+}
+
+// parse reads the template files into a new set, which replaces the old one only if there were no errors. The caller must hold
+// the write lock.
+func (tc *templateCache) parse(files map[string]string, stamp string) {
+	tc.stamp = stamp
+	set, err := tc.newSet(files)
+	tc.err = err
+	if err != nil {
+		tc.log.Error("Template parse failed", "dir", tc.dir, "error", err)
+		return
+	}
+	tc.set = set
+}
+
+// newSet parses the given files, which are keyed by template name.
+func (tc *templateCache) newSet(files map[string]string) (set *templateSet, err error) {
+	if len(files) == 0 {
+		err = &SawsijError{"No templates were found in " + tc.dir}
+		return
+	}
+	set = &templateSet{sources: make(map[string]string), layouts: make(map[string]string), pages: make(map[string]*template.Template)}
+
+	var names []string
+	for name, path := range files {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		set.sources[name] = string(b)
+		if m := layoutDeclaration.FindStringSubmatch(set.sources[name]); m != nil {
+			set.layouts[name] = m[1]
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	set.proto = template.New("dummy").Delims("<%", "%>").Funcs(tc.funcs)
+	for _, name := range names {
+		if _, ok := set.layouts[name]; ok {
+			continue
+		}
+		if _, err = set.proto.New(name).Parse(set.sources[name]); err != nil {
+			return nil, err
+		}
+	}
+	// Parse each page with a layout now, so errors in it are found straight away rather than on its first request.
+	for name := range set.layouts {
+		if _, err = set.page(name); err != nil {
+			return nil, err
+		}
+	}
+	set.base, err = set.proto.Clone()
+	return
+}
+
+// page returns a template set made of every template without a layout, plus the named page. The page is parsed last, so the blocks
+// it defines replace the defaults in its layout. The caller must hold the write lock, or be the only one using the set.
+func (set *templateSet) page(name string) (tmpl *template.Template, err error) {
+	if tmpl = set.pages[name]; tmpl != nil {
+		return
+	}
+	src, ok := set.sources[name]
+	if !ok {
+		err = &SawsijError{fmt.Sprintf("Template %q does not exist", name)}
+		return
+	}
+	if tmpl, err = set.proto.Clone(); err != nil {
+		return
+	}
+	if _, err = tmpl.New(name).Parse(src); err != nil {
+		return
+	}
+	set.pages[name] = tmpl
+	return
+}
+
+// has reports whether there is a template with the given name.
+func (tc *templateCache) has(name string) bool {
+	tc.mu.RLock()
+	defer tc.mu.RUnlock()
+	if tc.set == nil {
+		return false
+	}
+	_, ok := tc.set.sources[name]
+	return ok
+}
+
+// pageFor works out which template renders a route pattern. For "/admin/users/edit" it's "admin/users/edit.html" if that exists,
+// then "admin/users/edit/index.html", and otherwise "admin-users-edit.html" from GetTemplateName().
+func (tc *templateCache) pageFor(pattern string) string {
+	templatePath := GetTemplatePath(pattern)
+	for _, name := range []string{templatePath + ".html", templatePath + "/index.html"} {
+		if tc.has(name) {
+			return name
+		}
+	}
+	return GetTemplateName(pattern) + ".html"
+}
+
+// reload parses the templates again if the files have changed since they were last parsed.
+func (tc *templateCache) reload() {
+	files, stamp := tc.fingerprint()
+	tc.mu.RLock()
+	changed := stamp != tc.stamp
+	tc.mu.RUnlock()
+	if changed {
+		tc.mu.Lock()
+		// Another request may have reloaded while we waited for the lock.
+		if stamp != tc.stamp {
+			tc.log.Info("Templates have changed, reloading", "dir", tc.dir)
+			tc.parse(files, stamp)
+		}
+		tc.mu.Unlock()
+	}
+}
+
+// lookup finds what to execute to render page. If layout is blank, the layout the page declared is used, if it declared one.
+// It returns the template set to execute and the name of the template in it to run, which is the layout when there is one.
+// err is the error from the last parse; even when it isn't nil, tmpl may come from the last good templates, which can be used for
+// error pages.
+func (tc *templateCache) lookup(page string, layout string) (tmpl *template.Template, name string, err error) {
+	tc.mu.RLock()
+	set, err := tc.set, tc.err
+	if set != nil && layout == "" {
+		layout = set.layouts[page]
+	}
+	if set == nil || layout == "" {
+		if set != nil {
+			tmpl = set.base
+		}
+		tc.mu.RUnlock()
+		name = page
+		return
+	}
+	tmpl = set.pages[page]
+	tc.mu.RUnlock()
+
+	name = layout
+	if tmpl == nil {
+		tc.mu.Lock()
+		var perr error
+		tmpl, perr = set.page(page)
+		tc.mu.Unlock()
+		if err == nil {
+			err = perr
+		}
+	}
+	return
+}
@@ -0,0 +1,180 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bitbucket.org/jaybill/sawsij/framework/logging"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// writeTemplate writes a template file and moves its modification time on, so the change is seen even on file systems with
+// coarse timestamps.
+func writeTemplate(t *testing.T, filename string, content string, age int) {
+	err := WriteStringToFile(content, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mt := time.Now().Add(time.Duration(age) * time.Second)
+	if err = os.Chtimes(filename, mt, mt); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTemplateCacheReload(t *testing.T) {
+	basePath := standupApp(t, "templatecache", nil, map[string]string{"index.html": "first"})
+	defer os.RemoveAll(basePath)
+	filename := basePath + "/templates/index.html"
+
+	tc := newTemplateCache(basePath+"/templates", GetFuncMap(), logging.Default)
+	render := func(reload bool) (string, error) {
+		if reload {
+			tc.reload()
+		}
+		tmpl, name, err := tc.lookup("index.html", "")
+		var buf bytes.Buffer
+		if tmpl != nil {
+			tmpl.ExecuteTemplate(&buf, name, nil)
+		}
+		return buf.String(), err
+	}
+
+	writeTemplate(t, filename, "second", 1)
+	if out, err := render(false); out != "first" || err != nil {
+		t.Errorf("Expected cached template without reload, got %q %v", out, err)
+	}
+	if out, err := render(true); out != "second" || err != nil {
+		t.Errorf("Expected changed template to be reloaded, got %q %v", out, err)
+	}
+
+	writeTemplate(t, filename, "<% if %>broken", 2)
+	out, err := render(true)
+	if err == nil {
+		t.Error("Expected a parse error")
+	}
+	if out != "second" {
+		t.Errorf("Expected last good templates to be kept, got %q", out)
+	}
+	if _, err = render(false); err == nil {
+		t.Error("Expected the parse error to be kept")
+	}
+
+	writeTemplate(t, filename, "fixed", 3)
+	if out, err := render(true); out != "fixed" || err != nil {
+		t.Errorf("Expected fixed template, got %q %v", out, err)
+	}
+}
+
+func TestTemplateReloadConcurrently(t *testing.T) {
+	basePath := standupApp(t, "templaterace", nil, map[string]string{"index.html": "version 0", "error.html": "<% .status %>"})
+	defer os.RemoveAll(basePath)
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app.reloadTemplates = true
+	app.Route(RouteConfig{Pattern: "/", Roles: []int{R_GUEST}, Handler: testHandler})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				w := httptest.NewRecorder()
+				r, _ := http.NewRequest("GET", "http://localhost/", nil)
+				app.ServeHTTP(w, r)
+				if w.Code != http.StatusOK {
+					t.Errorf("Expected 200, got %v %q", w.Code, w.Body.String())
+					return
+				}
+			}
+		}()
+	}
+	for i := 1; i <= 10; i++ {
+		writeTemplate(t, basePath+"/templates/index.html", "version "+string(rune('0'+i%10)), i)
+	}
+	wg.Wait()
+}
+
+func TestLayoutsAndSubfolders(t *testing.T) {
+	basePath := standupApp(t, "layouts", nil, map[string]string{
+		"error.html":      "<% .status %>",
+		"messages.html":   "[messages]",
+		"posts-list.html": "<% template \"messages.html\" . %>legacy list",
+	})
+	defer os.RemoveAll(basePath)
+	for _, dir := range []string{"/templates/layouts", "/templates/admin/users", "/templates/admin/dashboard"} {
+		if err := os.MkdirAll(basePath+dir, os.FileMode(0777)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files := map[string]string{
+		"layouts/main.html":          `<main><% block "title" . %>Site<% end %>|<% template "messages.html" . %><% block "content" . %><% end %></main>`,
+		"layouts/admin.html":         `<admin><% block "content" . %><% end %></admin>`,
+		"admin/users/edit.html":      "<% layout \"layouts/admin.html\" %>\n<% define \"content\" %>editing <% .name %><% end %>",
+		"admin/users/list.html":      "<% layout \"layouts/main.html\" %>\n<% define \"title\" %>Users<% end %><% define \"content\" %>all users<% end %>",
+		"admin/dashboard/index.html": `<% define "content" %>dashboard<% end %>`,
+	}
+	for name, content := range files {
+		writeTemplate(t, basePath+"/templates/"+name, content, 0)
+	}
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	view := func(r *http.Request, a *AppScope, rs *RequestScope) (h HandlerResponse, err error) {
+		h.Init()
+		h.View["name"] = "bob"
+		h.View["other"] = true
+		return
+	}
+	app.Route(RouteConfig{Pattern: "/admin/users/edit", Roles: []int{R_GUEST}, Handler: view})
+	app.Route(RouteConfig{Pattern: "/admin/users/list", Roles: []int{R_GUEST}, Handler: view})
+	app.Route(RouteConfig{Pattern: "/admin/dashboard", Roles: []int{R_GUEST}, Handler: view, Layout: "layouts/admin.html"})
+	app.Route(RouteConfig{Pattern: "/posts/list", Roles: []int{R_GUEST}, Handler: view})
+	app.Route(RouteConfig{Pattern: "/missing", Roles: []int{R_GUEST}, Handler: view})
+
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{"/admin/users/edit", http.StatusOK, "<admin>editing bob</admin>"},
+		{"/admin/users/list", http.StatusOK, "<main>Users|[messages]all users</main>"},
+		{"/admin/dashboard", http.StatusOK, "<admin>dashboard</admin>"},
+		{"/posts/list", http.StatusOK, "[messages]legacy list"},
+		{"/missing", http.StatusInternalServerError, "500"},
+	}
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", "http://localhost"+test.path, nil)
+		app.ServeHTTP(w, r)
+		if w.Code != test.status || w.Body.String() != test.body {
+			t.Errorf("%v: expected %v %q, got %v %q", test.path, test.status, test.body, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestGetTemplatePath(t *testing.T) {
+	tests := map[string][2]string{
+		"/":                 {"index", "index"},
+		"/admin":            {"admin", "admin"},
+		"/admin/":           {"admin", "admin"},
+		"/admin/users/edit": {"admin-users-edit", "admin/users/edit"},
+	}
+	for pattern, expected := range tests {
+		if name, path := GetTemplateName(pattern), GetTemplatePath(pattern); name != expected[0] || path != expected[1] {
+			t.Errorf("%v: expected %v, got %v %v", pattern, expected, name, path)
+		}
+	}
+}
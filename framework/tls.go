@@ -0,0 +1,153 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"bitbucket.org/jaybill/sawsij/framework/logging"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// TLS versions that can be used for server.tls.minVersion in the config file.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// A certLoader holds the server certificate and can reload it from disk without restarting the server.
+type certLoader struct {
+	certFile string
+	keyFile  string
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+}
+
+// newCertLoader loads the certificate and key from the given files.
+func newCertLoader(certFile string, keyFile string) (cl *certLoader, err error) {
+	cl = &certLoader{certFile: certFile, keyFile: keyFile}
+	err = cl.reload()
+	return
+}
+
+// reload reads the certificate and key again. If they can't be read, the certificate that was loaded before is kept.
+func (cl *certLoader) reload() (err error) {
+	cert, err := tls.LoadX509KeyPair(cl.certFile, cl.keyFile)
+	if err != nil {
+		return
+	}
+	cl.mu.Lock()
+	cl.cert = &cert
+	cl.mu.Unlock()
+	return
+}
+
+// getCertificate is used as the GetCertificate function of the tls.Config, so every handshake gets the current certificate.
+func (cl *certLoader) getCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cl.mu.RLock()
+	defer cl.mu.RUnlock()
+	return cl.cert, nil
+}
+
+// tlsConfig builds a tls.Config from the server.tls section of the config file, which is described in the documentation for Run().
+// If server.tls.cert isn't set, the server uses plain HTTP and cfg will be nil.
+func (app *App) tlsConfig() (cfg *tls.Config, certs *certLoader, err error) {
+	certFile, err := configString(app.Config, "server.tls.cert", "")
+	if err != nil || certFile == "" {
+		return
+	}
+	keyFile, err := configString(app.Config, "server.tls.key", "")
+	if err != nil {
+		return
+	}
+	if keyFile == "" {
+		err = &SawsijError{"server.tls.key must be set when server.tls.cert is."}
+		return
+	}
+
+	certs, err = newCertLoader(app.appPath(certFile), app.appPath(keyFile))
+	if err != nil {
+		return
+	}
+
+	cfg = &tls.Config{GetCertificate: certs.getCertificate}
+
+	minVersion, err := configString(app.Config, "server.tls.minVersion", "1.2")
+	if err != nil {
+		return
+	}
+	version, ok := tlsVersions[strings.TrimSpace(minVersion)]
+	if !ok {
+		err = &SawsijError{fmt.Sprintf("server.tls.minVersion %q is not one of 1.0, 1.1, 1.2 or 1.3", minVersion)}
+		return
+	}
+	cfg.MinVersion = version
+
+	ciphers, err := configList(app.Config, "server.tls.ciphers")
+	if err != nil {
+		return
+	}
+	cfg.CipherSuites, err = parseCipherSuites(ciphers)
+	return
+}
+
+// parseCipherSuites turns cipher suite names into the ids used by crypto/tls.
+func parseCipherSuites(names []string) (ids []uint16, err error) {
+	known := make(map[string]uint16)
+	for _, cs := range tls.CipherSuites() {
+		known[cs.Name] = cs.ID
+	}
+	for _, cs := range tls.InsecureCipherSuites() {
+		known[cs.Name] = cs.ID
+	}
+
+	for _, name := range names {
+		id, ok := known[name]
+		if !ok {
+			err = &SawsijError{fmt.Sprintf("Unknown cipher suite %q in server.tls.ciphers", name)}
+			return
+		}
+		ids = append(ids, id)
+	}
+	return
+}
+
+// appPath returns path unchanged if it's absolute, otherwise relative to the application base path.
+func (app *App) appPath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(app.BasePath, path)
+}
+
+// redirectToHttps returns a handler that sends every request to the same URL on HTTPS. httpsAddr is the address the TLS server
+// listens on, which is used to work out the port. The default port of 443 is left out of the URL. The redirect is a 308, so forms
+// posted to the HTTP port are posted again to HTTPS rather than turned into GETs.
+func redirectToHttps(httpsAddr string) http.Handler {
+	_, port, err := net.SplitHostPort(httpsAddr)
+	if err != nil {
+		logging.Default.Warn("Can't find the HTTPS port", "listen", httpsAddr, "error", err)
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		host = strings.Trim(host, "[]")
+		if port != "" && port != "443" {
+			host = net.JoinHostPort(host, port)
+		} else if strings.Contains(host, ":") {
+			host = "[" + host + "]"
+		}
+		target := "https://" + host + r.URL.RequestURI()
+		http.Redirect(w, r, target, http.StatusPermanentRedirect)
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright 2012 J. William McCarthy. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package framework
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeSelfSignedCert creates a self-signed certificate for 127.0.0.1 and writes it and its key to the given files.
+func writeSelfSignedCert(t *testing.T, commonName string, certFile string, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTlsServeAndReload(t *testing.T) {
+	tlsConfig := []string{
+		"tls:",
+		"  cert: etc/server.crt",
+		"  key: etc/server.key",
+		"  minVersion: 1.2",
+		"  ciphers:",
+		"    - TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	}
+	basePath := standupApp(t, "tls", tlsConfig, map[string]string{"index.html": "secure"})
+	defer os.RemoveAll(basePath)
+	writeSelfSignedCert(t, "first", basePath+"/etc/server.crt", basePath+"/etc/server.key")
+
+	app, err := NewApp(&AppSetup{Roles: &map[string]int{}}, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app.Route(RouteConfig{Pattern: "/", Roles: []int{R_GUEST}, Handler: testHandler})
+
+	cfg, certs, err := app.tlsConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.MinVersion != tls.VersionTLS12 || len(cfg.CipherSuites) != 1 {
+		t.Fatalf("TLS settings from config were not used: %+v", cfg)
+	}
+
+	l, err := tls.Listen("tcp", "127.0.0.1:0", cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &http.Server{Handler: app}
+	go srv.Serve(l)
+	defer srv.Close()
+	url := "https://" + l.Addr().String() + "/"
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	get := func() (body string, commonName string) {
+		res, err := client.Get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		b, _ := ioutil.ReadAll(res.Body)
+		return string(b), res.TLS.PeerCertificates[0].Subject.CommonName
+	}
+
+	if body, cn := get(); body != "secure" || cn != "first" {
+		t.Fatalf("Expected first certificate and body, got %q %q", cn, body)
+	}
+
+	writeSelfSignedCert(t, "second", basePath+"/etc/server.crt", basePath+"/etc/server.key")
+	err = certs.reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Transport.(*http.Transport).CloseIdleConnections()
+	if _, cn := get(); cn != "second" {
+		t.Fatalf("Expected reloaded certificate, got %q", cn)
+	}
+
+	os.Remove(basePath + "/etc/server.key")
+	if err = certs.reload(); err == nil {
+		t.Fatal("Expected reload to fail without a key")
+	}
+	client.Transport.(*http.Transport).CloseIdleConnections()
+	if _, cn := get(); cn != "second" {
+		t.Fatalf("Expected old certificate to be kept, got %q", cn)
+	}
+}
+
+func TestRedirectToHttps(t *testing.T) {
+	tests := []struct {
+		listen   string
+		url      string
+		expected string
+	}{
+		{":443", "http://example.com/admin/users?page=2", "https://example.com/admin/users?page=2"},
+		{":8443", "http://example.com:8080/login", "https://example.com:8443/login"},
+		{"0.0.0.0:443", "http://[::1]:8080/", "https://[::1]/"},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("GET", test.url, nil)
+		redirectToHttps(test.listen).ServeHTTP(w, r)
+		if w.Code != http.StatusPermanentRedirect || w.Header().Get("Location") != test.expected {
+			t.Errorf("Expected redirect to %v, got %v %v", test.expected, w.Code, w.Header().Get("Location"))
+		}
+	}
+
+	// A form posted to the HTTP port keeps its method and body when it's redirected.
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "http://example.com/login", strings.NewReader("username=alice"))
+	redirectToHttps(":443").ServeHTTP(w, r)
+	if w.Code != http.StatusPermanentRedirect {
+		t.Errorf("Expected a 308 for a POST, got %v", w.Code)
+	}
+}
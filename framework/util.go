@@ -6,6 +6,7 @@ package framework
 
 import (
 	"archive/zip"
+	"bitbucket.org/jaybill/sawsij/framework/logging"
 	"bufio"
 	"code.google.com/p/go.crypto/bcrypt"
 	"crypto/md5"
@@ -13,7 +14,6 @@ import (
 	"html/template"
 	"io"
 	"io/ioutil"
-	"log"
 	"math"
 	"math/rand"
 	"net/http"
@@ -50,7 +50,7 @@ func GetIntId(strId string) (intId int64) {
 
 // GetUrlParams removes the string specified in "pattern" and returns key value pairs as a map of strings.
 func GetUrlParamsMap(pattern string, urlPath string) (urlParams map[string]string) {
-	log.Printf("Got URL path %v and Url Pattern %v", urlPath, pattern)
+	logging.Default.Debug("Getting URL params", "path", urlPath, "pattern", pattern)
 
 	restOfUrl := strings.Replace(urlPath, pattern, "", -1)
 
@@ -61,7 +61,6 @@ func GetUrlParamsMap(pattern string, urlPath string) (urlParams map[string]strin
 	urlParams = make(map[string](string))
 	if len(restOfUrl) > 0 && strings.Contains(restOfUrl, "/") {
 		allUrlParts := strings.Split(restOfUrl, "/")
-		log.Printf("Url part count: %v", len(allUrlParts))
 		if len(allUrlParts)%2 == 0 {
 			for i := 0; i < len(allUrlParts); i += 2 {
 				if i+1 < len(allUrlParts) {
@@ -69,7 +68,7 @@ func GetUrlParamsMap(pattern string, urlPath string) (urlParams map[string]strin
 				}
 			}
 		} else {
-			log.Printf("Uneven number of params: %+v", allUrlParts)
+			logging.Default.Warn("Uneven number of URL params", "path", urlPath, "parts", allUrlParts)
 		}
 
 	}
@@ -109,6 +108,19 @@ func CompareHashAndPassword(hash string, testPassword string, salt string) (vali
 
 // GetTemplateName takes a URL pattern and returns a template Id as a string.
 func GetTemplateName(pattern string) (templateId string) {
+	templateId = strings.Join(templateParts(pattern), "-")
+	return
+}
+
+// GetTemplatePath takes a URL pattern and returns the path of its template in the templates folder, without the extension.
+// So "/admin/users/edit" gives "admin/users/edit", for a template in [app_root]/templates/admin/users/edit.html.
+func GetTemplatePath(pattern string) (templatePath string) {
+	templatePath = strings.Join(templateParts(pattern), "/")
+	return
+}
+
+// templateParts splits a URL pattern into the parts that template names are made of.
+func templateParts(pattern string) (parts []string) {
 
 	patternParts := strings.Split(pattern, "/")
 	maxParts := len(patternParts)
@@ -117,18 +129,17 @@ func GetTemplateName(pattern string) (templateId string) {
 		maxParts = maxParts - 1
 	}
 
-	templateParts := make([]string, 0)
+	parts = make([]string, 0)
 	for i := 0; i < maxParts; i++ {
 		if i > 0 {
 			if patternParts[i] != "" {
-				templateParts = append(templateParts, patternParts[i])
+				parts = append(parts, patternParts[i])
 			} else {
-				templateParts = append(templateParts, "index")
+				parts = append(parts, "index")
 			}
 		}
 
 	}
-	templateId = strings.Join(templateParts, "-")
 
 	return
 }
@@ -4,13 +4,13 @@ func GetStaticResources() (r map[string]string) {
 
 	r = map[string]string{
 		"admin-dashboard.js":          "Z29vZ2xlLmxvYWQoInZpc3VhbGl6YXRpb24iLCAiMSIsIHtwYWNrYWdlczpbImNvcmVjaGFydCJdfSk7Cmdvb2dsZS5zZXRPbkxvYWRDYWxsYmFjayhkcmF3Q2hhcnRzKTsKCmZ1bmN0aW9uIGRyYXdDaGFydHMoKSB7Cgl2YXIgcGllZGF0YSA9IGdvb2dsZS52aXN1YWxpemF0aW9uLmFycmF5VG9EYXRhVGFibGUoWwoJICBbJ1BpZScsICdBbW91bnQnXSwKCSAgWydFYXRlbicsIDMwXSwKCSAgWydOb3QgRWF0ZW4nLCA4MF0sCSAgCgldKTsKCgl2YXIgb3B0aW9ucyA9IHsJICAKCSAgbGVnZW5kOiAnbm9uZScsCiAgICAgIHBpZVNsaWNlVGV4dDogJ2xhYmVsJywJCiAgICAgIGNoYXJ0QXJlYTp7d2lkdGg6IjEwMCUiLGhlaWdodDoiOTUlIn0gIAoJfTsKCgl2YXIgcGllY2hhcnQgPSBuZXcgZ29vZ2xlLnZpc3VhbGl6YXRpb24uUGllQ2hhcnQoZG9jdW1lbnQuZ2V0RWxlbWVudEJ5SWQoJ3BpZWNoYXJ0JykpOwoJcGllY2hhcnQuZHJhdyhwaWVkYXRhLCBvcHRpb25zKTsKfQ==",
-		"admin-delete.html.tpl":       "PCUgdGVtcGxhdGUgImFkbWluLWhlYWRlci5odG1sIiAuJT4KCgo8c3BhbiBjbGFzcz0icHVsbC1yaWdodCI+PGEgaHJlZj0iL2FkbWluL3t7LnR5cGVWYXJ9fSI+QmFjayB0byBsaXN0ICZyYXF1bzs8L2E+PC9zcGFuPgo8aDE+RGVsZXRlIHt7LnR5cGVWYXJ9fTwvaDE+Cgo8Zm9ybSBtZXRob2Q9IlBPU1QiIGFjdGlvbj0iL2FkbWluL3t7LnR5cGVWYXJ9fS9kZWxldGUvaWQvPCUgLnt7LnR5cGVWYXJ9fS5JZCAlPiI+Cgo8cD5Zb3UgYXJlIGFib3V0IHRvIGRlbGV0ZSB0aGlzIHt7LnR5cGVWYXJ9fTwvcD4KCjxwPkFyZSB5b3Ugc3VyZSB5b3Ugd2FudCB0byBkbyB0aGlzPzwvcD4KCjxkaXYgY2xhc3M9ImZvcm0tYWN0aW9ucyI+Cgk8YnV0dG9uIHR5cGU9InN1Ym1pdCIgY2xhc3M9ImJ0biBidG4tZGFuZ2VyIj5EZWxldGU8L2J1dHRvbj4KCTxhIGhyZWY9Ii9hZG1pbi97ey50eXBlVmFyfX0vZWRpdC9pZC88JSAue3sudHlwZVZhcn19LklkICU+IiBjbGFzcz0iYnRuIj5DYW5jZWw8L2E+CjwvZGl2Pgo8L2Zvcm0+Cgo8JSB0ZW1wbGF0ZSAiYWRtaW4tZm9vdGVyLmh0bWwiIC4lPg==",
-		"admin-edit.html.tpl":         "PCUgdGVtcGxhdGUgImFkbWluLWhlYWRlci5odG1sIiAuJT4KCjxzcGFuIGNsYXNzPSJwdWxsLXJpZ2h0Ij48YSBocmVmPSIvYWRtaW4ve3sudHlwZVZhcn19Ij5CYWNrIHRvIGxpc3QgJnJhcXVvOzwvYT48L3NwYW4+CjxoMT5NYW5hZ2Uge3sudHlwZVZhcn19czwvaDE+CjxoMz48JSBpZiAudXBkYXRlICU+RWRpdCB7ey50eXBlVmFyfX08JSBlbHNlICU+TmV3IHt7LnR5cGVWYXJ9fTwlIGVuZCAlPjwvaDM+CgoKPGRpdiBjbGFzcz0icm93Ij4KICA8ZGl2IGNsYXNzPSJjb2wtbWQtNiI+CiAgPGZvcm0gcm9sZT0iZm9ybSIgbWV0aG9kPSJQT1NUIiBhY3Rpb249Ii9hZG1pbi97ey50eXBlVmFyfX0vZWRpdDwlIGlmIC51cGRhdGUgJT4vaWQvPCUgLnt7LnR5cGVWYXJ9fS5JZCAlPjwlIGVuZCAlPiI+e3sgcmFuZ2UgJGZpZWxkIDo9IC5zdHJ1Y3QgfX17eyBpZiAkZmllbGQuSXNQayB9fQogICAgICA8JSBpZiAue3skLnR5cGVWYXJ9fS57eyRmaWVsZC5GTmFtZX19ICU+CiAgICAgIDxkaXYgY2xhc3M9ImZvcm0tZ3JvdXAiPjxsYWJlbCBjbGFzcz0iY29udHJvbC1sYWJlbCIgZm9yPSJ7eyRmaWVsZC5GTmFtZX19Ij57eyRmaWVsZC5GTmFtZX19PC9sYWJlbD4KICAgICAgPHAgY2xhc3M9ImZvcm0tY29udHJvbC1zdGF0aWMiPjwlIC57eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gJT48L3A+CiAgICAgIDwvZGl2PgogICAgICA8JSBlbmQgJT57e2Vsc2V9fXt7IGlmIGVxdWFsICRmaWVsZC5EaXNwbGF5VHlwZSAidGV4dCJ9fTxkaXYgY2xhc3M9ImZvcm0tZ3JvdXAiPjxsYWJlbCBjbGFzcz0iY29udHJvbC1sYWJlbCIgZm9yPSJ7eyRmaWVsZC5GTmFtZX19Ij57eyRmaWVsZC5GTmFtZX19PC9sYWJlbD48aW5wdXQgCiAgICAgICAgdHlwZT0idGV4dCIgCiAgICAgICAgcGxhY2Vob2xkZXI9Int7JGZpZWxkLkZOYW1lfX0iIAogICAgICAgIGNsYXNzPSJmb3JtLWNvbnRyb2wiIAogICAgICAgIGlkPSJ7eyRmaWVsZC5GTmFtZX19IiAKICAgICAgICBuYW1lPSJ7eyRmaWVsZC5GTmFtZX19IiAKICAgICAgICB2YWx1ZT0iPCUgaWYgLnt7JC50eXBlVmFyfX0ue3skZmllbGQuRk5hbWV9fSAlPjwlIC57eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gJT48JSBlbmQgJT4iPjwvZGl2Pnt7ZW5kfX0gCiAgICAgIHt7IGlmIGVxdWFsICRmaWVsZC5EaXNwbGF5VHlwZSAiY2hlY2tib3gifX08ZGl2IGNsYXNzPSJjaGVja2JveCI+PGxhYmVsPjxpbnB1dCAKICAgICAgICB0eXBlPSJjaGVja2JveCIgICAgICAgICAKICAgICAgICBpZD0ie3skZmllbGQuRk5hbWV9fSIgCiAgICAgICAgbmFtZT0ie3skZmllbGQuRk5hbWV9fSIgCiAgICAgICAgdmFsdWU9InRydWUiIDwlIGlmIGVxdWFsIC57eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gInRydWUiICU+IGNoZWNrZWQ8JSBlbmQgJT4+e3skZmllbGQuRk5hbWV9fTwvbGFiZWw+PC9kaXY+e3tlbmR9fSAgICAgICAgIAogICAgICB7eyBpZiBlcXVhbCAkZmllbGQuRGlzcGxheVR5cGUgIm51bWJlciJ9fTxkaXYgY2xhc3M9ImZvcm0tZ3JvdXAiPjxsYWJlbCBjbGFzcz0iY29udHJvbC1sYWJlbCIgZm9yPSJ7eyRmaWVsZC5GTmFtZX19Ij57eyRmaWVsZC5GTmFtZX19PC9sYWJlbD48aW5wdXQgCiAgICAgICAgdHlwZT0ibnVtYmVyIiAKICAgICAgICBwbGFjZWhvbGRlcj0ie3skZmllbGQuRk5hbWV9fSIgCiAgICAgICAgY2xhc3M9ImZvcm0tY29udHJvbCIgCiAgICAgICAgaWQ9Int7JGZpZWxkLkZOYW1lfX0iIAogICAgICAgIG5hbWU9Int7JGZpZWxkLkZOYW1lfX0iIAogICAgICAgIHZhbHVlPSI8JSBpZiAue3skLnR5cGVWYXJ9fS57eyRmaWVsZC5GTmFtZX19ICU+PCUgLnt7JC50eXBlVmFyfX0ue3skZmllbGQuRk5hbWV9fSAlPjwlIGVuZCAlPiI+PC9kaXY+e3tlbmR9fSAgICAgICAgICAgICAgICAKICAgICAge3sgaWYgZXF1YWwgJGZpZWxkLkRpc3BsYXlUeXBlICJkYXRlIn19PGRpdiBjbGFzcz0iZm9ybS1ncm91cCI+PGxhYmVsIGNsYXNzPSJjb250cm9sLWxhYmVsIiBmb3I9Int7JGZpZWxkLkZOYW1lfX0iPnt7JGZpZWxkLkZOYW1lfX08L2xhYmVsPjxpbnB1dCAKICAgICAgICB0eXBlPSJ0ZXh0IiAKICAgICAgICBwbGFjZWhvbGRlcj0ie3skZmllbGQuRk5hbWV9fSIgCiAgICAgICAgY2xhc3M9ImZvcm0tY29udHJvbCBkYXRlcGlja2VyIgogICAgICAgIGRhdGEtZGF0ZS1mb3JtYXQ9Im1tL2RkL3l5eXkiICAgICAgICAgCiAgICAgICAgaWQ9Int7JGZpZWxkLkZOYW1lfX0iIAogICAgICAgIG5hbWU9Int7JGZpZWxkLkZOYW1lfX0iIAogICAgICAgIHZhbHVlPSI8JSBpZiAue3skLnR5cGVWYXJ9fS57eyRmaWVsZC5GTmFtZX19ICU+PCUgZGF0ZWZvcm1hdCAgLnt7JC50eXBlVmFyfX0ue3skZmllbGQuRk5hbWV9fSAiMDEvMDIvMjAwNiIgJT48JSBlbmQgJT4iPjwvZGl2Pnt7ZW5kfX17e2VuZH19e3sgZW5kIH19CiAgICA8ZGl2IGNsYXNzPSJmb3JtLWdyb3VwIj4KICAgICAgPGJ1dHRvbiB0eXBlPSJzdWJtaXQiIGNsYXNzPSJidG4gYnRuLXByaW1hcnkiPlNhdmU8L2J1dHRvbj4KICAgICAgPCUgaWYgLnVwZGF0ZSAlPjxhIGhyZWY9Ii9hZG1pbi97ey50eXBlVmFyfX0vZGVsZXRlL2lkLzwlIC57ey50eXBlVmFyfX0uSWQgJT4iIHR5cGU9InN1Ym1pdCIgY2xhc3M9ImJ0biBidG4tZGFuZ2VyIj5EZWxldGU8L2E+PCUgZW5kICU+CiAgICAgICA8YSBjbGFzcz0iYnRuIGJ0bi1kZWZhdWx0IiBocmVmPSIvYWRtaW4ve3sudHlwZVZhcn19Ij5DYW5jZWw8L2E+ICAgICAgCiAgICA8L2Rpdj4KICAKPC9mb3JtPgoKICA8L2Rpdj4KPC9kaXY+ICAgIAo8JSB0ZW1wbGF0ZSAiYWRtaW4tZm9vdGVyLmh0bWwiIC4lPg==",
+		"admin-delete.html.tpl":       "PCUgbGF5b3V0ICJsYXlvdXRzL2FkbWluLmh0bWwiICU+CjwlIGRlZmluZSAiY29udGVudCIgJT4KCgo8c3BhbiBjbGFzcz0icHVsbC1yaWdodCI+PGEgaHJlZj0iL2FkbWluL3t7LnR5cGVWYXJ9fSI+QmFjayB0byBsaXN0ICZyYXF1bzs8L2E+PC9zcGFuPgo8aDE+RGVsZXRlIHt7LnR5cGVWYXJ9fTwvaDE+Cgo8Zm9ybSBtZXRob2Q9IlBPU1QiIGFjdGlvbj0iL2FkbWluL3t7LnR5cGVWYXJ9fS9kZWxldGUvaWQvPCUgLnt7LnR5cGVWYXJ9fS5JZCAlPiI+CjwlIGNzcmZGaWVsZCAuICU+Cgo8cD5Zb3UgYXJlIGFib3V0IHRvIGRlbGV0ZSB0aGlzIHt7LnR5cGVWYXJ9fTwvcD4KCjxwPkFyZSB5b3Ugc3VyZSB5b3Ugd2FudCB0byBkbyB0aGlzPzwvcD4KCjxkaXYgY2xhc3M9ImZvcm0tYWN0aW9ucyI+Cgk8YnV0dG9uIHR5cGU9InN1Ym1pdCIgY2xhc3M9ImJ0biBidG4tZGFuZ2VyIj5EZWxldGU8L2J1dHRvbj4KCTxhIGhyZWY9Ii9hZG1pbi97ey50eXBlVmFyfX0vZWRpdC9pZC88JSAue3sudHlwZVZhcn19LklkICU+IiBjbGFzcz0iYnRuIj5DYW5jZWw8L2E+CjwvZGl2Pgo8L2Zvcm0+Cgo8JSBlbmQgJT4=",
+		"admin-edit.html.tpl":         "PCUgbGF5b3V0ICJsYXlvdXRzL2FkbWluLmh0bWwiICU+CjwlIGRlZmluZSAiY29udGVudCIgJT4KCjxzcGFuIGNsYXNzPSJwdWxsLXJpZ2h0Ij48YSBocmVmPSIvYWRtaW4ve3sudHlwZVZhcn19Ij5CYWNrIHRvIGxpc3QgJnJhcXVvOzwvYT48L3NwYW4+CjxoMT5NYW5hZ2Uge3sudHlwZVZhcn19czwvaDE+CjxoMz48JSBpZiAudXBkYXRlICU+RWRpdCB7ey50eXBlVmFyfX08JSBlbHNlICU+TmV3IHt7LnR5cGVWYXJ9fTwlIGVuZCAlPjwvaDM+CgoKPGRpdiBjbGFzcz0icm93Ij4KICA8ZGl2IGNsYXNzPSJjb2wtbWQtNiI+CiAgPGZvcm0gcm9sZT0iZm9ybSIgbWV0aG9kPSJQT1NUIiBhY3Rpb249Ii9hZG1pbi97ey50eXBlVmFyfX0vZWRpdDwlIGlmIC51cGRhdGUgJT4vaWQvPCUgLnt7LnR5cGVWYXJ9fS5JZCAlPjwlIGVuZCAlPiI+PCUgY3NyZkZpZWxkIC4gJT57eyByYW5nZSAkZmllbGQgOj0gLnN0cnVjdCB9fXt7IGlmICRmaWVsZC5Jc1BrIH19CiAgICAgIDwlIGlmIC57eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gJT4KICAgICAgPGRpdiBjbGFzcz0iZm9ybS1ncm91cCI+PGxhYmVsIGNsYXNzPSJjb250cm9sLWxhYmVsIiBmb3I9Int7JGZpZWxkLkZOYW1lfX0iPnt7JGZpZWxkLkZOYW1lfX08L2xhYmVsPgogICAgICA8cCBjbGFzcz0iZm9ybS1jb250cm9sLXN0YXRpYyI+PCUgLnt7JC50eXBlVmFyfX0ue3skZmllbGQuRk5hbWV9fSAlPjwvcD4KICAgICAgPC9kaXY+CiAgICAgIDwlIGVuZCAlPnt7ZWxzZX19e3sgaWYgZXF1YWwgJGZpZWxkLkRpc3BsYXlUeXBlICJ0ZXh0In19PGRpdiBjbGFzcz0iZm9ybS1ncm91cCI+PGxhYmVsIGNsYXNzPSJjb250cm9sLWxhYmVsIiBmb3I9Int7JGZpZWxkLkZOYW1lfX0iPnt7JGZpZWxkLkZOYW1lfX08L2xhYmVsPjxpbnB1dCAKICAgICAgICB0eXBlPSJ0ZXh0IiAKICAgICAgICBwbGFjZWhvbGRlcj0ie3skZmllbGQuRk5hbWV9fSIgCiAgICAgICAgY2xhc3M9ImZvcm0tY29udHJvbCIgCiAgICAgICAgaWQ9Int7JGZpZWxkLkZOYW1lfX0iIAogICAgICAgIG5hbWU9Int7JGZpZWxkLkZOYW1lfX0iIAogICAgICAgIHZhbHVlPSI8JSBpZiAue3skLnR5cGVWYXJ9fS57eyRmaWVsZC5GTmFtZX19ICU+PCUgLnt7JC50eXBlVmFyfX0ue3skZmllbGQuRk5hbWV9fSAlPjwlIGVuZCAlPiI+PC9kaXY+e3tlbmR9fSAKICAgICAge3sgaWYgZXF1YWwgJGZpZWxkLkRpc3BsYXlUeXBlICJjaGVja2JveCJ9fTxkaXYgY2xhc3M9ImNoZWNrYm94Ij48bGFiZWw+PGlucHV0IAogICAgICAgIHR5cGU9ImNoZWNrYm94IiAgICAgICAgIAogICAgICAgIGlkPSJ7eyRmaWVsZC5GTmFtZX19IiAKICAgICAgICBuYW1lPSJ7eyRmaWVsZC5GTmFtZX19IiAKICAgICAgICB2YWx1ZT0idHJ1ZSIgPCUgaWYgZXF1YWwgLnt7JC50eXBlVmFyfX0ue3skZmllbGQuRk5hbWV9fSAidHJ1ZSIgJT4gY2hlY2tlZDwlIGVuZCAlPj57eyRmaWVsZC5GTmFtZX19PC9sYWJlbD48L2Rpdj57e2VuZH19ICAgICAgICAgCiAgICAgIHt7IGlmIGVxdWFsICRmaWVsZC5EaXNwbGF5VHlwZSAibnVtYmVyIn19PGRpdiBjbGFzcz0iZm9ybS1ncm91cCI+PGxhYmVsIGNsYXNzPSJjb250cm9sLWxhYmVsIiBmb3I9Int7JGZpZWxkLkZOYW1lfX0iPnt7JGZpZWxkLkZOYW1lfX08L2xhYmVsPjxpbnB1dCAKICAgICAgICB0eXBlPSJudW1iZXIiIAogICAgICAgIHBsYWNlaG9sZGVyPSJ7eyRmaWVsZC5GTmFtZX19IiAKICAgICAgICBjbGFzcz0iZm9ybS1jb250cm9sIiAKICAgICAgICBpZD0ie3skZmllbGQuRk5hbWV9fSIgCiAgICAgICAgbmFtZT0ie3skZmllbGQuRk5hbWV9fSIgCiAgICAgICAgdmFsdWU9IjwlIGlmIC57eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gJT48JSAue3skLnR5cGVWYXJ9fS57eyRmaWVsZC5GTmFtZX19ICU+PCUgZW5kICU+Ij48L2Rpdj57e2VuZH19ICAgICAgICAgICAgICAgIAogICAgICB7eyBpZiBlcXVhbCAkZmllbGQuRGlzcGxheVR5cGUgImRhdGUifX08ZGl2IGNsYXNzPSJmb3JtLWdyb3VwIj48bGFiZWwgY2xhc3M9ImNvbnRyb2wtbGFiZWwiIGZvcj0ie3skZmllbGQuRk5hbWV9fSI+e3skZmllbGQuRk5hbWV9fTwvbGFiZWw+PGlucHV0IAogICAgICAgIHR5cGU9InRleHQiIAogICAgICAgIHBsYWNlaG9sZGVyPSJ7eyRmaWVsZC5GTmFtZX19IiAKICAgICAgICBjbGFzcz0iZm9ybS1jb250cm9sIGRhdGVwaWNrZXIiCiAgICAgICAgZGF0YS1kYXRlLWZvcm1hdD0ibW0vZGQveXl5eSIgICAgICAgICAKICAgICAgICBpZD0ie3skZmllbGQuRk5hbWV9fSIgCiAgICAgICAgbmFtZT0ie3skZmllbGQuRk5hbWV9fSIgCiAgICAgICAgdmFsdWU9IjwlIGlmIC57eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gJT48JSBkYXRlZm9ybWF0ICAue3skLnR5cGVWYXJ9fS57eyRmaWVsZC5GTmFtZX19ICIwMS8wMi8yMDA2IiAlPjwlIGVuZCAlPiI+PC9kaXY+e3tlbmR9fXt7ZW5kfX17eyBlbmQgfX0KICAgIDxkaXYgY2xhc3M9ImZvcm0tZ3JvdXAiPgogICAgICA8YnV0dG9uIHR5cGU9InN1Ym1pdCIgY2xhc3M9ImJ0biBidG4tcHJpbWFyeSI+U2F2ZTwvYnV0dG9uPgogICAgICA8JSBpZiAudXBkYXRlICU+PGEgaHJlZj0iL2FkbWluL3t7LnR5cGVWYXJ9fS9kZWxldGUvaWQvPCUgLnt7LnR5cGVWYXJ9fS5JZCAlPiIgdHlwZT0ic3VibWl0IiBjbGFzcz0iYnRuIGJ0bi1kYW5nZXIiPkRlbGV0ZTwvYT48JSBlbmQgJT4KICAgICAgIDxhIGNsYXNzPSJidG4gYnRuLWRlZmF1bHQiIGhyZWY9Ii9hZG1pbi97ey50eXBlVmFyfX0iPkNhbmNlbDwvYT4gICAgICAKICAgIDwvZGl2PgogIAo8L2Zvcm0+CgogIDwvZGl2Pgo8L2Rpdj4gICAgCjwlIGVuZCAlPg==",
 		"admin.css":                   "LyogQWRtaW4gc3BlY2lmaWMgc3R5bGVzLiAqLwoKYm9keSB7IHBhZGRpbmctdG9wOiA3MHB4OyB9CgoudGFibGUtY2xpY2tyb3dzIHRkewoJY3Vyc29yOiBwb2ludGVyOyAKCWN1cnNvcjogaGFuZDsKfQoKI3BpZWNoYXJ0ewoJCgloZWlnaHQ6IDMwMHB4Owp9Cgo=",
-		"admin.html.tpl":              "PCUgdGVtcGxhdGUgImFkbWluLWhlYWRlci5odG1sIiAuJT4KCjxzcGFuIGNsYXNzPSJwdWxsLXJpZ2h0Ij48YSBjbGFzcz0iYnRuIGJ0bi1wcmltYXJ5IiBocmVmPSIvYWRtaW4ve3sudHlwZVZhcn19L2VkaXQiIHRpdGxlPSJBZGQgbmV3IHt7LnR5cGVWYXJ9fSI+PGkgY2xhc3M9Imljb24tcGx1cy1zaWduIGljb24td2hpdGUiPjwvaT4gQWRkIE5ldzwvYT48L3NwYW4+CjxoMT5NYW5hZ2Uge3sudHlwZVZhcn19czwvaDE+CgoKPCUgaWYgLnt7LnR5cGVWYXJ9fXMgJT4KPHRhYmxlIGNsYXNzPSJ0YWJsZSB0YWJsZS1ob3ZlciB0YWJsZS1jbGlja3Jvd3MiPgogIDx0aGVhZD4KICAgIDx0cj4Ke3sgcmFuZ2UgJGZpZWxkIDo9IC5zdHJ1Y3QgfX0gICAgICAgPHRoPnt7JGZpZWxkLkZOYW1lfX08L3RoPiAgICAgICAKe3sgZW5kIH19ICAgIDwvdHI+CiAgPC90aGVhZD4KICA8dGJvZHk+CiAgICA8JXJhbmdlICRpbmRleCwke3sudHlwZVZhcn19IDo9IC57ey50eXBlVmFyfX1zICU+CiAgICA8dHI+CiAgICB7eyByYW5nZSAkaSwgJGZpZWxkIDo9IC5zdHJ1Y3QgfX08dGQ+ICAgICAgCiAgICB7eyBpZiBlcSAkaSAwIH19PGEgaHJlZj0iL2FkbWluL3t7ICQudHlwZVZhciB9fS9lZGl0L2lkLzwlICR7eyAkLnR5cGVWYXJ9fS5JZCAlPiI+e3sgZW5kIH19CiAgICAgICAgICAgIDwlICR7eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gJT4KICAgIHt7IGlmIGVxICRpIDAgfX08L2E+e3sgZW5kIH19CiAgICAgIDwvdGQ+ICAgICAgIAogICAge3sgZW5kIH19CiAgICA8L3RyPiAgICAgIAogICAgPCVlbmQlPgogIDwvdGJvZHk+CjwvdGFibGU+CjwlIGVsc2UgJT4KPGRpdiBjbGFzcz0iYWxlcnQgYWxlcnQtaW5mbyI+CiAgICAgICAgICAgICAgPGJ1dHRvbiB0eXBlPSJidXR0b24iIGNsYXNzPSJjbG9zZSIgZGF0YS1kaXNtaXNzPSJhbGVydCI+w5c8L2J1dHRvbj4KICAgICAgICAgICAgICA8c3Ryb25nPk5vIHt7LnR5cGVWYXJ9fXMgZm91bmQuPC9zdHJvbmc+IElmIHlvdSdkIGxpa2UsIHlvdSBjYW4gPGEgaHJlZj0iL2FkbWluL3t7LnR5cGVWYXJ9fS9lZGl0Ij5jcmVhdGUgb25lPC9hPi4KICAgICAgICAgICAgPC9kaXY+CjwlIGVuZCAlPgo8JSB0ZW1wbGF0ZSAiYWRtaW4tZm9vdGVyLmh0bWwiIC4lPg==",
+		"admin.html.tpl":              "PCUgbGF5b3V0ICJsYXlvdXRzL2FkbWluLmh0bWwiICU+CjwlIGRlZmluZSAiY29udGVudCIgJT4KCjxzcGFuIGNsYXNzPSJwdWxsLXJpZ2h0Ij48YSBjbGFzcz0iYnRuIGJ0bi1wcmltYXJ5IiBocmVmPSIvYWRtaW4ve3sudHlwZVZhcn19L2VkaXQiIHRpdGxlPSJBZGQgbmV3IHt7LnR5cGVWYXJ9fSI+PGkgY2xhc3M9Imljb24tcGx1cy1zaWduIGljb24td2hpdGUiPjwvaT4gQWRkIE5ldzwvYT48L3NwYW4+CjxoMT5NYW5hZ2Uge3sudHlwZVZhcn19czwvaDE+CgoKPCUgaWYgLnt7LnR5cGVWYXJ9fXMgJT4KPHRhYmxlIGNsYXNzPSJ0YWJsZSB0YWJsZS1ob3ZlciB0YWJsZS1jbGlja3Jvd3MiPgogIDx0aGVhZD4KICAgIDx0cj4Ke3sgcmFuZ2UgJGZpZWxkIDo9IC5zdHJ1Y3QgfX0gICAgICAgPHRoPnt7JGZpZWxkLkZOYW1lfX08L3RoPiAgICAgICAKe3sgZW5kIH19ICAgIDwvdHI+CiAgPC90aGVhZD4KICA8dGJvZHk+CiAgICA8JXJhbmdlICRpbmRleCwke3sudHlwZVZhcn19IDo9IC57ey50eXBlVmFyfX1zICU+CiAgICA8dHI+CiAgICB7eyByYW5nZSAkaSwgJGZpZWxkIDo9IC5zdHJ1Y3QgfX08dGQ+ICAgICAgCiAgICB7eyBpZiBlcSAkaSAwIH19PGEgaHJlZj0iL2FkbWluL3t7ICQudHlwZVZhciB9fS9lZGl0L2lkLzwlICR7eyAkLnR5cGVWYXJ9fS5JZCAlPiI+e3sgZW5kIH19CiAgICAgICAgICAgIDwlICR7eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gJT4KICAgIHt7IGlmIGVxICRpIDAgfX08L2E+e3sgZW5kIH19CiAgICAgIDwvdGQ+ICAgICAgIAogICAge3sgZW5kIH19CiAgICA8L3RyPiAgICAgIAogICAgPCVlbmQlPgogIDwvdGJvZHk+CjwvdGFibGU+CjwlIGVsc2UgJT4KPGRpdiBjbGFzcz0iYWxlcnQgYWxlcnQtaW5mbyI+CiAgICAgICAgICAgICAgPGJ1dHRvbiB0eXBlPSJidXR0b24iIGNsYXNzPSJjbG9zZSIgZGF0YS1kaXNtaXNzPSJhbGVydCI+w5c8L2J1dHRvbj4KICAgICAgICAgICAgICA8c3Ryb25nPk5vIHt7LnR5cGVWYXJ9fXMgZm91bmQuPC9zdHJvbmc+IElmIHlvdSdkIGxpa2UsIHlvdSBjYW4gPGEgaHJlZj0iL2FkbWluL3t7LnR5cGVWYXJ9fS9lZGl0Ij5jcmVhdGUgb25lPC9hPi4KICAgICAgICAgICAgPC9kaXY+CjwlIGVuZCAlPgo8JSBlbmQgJT4=",
 		"bootstrap-datepicker.min.js": "LyogPT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09CiAqIGJvb3RzdHJhcC1kYXRlcGlja2VyLmpzCiAqIGh0dHA6Ly93d3cuZXllY29uLnJvL2Jvb3RzdHJhcC1kYXRlcGlja2VyCiAqID09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PQogKiBDb3B5cmlnaHQgMjAxMiBTdGVmYW4gUGV0cmUKICogSW1wcm92ZW1lbnRzIGJ5IEFuZHJldyBSb3dscwogKiBVcGRhdGVkIGZvciBCb290c3RyYXAgMy54IGJ5IElhbiBTZXJsaW4KICoKICogTGljZW5zZWQgdW5kZXIgdGhlIEFwYWNoZSBMaWNlbnNlLCBWZXJzaW9uIDIuMCAodGhlICJMaWNlbnNlIik7CiAqIHlvdSBtYXkgbm90IHVzZSB0aGlzIGZpbGUgZXhjZXB0IGluIGNvbXBsaWFuY2Ugd2l0aCB0aGUgTGljZW5zZS4KICogWW91IG1heSBvYnRhaW4gYSBjb3B5IG9mIHRoZSBMaWNlbnNlIGF0CiAqCiAqIGh0dHA6Ly93d3cuYXBhY2hlLm9yZy9saWNlbnNlcy9MSUNFTlNFLTIuMAogKgogKiBVbmxlc3MgcmVxdWlyZWQgYnkgYXBwbGljYWJsZSBsYXcgb3IgYWdyZWVkIHRvIGluIHdyaXRpbmcsIHNvZnR3YXJlCiAqIGRpc3RyaWJ1dGVkIHVuZGVyIHRoZSBMaWNlbnNlIGlzIGRpc3RyaWJ1dGVkIG9uIGFuICJBUyBJUyIgQkFTSVMsCiAqIFdJVEhPVVQgV0FSUkFOVElFUyBPUiBDT05ESVRJT05TIE9GIEFOWSBLSU5ELCBlaXRoZXIgZXhwcmVzcyBvciBpbXBsaWVkLgogKiBTZWUgdGhlIExpY2Vuc2UgZm9yIHRoZSBzcGVjaWZpYyBsYW5ndWFnZSBnb3Zlcm5pbmcgcGVybWlzc2lvbnMgYW5kCiAqIGxpbWl0YXRpb25zIHVuZGVyIHRoZSBMaWNlbnNlLgogKiA9PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT09PT0gKi8hZnVuY3Rpb24oZSl7ZnVuY3Rpb24gdCgpe3JldHVybiBuZXcgRGF0ZShEYXRlLlVUQy5hcHBseShEYXRlLGFyZ3VtZW50cykpfWZ1bmN0aW9uIG4oKXt2YXIgZT1uZXcgRGF0ZTtyZXR1cm4gdChlLmdldFVUQ0Z1bGxZZWFyKCksZS5nZXRVVENNb250aCgpLGUuZ2V0VVRDRGF0ZSgpKX12YXIgcj1mdW5jdGlvbih0LG4pe3ZhciByPXRoaXM7dGhpcy5lbGVtZW50PWUodCksdGhpcy5sYW5ndWFnZT1uLmxhbmd1YWdlfHx0aGlzLmVsZW1lbnQuZGF0YSgiZGF0ZS1sYW5ndWFnZSIpfHwiZW4iLHRoaXMubGFuZ3VhZ2U9dGhpcy5sYW5ndWFnZSBpbiBvP3RoaXMubGFuZ3VhZ2U6dGhpcy5sYW5ndWFnZS5zcGxpdCgiLSIpWzBdLHRoaXMubGFuZ3VhZ2U9dGhpcy5sYW5ndWFnZSBpbiBvP3RoaXMubGFuZ3VhZ2U6ImVuIix0aGlzLmlzUlRMPW9bdGhpcy5sYW5ndWFnZV0ucnRsfHwhMSx0aGlzLmZvcm1hdD11LnBhcnNlRm9ybWF0KG4uZm9ybWF0fHx0aGlzLmVsZW1lbnQuZGF0YSgiZGF0ZS1mb3JtYXQiKXx8b1t0aGlzLmxhbmd1YWdlXS5mb3JtYXR8fCJtbS9kZC95eXl5IiksdGhpcy5pc0lubGluZT0hMSx0aGlzLmlzSW5wdXQ9dGhpcy5lbGVtZW50LmlzKCJpbnB1dCIpLHRoaXMuY29tcG9uZW50PXRoaXMuZWxlbWVudC5pcygiLmRhdGUiKT90aGlzLmVsZW1lbnQuZmluZCgiLmlucHV0LWdyb3VwLWFkZG9uLCAuYnRuIik6ITEsdGhpcy5oYXNJbnB1dD10aGlzLmNvbXBvbmVudCYmdGhpcy5lbGVtZW50LmZpbmQoImlucHV0IikubGVuZ3RoLHRoaXMuY29tcG9uZW50JiZ0aGlzLmNvbXBvbmVudC5sZW5ndGg9PT0wJiYodGhpcy5jb21wb25lbnQ9ITEpLHRoaXMuZm9yY2VQYXJzZT0hMCwiZm9yY2VQYXJzZSJpbiBuP3RoaXMuZm9yY2VQYXJzZT1uLmZvcmNlUGFyc2U6ImRhdGVGb3JjZVBhcnNlImluIHRoaXMuZWxlbWVudC5kYXRhKCkmJih0aGlzLmZvcmNlUGFyc2U9dGhpcy5lbGVtZW50LmRhdGEoImRhdGUtZm9yY2UtcGFyc2UiKSksdGhpcy5waWNrZXI9ZSh1LnRlbXBsYXRlKSx0aGlzLl9idWlsZEV2ZW50cygpLHRoaXMuX2F0dGFjaEV2ZW50cygpLHRoaXMuaXNJbmxpbmU/dGhpcy5waWNrZXIuYWRkQ2xhc3MoImRhdGVwaWNrZXItaW5saW5lIikuYXBwZW5kVG8odGhpcy5lbGVtZW50KTp0aGlzLnBpY2tlci5hZGRDbGFzcygiZGF0ZXBpY2tlci1kcm9wZG93biBkcm9wZG93bi1tZW51IiksdGhpcy5pc1JUTCYmKHRoaXMucGlja2VyLmFkZENsYXNzKCJkYXRlcGlja2VyLXJ0bCIpLHRoaXMucGlja2VyLmZpbmQoIi5wcmV2IGksIC5uZXh0IGkiKS50b2dnbGVDbGFzcygiaWNvbi1hcnJvdy1sZWZ0IGljb24tYXJyb3ctcmlnaHQiKSksdGhpcy5hdXRvY2xvc2U9ITEsImF1dG9jbG9zZSJpbiBuP3RoaXMuYXV0b2Nsb3NlPW4uYXV0b2Nsb3NlOiJkYXRlQXV0b2Nsb3NlImluIHRoaXMuZWxlbWVudC5kYXRhKCkmJih0aGlzLmF1dG9jbG9zZT10aGlzLmVsZW1lbnQuZGF0YSgiZGF0ZS1hdXRvY2xvc2UiKSksdGhpcy5rZXlib2FyZE5hdmlnYXRpb249ITAsImtleWJvYXJkTmF2aWdhdGlvbiJpbiBuP3RoaXMua2V5Ym9hcmROYXZpZ2F0aW9uPW4ua2V5Ym9hcmROYXZpZ2F0aW9uOiJkYXRlS2V5Ym9hcmROYXZpZ2F0aW9uImluIHRoaXMuZWxlbWVudC5kYXRhKCkmJih0aGlzLmtleWJvYXJkTmF2aWdhdGlvbj10aGlzLmVsZW1lbnQuZGF0YSgiZGF0ZS1rZXlib2FyZC1uYXZpZ2F0aW9uIikpLHRoaXMudmlld01vZGU9dGhpcy5zdGFydFZpZXdNb2RlPTA7c3dpdGNoKG4uc3RhcnRWaWV3fHx0aGlzLmVsZW1lbnQuZGF0YSgiZGF0ZS1zdGFydC12aWV3Iikpe2Nhc2UgMjpjYXNlImRlY2FkZSI6dGhpcy52aWV3TW9kZT10aGlzLnN0YXJ0Vmlld01vZGU9MjticmVhaztjYXNlIDE6Y2FzZSJ5ZWFyIjp0aGlzLnZpZXdNb2RlPXRoaXMuc3RhcnRWaWV3TW9kZT0xfXRoaXMubWluVmlld01vZGU9bi5taW5WaWV3TW9kZXx8dGhpcy5lbGVtZW50LmRhdGEoImRhdGUtbWluLXZpZXctbW9kZSIpfHwwO2lmKHR5cGVvZiB0aGlzLm1pblZpZXdNb2RlPT0ic3RyaW5nIilzd2l0Y2godGhpcy5taW5WaWV3TW9kZSl7Y2FzZSJtb250aHMiOnRoaXMubWluVmlld01vZGU9MTticmVhaztjYXNlInllYXJzIjp0aGlzLm1pblZpZXdNb2RlPTI7YnJlYWs7ZGVmYXVsdDp0aGlzLm1pblZpZXdNb2RlPTB9dGhpcy52aWV3TW9kZT10aGlzLnN0YXJ0Vmlld01vZGU9TWF0aC5tYXgodGhpcy5zdGFydFZpZXdNb2RlLHRoaXMubWluVmlld01vZGUpLHRoaXMudG9kYXlCdG49bi50b2RheUJ0bnx8dGhpcy5lbGVtZW50LmRhdGEoImRhdGUtdG9kYXktYnRuIil8fCExLHRoaXMudG9kYXlIaWdobGlnaHQ9bi50b2RheUhpZ2hsaWdodHx8dGhpcy5lbGVtZW50LmRhdGEoImRhdGUtdG9kYXktaGlnaGxpZ2h0Iil8fCExLHRoaXMuY2FsZW5kYXJXZWVrcz0hMSwiY2FsZW5kYXJXZWVrcyJpbiBuP3RoaXMuY2FsZW5kYXJXZWVrcz1uLmNhbGVuZGFyV2Vla3M6ImRhdGVDYWxlbmRhcldlZWtzImluIHRoaXMuZWxlbWVudC5kYXRhKCkmJih0aGlzLmNhbGVuZGFyV2Vla3M9dGhpcy5lbGVtZW50LmRhdGEoImRhdGUtY2FsZW5kYXItd2Vla3MiKSksdGhpcy5jYWxlbmRhcldlZWtzJiZ0aGlzLnBpY2tlci5maW5kKCJ0Zm9vdCB0aC50b2RheSIpLmF0dHIoImNvbHNwYW4iLGZ1bmN0aW9uKGUsdCl7cmV0dXJuIHBhcnNlSW50KHQpKzF9KSx0aGlzLl9hbGxvd191cGRhdGU9ITEsdGhpcy53ZWVrU3RhcnQ9KG4ud2Vla1N0YXJ0fHx0aGlzLmVsZW1lbnQuZGF0YSgiZGF0ZS13ZWVrc3RhcnQiKXx8b1t0aGlzLmxhbmd1YWdlXS53ZWVrU3RhcnR8fDApJTcsdGhpcy53ZWVrRW5kPSh0aGlzLndlZWtTdGFydCs2KSU3LHRoaXMuc3RhcnREYXRlPS1JbmZpbml0eSx0aGlzLmVuZERhdGU9SW5maW5pdHksdGhpcy5kYXlzT2ZXZWVrRGlzYWJsZWQ9W10sdGhpcy5zZXRTdGFydERhdGUobi5zdGFydERhdGV8fHRoaXMuZWxlbWVudC5kYXRhKCJkYXRlLXN0YXJ0ZGF0ZSIpKSx0aGlzLnNldEVuZERhdGUobi5lbmREYXRlfHx0aGlzLmVsZW1lbnQuZGF0YSgiZGF0ZS1lbmRkYXRlIikpLHRoaXMuc2V0RGF5c09mV2Vla0Rpc2FibGVkKG4uZGF5c09mV2Vla0Rpc2FibGVkfHx0aGlzLmVsZW1lbnQuZGF0YSgiZGF0ZS1kYXlzLW9mLXdlZWstZGlzYWJsZWQiKSksdGhpcy5maWxsRG93KCksdGhpcy5maWxsTW9udGhzKCksdGhpcy5zZXRSYW5nZShuLnJhbmdlKSx0aGlzLl9hbGxvd191cGRhdGU9ITAsdGhpcy51cGRhdGUoKSx0aGlzLnNob3dNb2RlKCksdGhpcy5pc0lubGluZSYmdGhpcy5zaG93KCl9O3IucHJvdG90eXBlPXtjb25zdHJ1Y3RvcjpyLF9ldmVudHM6W10sX3NlY29uZGFyeUV2ZW50czpbXSxfYXBwbHlFdmVudHM6ZnVuY3Rpb24oZSl7Zm9yKHZhciB0PTAsbixyO3Q8ZS5sZW5ndGg7dCsrKW49ZVt0XVswXSxyPWVbdF1bMV0sbi5vbihyKX0sX3VuYXBwbHlFdmVudHM6ZnVuY3Rpb24oZSl7Zm9yKHZhciB0PTAsbixyO3Q8ZS5sZW5ndGg7dCsrKW49ZVt0XVswXSxyPWVbdF1bMV0sbi5vZmYocil9LF9idWlsZEV2ZW50czpmdW5jdGlvbigpe3RoaXMuaXNJbnB1dD90aGlzLl9ldmVudHM9W1t0aGlzLmVsZW1lbnQse2ZvY3VzOmUucHJveHkodGhpcy5zaG93LHRoaXMpLGtleXVwOmUucHJveHkodGhpcy51cGRhdGUsdGhpcyksa2V5ZG93bjplLnByb3h5KHRoaXMua2V5ZG93bix0aGlzKX1dXTp0aGlzLmNvbXBvbmVudCYmdGhpcy5oYXNJbnB1dD90aGlzLl9ldmVudHM9W1t0aGlzLmVsZW1lbnQuZmluZCgiaW5wdXQiKSx7Zm9jdXM6ZS5wcm94eSh0aGlzLnNob3csdGhpcyksa2V5dXA6ZS5wcm94eSh0aGlzLnVwZGF0ZSx0aGlzKSxrZXlkb3duOmUucHJveHkodGhpcy5rZXlkb3duLHRoaXMpfV0sW3RoaXMuY29tcG9uZW50LHtjbGljazplLnByb3h5KHRoaXMuc2hvdyx0aGlzKX1dXTp0aGlzLmVsZW1lbnQuaXMoImRpdiIpP3RoaXMuaXNJbmxpbmU9ITA6dGhpcy5fZXZlbnRzPVtbdGhpcy5lbGVtZW50LHtjbGljazplLnByb3h5KHRoaXMuc2hvdyx0aGlzKX1dXSx0aGlzLl9zZWNvbmRhcnlFdmVudHM9W1t0aGlzLnBpY2tlcix7Y2xpY2s6ZS5wcm94eSh0aGlzLmNsaWNrLHRoaXMpfV0sW2Uod2luZG93KSx7cmVzaXplOmUucHJveHkodGhpcy5wbGFjZSx0aGlzKX1dLFtlKGRvY3VtZW50KSx7bW91c2Vkb3duOmUucHJveHkoZnVuY3Rpb24odCl7ZSh0LnRhcmdldCkuY2xvc2VzdCgiLmRhdGVwaWNrZXIuZGF0ZXBpY2tlci1pbmxpbmUsIC5kYXRlcGlja2VyLmRhdGVwaWNrZXItZHJvcGRvd24iKS5sZW5ndGg9PT0wJiZ0aGlzLmhpZGUoKX0sdGhpcyl9XV19LF9hdHRhY2hFdmVudHM6ZnVuY3Rpb24oKXt0aGlzLl9kZXRhY2hFdmVudHMoKSx0aGlzLl9hcHBseUV2ZW50cyh0aGlzLl9ldmVudHMpfSxfZGV0YWNoRXZlbnRzOmZ1bmN0aW9uKCl7dGhpcy5fdW5hcHBseUV2ZW50cyh0aGlzLl9ldmVudHMpfSxfYXR0YWNoU2Vjb25kYXJ5RXZlbnRzOmZ1bmN0aW9uKCl7dGhpcy5fZGV0YWNoU2Vjb25kYXJ5RXZlbnRzKCksdGhpcy5fYXBwbHlFdmVudHModGhpcy5fc2Vjb25kYXJ5RXZlbnRzKX0sX2RldGFjaFNlY29uZGFyeUV2ZW50czpmdW5jdGlvbigpe3RoaXMuX3VuYXBwbHlFdmVudHModGhpcy5fc2Vjb25kYXJ5RXZlbnRzKX0sc2hvdzpmdW5jdGlvbihlKXt0aGlzLmlzSW5saW5lfHx0aGlzLnBpY2tlci5hcHBlbmRUbygiYm9keSIpLHRoaXMucGlja2VyLnNob3coKSx0aGlzLmhlaWdodD10aGlzLmNvbXBvbmVudD90aGlzLmNvbXBvbmVudC5vdXRlckhlaWdodCgpOnRoaXMuZWxlbWVudC5vdXRlckhlaWdodCgpLHRoaXMucGxhY2UoKSx0aGlzLl9hdHRhY2hTZWNvbmRhcnlFdmVudHMoKSxlJiZlLnByZXZlbnREZWZhdWx0KCksdGhpcy5lbGVtZW50LnRyaWdnZXIoe3R5cGU6InNob3ciLGRhdGU6dGhpcy5kYXRlfSl9LGhpZGU6ZnVuY3Rpb24oZSl7aWYodGhpcy5pc0lubGluZSlyZXR1cm47aWYoIXRoaXMucGlja2VyLmlzKCI6dmlzaWJsZSIpKXJldHVybjt0aGlzLnBpY2tlci5oaWRlKCkuZGV0YWNoKCksdGhpcy5fZGV0YWNoU2Vjb25kYXJ5RXZlbnRzKCksdGhpcy52aWV3TW9kZT10aGlzLnN0YXJ0Vmlld01vZGUsdGhpcy5zaG93TW9kZSgpLHRoaXMuZm9yY2VQYXJzZSYmKHRoaXMuaXNJbnB1dCYmdGhpcy5lbGVtZW50LnZhbCgpfHx0aGlzLmhhc0lucHV0JiZ0aGlzLmVsZW1lbnQuZmluZCgiaW5wdXQiKS52YWwoKSkmJnRoaXMuc2V0VmFsdWUoKSx0aGlzLmVsZW1lbnQudHJpZ2dlcih7dHlwZToiaGlkZSIsZGF0ZTp0aGlzLmRhdGV9KX0scmVtb3ZlOmZ1bmN0aW9uKCl7dGhpcy5oaWRlKCksdGhpcy5fZGV0YWNoRXZlbnRzKCksdGhpcy5fZGV0YWNoU2Vjb25kYXJ5RXZlbnRzKCksdGhpcy5waWNrZXIucmVtb3ZlKCksZGVsZXRlIHRoaXMuZWxlbWVudC5kYXRhKCkuZGF0ZXBpY2tlcix0aGlzLmlzSW5wdXR8fGRlbGV0ZSB0aGlzLmVsZW1lbnQuZGF0YSgpLmRhdGV9LGdldERhdGU6ZnVuY3Rpb24oKXt2YXIgZT10aGlzLmdldFVUQ0RhdGUoKTtyZXR1cm4gbmV3IERhdGUoZS5nZXRUaW1lKCkrZS5nZXRUaW1lem9uZU9mZnNldCgpKjZlNCl9LGdldFVUQ0RhdGU6ZnVuY3Rpb24oKXtyZXR1cm4gdGhpcy5kYXRlfSxzZXREYXRlOmZ1bmN0aW9uKGUpe3RoaXMuc2V0VVRDRGF0ZShuZXcgRGF0ZShlLmdldFRpbWUoKS1lLmdldFRpbWV6b25lT2Zmc2V0KCkqNmU0KSl9LHNldFVUQ0RhdGU6ZnVuY3Rpb24oZSl7dGhpcy5kYXRlPWUsdGhpcy5zZXRWYWx1ZSgpfSxzZXRWYWx1ZTpmdW5jdGlvbigpe3ZhciBlPXRoaXMuZ2V0Rm9ybWF0dGVkRGF0ZSgpO3RoaXMuaXNJbnB1dD90aGlzLmVsZW1lbnQudmFsKGUpOnRoaXMuY29tcG9uZW50JiZ0aGlzLmVsZW1lbnQuZmluZCgiaW5wdXQiKS52YWwoZSl9LGdldEZvcm1hdHRlZERhdGU6ZnVuY3Rpb24oZSl7cmV0dXJuIGU9PT11bmRlZmluZWQmJihlPXRoaXMuZm9ybWF0KSx1LmZvcm1hdERhdGUodGhpcy5kYXRlLGUsdGhpcy5sYW5ndWFnZSl9LHNldFN0YXJ0RGF0ZTpmdW5jdGlvbihlKXt0aGlzLnN0YXJ0RGF0ZT1lfHwtSW5maW5pdHksdGhpcy5zdGFydERhdGUhPT0tSW5maW5pdHkmJih0aGlzLnN0YXJ0RGF0ZT11LnBhcnNlRGF0ZSh0aGlzLnN0YXJ0RGF0ZSx0aGlzLmZvcm1hdCx0aGlzLmxhbmd1YWdlKSksdGhpcy51cGRhdGUoKSx0aGlzLnVwZGF0ZU5hdkFycm93cygpfSxzZXRFbmREYXRlOmZ1bmN0aW9uKGUpe3RoaXMuZW5kRGF0ZT1lfHxJbmZpbml0eSx0aGlzLmVuZERhdGUhPT1JbmZpbml0eSYmKHRoaXMuZW5kRGF0ZT11LnBhcnNlRGF0ZSh0aGlzLmVuZERhdGUsdGhpcy5mb3JtYXQsdGhpcy5sYW5ndWFnZSkpLHRoaXMudXBkYXRlKCksdGhpcy51cGRhdGVOYXZBcnJvd3MoKX0sc2V0RGF5c09mV2Vla0Rpc2FibGVkOmZ1bmN0aW9uKHQpe3RoaXMuZGF5c09mV2Vla0Rpc2FibGVkPXR8fFtdLGUuaXNBcnJheSh0aGlzLmRheXNPZldlZWtEaXNhYmxlZCl8fCh0aGlzLmRheXNPZldlZWtEaXNhYmxlZD10aGlzLmRheXNPZldlZWtEaXNhYmxlZC5zcGxpdCgvLFxzKi8pKSx0aGlzLmRheXNPZldlZWtEaXNhYmxlZD1lLm1hcCh0aGlzLmRheXNPZldlZWtEaXNhYmxlZCxmdW5jdGlvbihlKXtyZXR1cm4gcGFyc2VJbnQoZSwxMCl9KSx0aGlzLnVwZGF0ZSgpLHRoaXMudXBkYXRlTmF2QXJyb3dzKCl9LHBsYWNlOmZ1bmN0aW9uKCl7aWYodGhpcy5pc0lubGluZSlyZXR1cm47dmFyIHQ9cGFyc2VJbnQodGhpcy5lbGVtZW50LnBhcmVudHMoKS5maWx0ZXIoZnVuY3Rpb24oKXtyZXR1cm4gZSh0aGlzKS5jc3MoInotaW5kZXgiKSE9ImF1dG8ifSkuZmlyc3QoKS5jc3MoInotaW5kZXgiKSkrMTAsbj10aGlzLmNvbXBvbmVudD90aGlzLmNvbXBvbmVudC5wYXJlbnQoKS5vZmZzZXQoKTp0aGlzLmVsZW1lbnQub2Zmc2V0KCkscj10aGlzLmNvbXBvbmVudD90aGlzLmNvbXBvbmVudC5vdXRlckhlaWdodCghMCk6dGhpcy5lbGVtZW50Lm91dGVySGVpZ2h0KCEwKTt0aGlzLnBpY2tlci5jc3Moe3RvcDpuLnRvcCtyLGxlZnQ6bi5sZWZ0LHpJbmRleDp0fSl9LF9hbGxvd191cGRhdGU6ITAsdXBkYXRlOmZ1bmN0aW9uKCl7aWYoIXRoaXMuX2FsbG93X3VwZGF0ZSlyZXR1cm47dmFyIGUsdD0hMTthcmd1bWVudHMmJmFyZ3VtZW50cy5sZW5ndGgmJih0eXBlb2YgYXJndW1lbnRzWzBdPT0ic3RyaW5nInx8YXJndW1lbnRzWzBdaW5zdGFuY2VvZiBEYXRlKT8oZT1hcmd1bWVudHNbMF0sdD0hMCk6KGU9dGhpcy5pc0lucHV0P3RoaXMuZWxlbWVudC52YWwoKTp0aGlzLmVsZW1lbnQuZGF0YSgiZGF0ZSIpfHx0aGlzLmVsZW1lbnQuZmluZCgiaW5wdXQiKS52YWwoKSxkZWxldGUgdGhpcy5lbGVtZW50LmRhdGEoKS5kYXRlKSx0aGlzLmRhdGU9dS5wYXJzZURhdGUoZSx0aGlzLmZvcm1hdCx0aGlzLmxhbmd1YWdlKSx0JiZ0aGlzLnNldFZhbHVlKCksdGhpcy5kYXRlPHRoaXMuc3RhcnREYXRlP3RoaXMudmlld0RhdGU9bmV3IERhdGUodGhpcy5zdGFydERhdGUpOnRoaXMuZGF0ZT50aGlzLmVuZERhdGU/dGhpcy52aWV3RGF0ZT1uZXcgRGF0ZSh0aGlzLmVuZERhdGUpOnRoaXMudmlld0RhdGU9bmV3IERhdGUodGhpcy5kYXRlKSx0aGlzLmZpbGwoKX0sZmlsbERvdzpmdW5jdGlvbigpe3ZhciBlPXRoaXMud2Vla1N0YXJ0LHQ9Ijx0cj4iO2lmKHRoaXMuY2FsZW5kYXJXZWVrcyl7dmFyIG49Jzx0aCBjbGFzcz0iY3ciPiZuYnNwOzwvdGg+Jzt0Kz1uLHRoaXMucGlja2VyLmZpbmQoIi5kYXRlcGlja2VyLWRheXMgdGhlYWQgdHI6Zmlyc3QtY2hpbGQiKS5wcmVwZW5kKG4pfXdoaWxlKGU8dGhpcy53ZWVrU3RhcnQrNyl0Kz0nPHRoIGNsYXNzPSJkb3ciPicrb1t0aGlzLmxhbmd1YWdlXS5kYXlzTWluW2UrKyU3XSsiPC90aD4iO3QrPSI8L3RyPiIsdGhpcy5waWNrZXIuZmluZCgiLmRhdGVwaWNrZXItZGF5cyB0aGVhZCIpLmFwcGVuZCh0KX0sZmlsbE1vbnRoczpmdW5jdGlvbigpe3ZhciBlPSIiLHQ9MDt3aGlsZSh0PDEyKWUrPSc8c3BhbiBjbGFzcz0ibW9udGgiPicrb1t0aGlzLmxhbmd1YWdlXS5tb250aHNTaG9ydFt0KytdKyI8L3NwYW4+Ijt0aGlzLnBpY2tlci5maW5kKCIuZGF0ZXBpY2tlci1tb250aHMgdGQiKS5odG1sKGUpfSxzZXRSYW5nZTpmdW5jdGlvbih0KXshdHx8IXQubGVuZ3RoP2RlbGV0ZSB0aGlzLnJhbmdlOnRoaXMucmFuZ2U9ZS5tYXAodCxmdW5jdGlvbihlKXtyZXR1cm4gZS52YWx1ZU9mKCl9KSx0aGlzLmZpbGwoKX0sZ2V0Q2xhc3NOYW1lczpmdW5jdGlvbih0KXt2YXIgbj1bXSxyPXRoaXMudmlld0RhdGUuZ2V0VVRDRnVsbFllYXIoKSxpPXRoaXMudmlld0RhdGUuZ2V0VVRDTW9udGgoKSxzPXRoaXMuZGF0ZS52YWx1ZU9mKCksbz1uZXcgRGF0ZTtyZXR1cm4gdC5nZXRVVENGdWxsWWVhcigpPHJ8fHQuZ2V0VVRDRnVsbFllYXIoKT09ciYmdC5nZXRVVENNb250aCgpPGk/bi5wdXNoKCJvbGQiKToodC5nZXRVVENGdWxsWWVhcigpPnJ8fHQuZ2V0VVRDRnVsbFllYXIoKT09ciYmdC5nZXRVVENNb250aCgpPmkpJiZuLnB1c2goIm5ldyIpLHRoaXMudG9kYXlIaWdobGlnaHQmJnQuZ2V0VVRDRnVsbFllYXIoKT09by5nZXRGdWxsWWVhcigpJiZ0LmdldFVUQ01vbnRoKCk9PW8uZ2V0TW9udGgoKSYmdC5nZXRVVENEYXRlKCk9PW8uZ2V0RGF0ZSgpJiZuLnB1c2goInRvZGF5IikscyYmdC52YWx1ZU9mKCk9PXMmJm4ucHVzaCgiYWN0aXZlIiksKHQudmFsdWVPZigpPHRoaXMuc3RhcnREYXRlfHx0LnZhbHVlT2YoKT50aGlzLmVuZERhdGV8fGUuaW5BcnJheSh0LmdldFVUQ0RheSgpLHRoaXMuZGF5c09mV2Vla0Rpc2FibGVkKSE9PS0xKSYmbi5wdXNoKCJkaXNhYmxlZCIpLHRoaXMucmFuZ2UmJih0PnRoaXMucmFuZ2VbMF0mJnQ8dGhpcy5yYW5nZVt0aGlzLnJhbmdlLmxlbmd0aC0xXSYmbi5wdXNoKCJyYW5nZSIpLGUuaW5BcnJheSh0LnZhbHVlT2YoKSx0aGlzLnJhbmdlKSE9LTEmJm4ucHVzaCgic2VsZWN0ZWQiKSksbn0sZmlsbDpmdW5jdGlvbigpe3ZhciBlPW5ldyBEYXRlKHRoaXMudmlld0RhdGUpLG49ZS5nZXRVVENGdWxsWWVhcigpLHI9ZS5nZXRVVENNb250aCgpLGk9dGhpcy5zdGFydERhdGUhPT0tSW5maW5pdHk/dGhpcy5zdGFydERhdGUuZ2V0VVRDRnVsbFllYXIoKTotSW5maW5pdHkscz10aGlzLnN0YXJ0RGF0ZSE9PS1JbmZpbml0eT90aGlzLnN0YXJ0RGF0ZS5nZXRVVENNb250aCgpOi1JbmZpbml0eSxhPXRoaXMuZW5kRGF0ZSE9PUluZmluaXR5P3RoaXMuZW5kRGF0ZS5nZXRVVENGdWxsWWVhcigpOkluZmluaXR5LGY9dGhpcy5lbmREYXRlIT09SW5maW5pdHk/dGhpcy5lbmREYXRlLmdldFVUQ01vbnRoKCk6SW5maW5pdHksbD10aGlzLmRhdGUmJnRoaXMuZGF0ZS52YWx1ZU9mKCk7dGhpcy5waWNrZXIuZmluZCgiLmRhdGVwaWNrZXItZGF5cyB0aGVhZCB0aC5kYXRlcGlja2VyLXN3aXRjaCIpLnRleHQob1t0aGlzLmxhbmd1YWdlXS5tb250aHNbcl0rIiAiK24pLHRoaXMucGlja2VyLmZpbmQoInRmb290IHRoLnRvZGF5IikudGV4dChvW3RoaXMubGFuZ3VhZ2VdLnRvZGF5KS50b2dnbGUodGhpcy50b2RheUJ0biE9PSExKSx0aGlzLnVwZGF0ZU5hdkFycm93cygpLHRoaXMuZmlsbE1vbnRocygpO3ZhciBjPXQobixyLTEsMjgsMCwwLDAsMCksaD11LmdldERheXNJbk1vbnRoKGMuZ2V0VVRDRnVsbFllYXIoKSxjLmdldFVUQ01vbnRoKCkpO2Muc2V0VVRDRGF0ZShoKSxjLnNldFVUQ0RhdGUoaC0oYy5nZXRVVENEYXkoKS10aGlzLndlZWtTdGFydCs3KSU3KTt2YXIgcD1uZXcgRGF0ZShjKTtwLnNldFVUQ0RhdGUocC5nZXRVVENEYXRlKCkrNDIpLHA9cC52YWx1ZU9mKCk7dmFyIGQ9W10sdjt3aGlsZShjLnZhbHVlT2YoKTxwKXtpZihjLmdldFVUQ0RheSgpPT10aGlzLndlZWtTdGFydCl7ZC5wdXNoKCI8dHI+Iik7aWYodGhpcy5jYWxlbmRhcldlZWtzKXt2YXIgbT1uZXcgRGF0ZSgrYysodGhpcy53ZWVrU3RhcnQtYy5nZXRVVENEYXkoKS03KSU3Kjg2NGU1KSxnPW5ldyBEYXRlKCttKygxMS1tLmdldFVUQ0RheSgpKSU3Kjg2NGU1KSx5PW5ldyBEYXRlKCsoeT10KGcuZ2V0VVRDRnVsbFllYXIoKSwwLDEpKSsoMTEteS5nZXRVVENEYXkoKSklNyo4NjRlNSksYj0oZy15KS84NjRlNS83KzE7ZC5wdXNoKCc8dGQgY2xhc3M9ImN3Ij4nK2IrIjwvdGQ+Iil9fXY9dGhpcy5nZXRDbGFzc05hbWVzKGMpLHYucHVzaCgiZGF5IiksZC5wdXNoKCc8dGQgY2xhc3M9Iicrdi5qb2luKCIgIikrJyI+JytjLmdldFVUQ0RhdGUoKSsiPC90ZD4iKSxjLmdldFVUQ0RheSgpPT10aGlzLndlZWtFbmQmJmQucHVzaCgiPC90cj4iKSxjLnNldFVUQ0RhdGUoYy5nZXRVVENEYXRlKCkrMSl9dGhpcy5waWNrZXIuZmluZCgiLmRhdGVwaWNrZXItZGF5cyB0Ym9keSIpLmVtcHR5KCkuYXBwZW5kKGQuam9pbigiIikpO3ZhciB3PXRoaXMuZGF0ZSYmdGhpcy5kYXRlLmdldFVUQ0Z1bGxZZWFyKCksRT10aGlzLnBpY2tlci5maW5kKCIuZGF0ZXBpY2tlci1tb250aHMiKS5maW5kKCJ0aDplcSgxKSIpLnRleHQobikuZW5kKCkuZmluZCgic3BhbiIpLnJlbW92ZUNsYXNzKCJhY3RpdmUiKTt3JiZ3PT1uJiZFLmVxKHRoaXMuZGF0ZS5nZXRVVENNb250aCgpKS5hZGRDbGFzcygiYWN0aXZlIiksKG48aXx8bj5hKSYmRS5hZGRDbGFzcygiZGlzYWJsZWQiKSxuPT1pJiZFLnNsaWNlKDAscykuYWRkQ2xhc3MoImRpc2FibGVkIiksbj09YSYmRS5zbGljZShmKzEpLmFkZENsYXNzKCJkaXNhYmxlZCIpLGQ9IiIsbj1wYXJzZUludChuLzEwLDEwKSoxMDt2YXIgUz10aGlzLnBpY2tlci5maW5kKCIuZGF0ZXBpY2tlci15ZWFycyIpLmZpbmQoInRoOmVxKDEpIikudGV4dChuKyItIisobis5KSkuZW5kKCkuZmluZCgidGQiKTtuLT0xO2Zvcih2YXIgeD0tMTt4PDExO3grKylkKz0nPHNwYW4gY2xhc3M9InllYXInKyh4PT0tMXx8eD09MTA/IiBvbGQiOiIiKSsodz09bj8iIGFjdGl2ZSI6IiIpKyhuPGl8fG4+YT8iIGRpc2FibGVkIjoiIikrJyI+JytuKyI8L3NwYW4+IixuKz0xO1MuaHRtbChkKX0sdXBkYXRlTmF2QXJyb3dzOmZ1bmN0aW9uKCl7aWYoIXRoaXMuX2FsbG93X3VwZGF0ZSlyZXR1cm47dmFyIGU9bmV3IERhdGUodGhpcy52aWV3RGF0ZSksdD1lLmdldFVUQ0Z1bGxZZWFyKCksbj1lLmdldFVUQ01vbnRoKCk7c3dpdGNoKHRoaXMudmlld01vZGUpe2Nhc2UgMDp0aGlzLnN0YXJ0RGF0ZSE9PS1JbmZpbml0eSYmdDw9dGhpcy5zdGFydERhdGUuZ2V0VVRDRnVsbFllYXIoKSYmbjw9dGhpcy5zdGFydERhdGUuZ2V0VVRDTW9udGgoKT90aGlzLnBpY2tlci5maW5kKCIucHJldiIpLmNzcyh7dmlzaWJpbGl0eToiaGlkZGVuIn0pOnRoaXMucGlja2VyLmZpbmQoIi5wcmV2IikuY3NzKHt2aXNpYmlsaXR5OiJ2aXNpYmxlIn0pLHRoaXMuZW5kRGF0ZSE9PUluZmluaXR5JiZ0Pj10aGlzLmVuZERhdGUuZ2V0VVRDRnVsbFllYXIoKSYmbj49dGhpcy5lbmREYXRlLmdldFVUQ01vbnRoKCk/dGhpcy5waWNrZXIuZmluZCgiLm5leHQiKS5jc3Moe3Zpc2liaWxpdHk6ImhpZGRlbiJ9KTp0aGlzLnBpY2tlci5maW5kKCIubmV4dCIpLmNzcyh7dmlzaWJpbGl0eToidmlzaWJsZSJ9KTticmVhaztjYXNlIDE6Y2FzZSAyOnRoaXMuc3RhcnREYXRlIT09LUluZmluaXR5JiZ0PD10aGlzLnN0YXJ0RGF0ZS5nZXRVVENGdWxsWWVhcigpP3RoaXMucGlja2VyLmZpbmQoIi5wcmV2IikuY3NzKHt2aXNpYmlsaXR5OiJoaWRkZW4ifSk6dGhpcy5waWNrZXIuZmluZCgiLnByZXYiKS5jc3Moe3Zpc2liaWxpdHk6InZpc2libGUifSksdGhpcy5lbmREYXRlIT09SW5maW5pdHkmJnQ+PXRoaXMuZW5kRGF0ZS5nZXRVVENGdWxsWWVhcigpP3RoaXMucGlja2VyLmZpbmQoIi5uZXh0IikuY3NzKHt2aXNpYmlsaXR5OiJoaWRkZW4ifSk6dGhpcy5waWNrZXIuZmluZCgiLm5leHQiKS5jc3Moe3Zpc2liaWxpdHk6InZpc2libGUifSl9fSxjbGljazpmdW5jdGlvbihuKXtuLnByZXZlbnREZWZhdWx0KCk7dmFyIHI9ZShuLnRhcmdldCkuY2xvc2VzdCgic3BhbiwgdGQsIHRoIik7aWYoci5sZW5ndGg9PTEpc3dpdGNoKHJbMF0ubm9kZU5hbWUudG9Mb3dlckNhc2UoKSl7Y2FzZSJ0aCI6c3dpdGNoKHJbMF0uY2xhc3NOYW1lKXtjYXNlImRhdGVwaWNrZXItc3dpdGNoIjp0aGlzLnNob3dNb2RlKDEpO2JyZWFrO2Nhc2UicHJldiI6Y2FzZSJuZXh0Ijp2YXIgaT11Lm1vZGVzW3RoaXMudmlld01vZGVdLm5hdlN0ZXAqKHJbMF0uY2xhc3NOYW1lPT0icHJldiI/LTE6MSk7c3dpdGNoKHRoaXMudmlld01vZGUpe2Nhc2UgMDp0aGlzLnZpZXdEYXRlPXRoaXMubW92ZU1vbnRoKHRoaXMudmlld0RhdGUsaSk7YnJlYWs7Y2FzZSAxOmNhc2UgMjp0aGlzLnZpZXdEYXRlPXRoaXMubW92ZVllYXIodGhpcy52aWV3RGF0ZSxpKX10aGlzLmZpbGwoKTticmVhaztjYXNlInRvZGF5Ijp2YXIgcz1uZXcgRGF0ZTtzPXQocy5nZXRGdWxsWWVhcigpLHMuZ2V0TW9udGgoKSxzLmdldERhdGUoKSwwLDAsMCksdGhpcy5zaG93TW9kZSgtMik7dmFyIG89dGhpcy50b2RheUJ0bj09ImxpbmtlZCI/bnVsbDoidmlldyI7dGhpcy5fc2V0RGF0ZShzLG8pfWJyZWFrO2Nhc2Uic3BhbiI6aWYoIXIuaXMoIi5kaXNhYmxlZCIpKXt0aGlzLnZpZXdEYXRlLnNldFVUQ0RhdGUoMSk7aWYoci5pcygiLm1vbnRoIikpe3ZhciBhPTEsZj1yLnBhcmVudCgpLmZpbmQoInNwYW4iKS5pbmRleChyKSxsPXRoaXMudmlld0RhdGUuZ2V0VVRDRnVsbFllYXIoKTt0aGlzLnZpZXdEYXRlLnNldFVUQ01vbnRoKGYpLHRoaXMuZWxlbWVudC50cmlnZ2VyKHt0eXBlOiJjaGFuZ2VNb250aCIsZGF0ZTp0aGlzLnZpZXdEYXRlfSksdGhpcy5taW5WaWV3TW9kZT09MSYmdGhpcy5fc2V0RGF0ZSh0KGwsZixhLDAsMCwwLDApKX1lbHNle3ZhciBsPXBhcnNlSW50KHIudGV4dCgpLDEwKXx8MCxhPTEsZj0wO3RoaXMudmlld0RhdGUuc2V0VVRDRnVsbFllYXIobCksdGhpcy5lbGVtZW50LnRyaWdnZXIoe3R5cGU6ImNoYW5nZVllYXIiLGRhdGU6dGhpcy52aWV3RGF0ZX0pLHRoaXMubWluVmlld01vZGU9PTImJnRoaXMuX3NldERhdGUodChsLGYsYSwwLDAsMCwwKSl9dGhpcy5zaG93TW9kZSgtMSksdGhpcy5maWxsKCl9YnJlYWs7Y2FzZSJ0ZCI6aWYoci5pcygiLmRheSIpJiYhci5pcygiLmRpc2FibGVkIikpe3ZhciBhPXBhcnNlSW50KHIudGV4dCgpLDEwKXx8MSxsPXRoaXMudmlld0RhdGUuZ2V0VVRDRnVsbFllYXIoKSxmPXRoaXMudmlld0RhdGUuZ2V0VVRDTW9udGgoKTtyLmlzKCIub2xkIik/Zj09PTA/KGY9MTEsbC09MSk6Zi09MTpyLmlzKCIubmV3IikmJihmPT0xMT8oZj0wLGwrPTEpOmYrPTEpLHRoaXMuX3NldERhdGUodChsLGYsYSwwLDAsMCwwKSl9fX0sX3NldERhdGU6ZnVuY3Rpb24oZSx0KXtpZighdHx8dD09ImRhdGUiKXRoaXMuZGF0ZT1lO2lmKCF0fHx0PT0idmlldyIpdGhpcy52aWV3RGF0ZT1lO3RoaXMuZmlsbCgpLHRoaXMuc2V0VmFsdWUoKSx0aGlzLmVsZW1lbnQudHJpZ2dlcih7dHlwZToiY2hhbmdlRGF0ZSIsZGF0ZTp0aGlzLmRhdGV9KTt2YXIgbjt0aGlzLmlzSW5wdXQ/bj10aGlzLmVsZW1lbnQ6dGhpcy5jb21wb25lbnQmJihuPXRoaXMuZWxlbWVudC5maW5kKCJpbnB1dCIpKSxuJiYobi5jaGFuZ2UoKSx0aGlzLmF1dG9jbG9zZSYmKCF0fHx0PT0iZGF0ZSIpJiZ0aGlzLmhpZGUoKSl9LG1vdmVNb250aDpmdW5jdGlvbihlLHQpe2lmKCF0KXJldHVybiBlO3ZhciBuPW5ldyBEYXRlKGUudmFsdWVPZigpKSxyPW4uZ2V0VVRDRGF0ZSgpLGk9bi5nZXRVVENNb250aCgpLHM9TWF0aC5hYnModCksbyx1O3Q9dD4wPzE6LTE7aWYocz09MSl7dT10PT0tMT9mdW5jdGlvbigpe3JldHVybiBuLmdldFVUQ01vbnRoKCk9PWl9OmZ1bmN0aW9uKCl7cmV0dXJuIG4uZ2V0VVRDTW9udGgoKSE9b30sbz1pK3Qsbi5zZXRVVENNb250aChvKTtpZihvPDB8fG8+MTEpbz0obysxMiklMTJ9ZWxzZXtmb3IodmFyIGE9MDthPHM7YSsrKW49dGhpcy5tb3ZlTW9udGgobix0KTtvPW4uZ2V0VVRDTW9udGgoKSxuLnNldFVUQ0RhdGUociksdT1mdW5jdGlvbigpe3JldHVybiBvIT1uLmdldFVUQ01vbnRoKCl9fXdoaWxlKHUoKSluLnNldFVUQ0RhdGUoLS1yKSxuLnNldFVUQ01vbnRoKG8pO3JldHVybiBufSxtb3ZlWWVhcjpmdW5jdGlvbihlLHQpe3JldHVybiB0aGlzLm1vdmVNb250aChlLHQqMTIpfSxkYXRlV2l0aGluUmFuZ2U6ZnVuY3Rpb24oZSl7cmV0dXJuIGU+PXRoaXMuc3RhcnREYXRlJiZlPD10aGlzLmVuZERhdGV9LGtleWRvd246ZnVuY3Rpb24oZSl7aWYodGhpcy5waWNrZXIuaXMoIjpub3QoOnZpc2libGUpIikpe2Uua2V5Q29kZT09MjcmJnRoaXMuc2hvdygpO3JldHVybn12YXIgdD0hMSxuLHIsaSxzLG87c3dpdGNoKGUua2V5Q29kZSl7Y2FzZSAyNzp0aGlzLmhpZGUoKSxlLnByZXZlbnREZWZhdWx0KCk7YnJlYWs7Y2FzZSAzNzpjYXNlIDM5OmlmKCF0aGlzLmtleWJvYXJkTmF2aWdhdGlvbilicmVhaztuPWUua2V5Q29kZT09Mzc/LTE6MSxlLmN0cmxLZXk/KHM9dGhpcy5tb3ZlWWVhcih0aGlzLmRhdGUsbiksbz10aGlzLm1vdmVZZWFyKHRoaXMudmlld0RhdGUsbikpOmUuc2hpZnRLZXk/KHM9dGhpcy5tb3ZlTW9udGgodGhpcy5kYXRlLG4pLG89dGhpcy5tb3ZlTW9udGgodGhpcy52aWV3RGF0ZSxuKSk6KHM9bmV3IERhdGUodGhpcy5kYXRlKSxzLnNldFVUQ0RhdGUodGhpcy5kYXRlLmdldFVUQ0RhdGUoKStuKSxvPW5ldyBEYXRlKHRoaXMudmlld0RhdGUpLG8uc2V0VVRDRGF0ZSh0aGlzLnZpZXdEYXRlLmdldFVUQ0RhdGUoKStuKSksdGhpcy5kYXRlV2l0aGluUmFuZ2UocykmJih0aGlzLmRhdGU9cyx0aGlzLnZpZXdEYXRlPW8sdGhpcy5zZXRWYWx1ZSgpLHRoaXMudXBkYXRlKCksZS5wcmV2ZW50RGVmYXVsdCgpLHQ9ITApO2JyZWFrO2Nhc2UgMzg6Y2FzZSA0MDppZighdGhpcy5rZXlib2FyZE5hdmlnYXRpb24pYnJlYWs7bj1lLmtleUNvZGU9PTM4Py0xOjEsZS5jdHJsS2V5PyhzPXRoaXMubW92ZVllYXIodGhpcy5kYXRlLG4pLG89dGhpcy5tb3ZlWWVhcih0aGlzLnZpZXdEYXRlLG4pKTplLnNoaWZ0S2V5PyhzPXRoaXMubW92ZU1vbnRoKHRoaXMuZGF0ZSxuKSxvPXRoaXMubW92ZU1vbnRoKHRoaXMudmlld0RhdGUsbikpOihzPW5ldyBEYXRlKHRoaXMuZGF0ZSkscy5zZXRVVENEYXRlKHRoaXMuZGF0ZS5nZXRVVENEYXRlKCkrbio3KSxvPW5ldyBEYXRlKHRoaXMudmlld0RhdGUpLG8uc2V0VVRDRGF0ZSh0aGlzLnZpZXdEYXRlLmdldFVUQ0RhdGUoKStuKjcpKSx0aGlzLmRhdGVXaXRoaW5SYW5nZShzKSYmKHRoaXMuZGF0ZT1zLHRoaXMudmlld0RhdGU9byx0aGlzLnNldFZhbHVlKCksdGhpcy51cGRhdGUoKSxlLnByZXZlbnREZWZhdWx0KCksdD0hMCk7YnJlYWs7Y2FzZSAxMzp0aGlzLmhpZGUoKSxlLnByZXZlbnREZWZhdWx0KCk7YnJlYWs7Y2FzZSA5OnRoaXMuaGlkZSgpfWlmKHQpe3RoaXMuZWxlbWVudC50cmlnZ2VyKHt0eXBlOiJjaGFuZ2VEYXRlIixkYXRlOnRoaXMuZGF0ZX0pO3ZhciB1O3RoaXMuaXNJbnB1dD91PXRoaXMuZWxlbWVudDp0aGlzLmNvbXBvbmVudCYmKHU9dGhpcy5lbGVtZW50LmZpbmQoImlucHV0IikpLHUmJnUuY2hhbmdlKCl9fSxzaG93TW9kZTpmdW5jdGlvbihlKXtlJiYodGhpcy52aWV3TW9kZT1NYXRoLm1heCh0aGlzLm1pblZpZXdNb2RlLE1hdGgubWluKDIsdGhpcy52aWV3TW9kZStlKSkpLHRoaXMucGlja2VyLmZpbmQoIj5kaXYiKS5oaWRlKCkuZmlsdGVyKCIuZGF0ZXBpY2tlci0iK3UubW9kZXNbdGhpcy52aWV3TW9kZV0uY2xzTmFtZSkuY3NzKCJkaXNwbGF5IiwiYmxvY2siKSx0aGlzLnVwZGF0ZU5hdkFycm93cygpfX07dmFyIGk9ZnVuY3Rpb24odCxuKXt0aGlzLmVsZW1lbnQ9ZSh0KSx0aGlzLmlucHV0cz1lLm1hcChuLmlucHV0cyxmdW5jdGlvbihlKXtyZXR1cm4gZS5qcXVlcnk/ZVswXTplfSksZGVsZXRlIG4uaW5wdXRzLGUodGhpcy5pbnB1dHMpLmRhdGVwaWNrZXIobikuYmluZCgiY2hhbmdlRGF0ZSIsZS5wcm94eSh0aGlzLmRhdGVVcGRhdGVkLHRoaXMpKSx0aGlzLnBpY2tlcnM9ZS5tYXAodGhpcy5pbnB1dHMsZnVuY3Rpb24odCl7cmV0dXJuIGUodCkuZGF0YSgiZGF0ZXBpY2tlciIpfSksdGhpcy51cGRhdGVEYXRlcygpfTtpLnByb3RvdHlwZT17dXBkYXRlRGF0ZXM6ZnVuY3Rpb24oKXt0aGlzLmRhdGVzPWUubWFwKHRoaXMucGlja2VycyxmdW5jdGlvbihlKXtyZXR1cm4gZS5kYXRlfSksdGhpcy51cGRhdGVSYW5nZXMoKX0sdXBkYXRlUmFuZ2VzOmZ1bmN0aW9uKCl7dmFyIHQ9ZS5tYXAodGhpcy5kYXRlcyxmdW5jdGlvbihlKXtyZXR1cm4gZS52YWx1ZU9mKCl9KTtlLmVhY2godGhpcy5waWNrZXJzLGZ1bmN0aW9uKGUsbil7bi5zZXRSYW5nZSh0KX0pfSxkYXRlVXBkYXRlZDpmdW5jdGlvbih0KXt2YXIgbj1lKHQudGFyZ2V0KS5kYXRhKCJkYXRlcGlja2VyIikscj10LmRhdGUsaT1lLmluQXJyYXkodC50YXJnZXQsdGhpcy5pbnB1dHMpLHM9dGhpcy5pbnB1dHMubGVuZ3RoO2lmKGk9PS0xKXJldHVybjtpZihyPHRoaXMuZGF0ZXNbaV0pd2hpbGUoaT49MCYmcjx0aGlzLmRhdGVzW2ldKXRoaXMucGlja2Vyc1tpLS1dLnNldFVUQ0RhdGUocik7ZWxzZSBpZihyPnRoaXMuZGF0ZXNbaV0pd2hpbGUoaTxzJiZyPnRoaXMuZGF0ZXNbaV0pdGhpcy5waWNrZXJzW2krK10uc2V0VVRDRGF0ZShyKTt0aGlzLnVwZGF0ZURhdGVzKCl9LHJlbW92ZTpmdW5jdGlvbigpe2UubWFwKHRoaXMucGlja2VycyxmdW5jdGlvbihlKXtlLnJlbW92ZSgpfSksZGVsZXRlIHRoaXMuZWxlbWVudC5kYXRhKCkuZGF0ZXBpY2tlcn19O3ZhciBzPWUuZm4uZGF0ZXBpY2tlcjtlLmZuLmRhdGVwaWNrZXI9ZnVuY3Rpb24odCl7dmFyIG49QXJyYXkuYXBwbHkobnVsbCxhcmd1bWVudHMpO3JldHVybiBuLnNoaWZ0KCksdGhpcy5lYWNoKGZ1bmN0aW9uKCl7dmFyIHM9ZSh0aGlzKSxvPXMuZGF0YSgiZGF0ZXBpY2tlciIpLHU9dHlwZW9mIHQ9PSJvYmplY3QiJiZ0O2lmKCFvKWlmKHMuaXMoIi5pbnB1dC1kYXRlcmFuZ2UiKXx8dS5pbnB1dHMpe3ZhciBhPXtpbnB1dHM6dS5pbnB1dHN8fHMuZmluZCgiaW5wdXQiKS50b0FycmF5KCl9O3MuZGF0YSgiZGF0ZXBpY2tlciIsbz1uZXcgaSh0aGlzLGUuZXh0ZW5kKGEsZS5mbi5kYXRlcGlja2VyLmRlZmF1bHRzLHUpKSl9ZWxzZSBzLmRhdGEoImRhdGVwaWNrZXIiLG89bmV3IHIodGhpcyxlLmV4dGVuZCh7fSxlLmZuLmRhdGVwaWNrZXIuZGVmYXVsdHMsdSkpKTt0eXBlb2YgdD09InN0cmluZyImJnR5cGVvZiBvW3RdPT0iZnVuY3Rpb24iJiZvW3RdLmFwcGx5KG8sbil9KX0sZS5mbi5kYXRlcGlja2VyLmRlZmF1bHRzPXt9LGUuZm4uZGF0ZXBpY2tlci5Db25zdHJ1Y3Rvcj1yO3ZhciBvPWUuZm4uZGF0ZXBpY2tlci5kYXRlcz17ZW46e2RheXM6WyJTdW5kYXkiLCJNb25kYXkiLCJUdWVzZGF5IiwiV2VkbmVzZGF5IiwiVGh1cnNkYXkiLCJGcmlkYXkiLCJTYXR1cmRheSIsIlN1bmRheSJdLGRheXNTaG9ydDpbIlN1biIsIk1vbiIsIlR1ZSIsIldlZCIsIlRodSIsIkZyaSIsIlNhdCIsIlN1biJdLGRheXNNaW46WyJTdSIsIk1vIiwiVHUiLCJXZSIsIlRoIiwiRnIiLCJTYSIsIlN1Il0sbW9udGhzOlsiSmFudWFyeSIsIkZlYnJ1YXJ5IiwiTWFyY2giLCJBcHJpbCIsIk1heSIsIkp1bmUiLCJKdWx5IiwiQXVndXN0IiwiU2VwdGVtYmVyIiwiT2N0b2JlciIsIk5vdmVtYmVyIiwiRGVjZW1iZXIiXSxtb250aHNTaG9ydDpbIkphbiIsIkZlYiIsIk1hciIsIkFwciIsIk1heSIsIkp1biIsIkp1bCIsIkF1ZyIsIlNlcCIsIk9jdCIsIk5vdiIsIkRlYyJdLHRvZGF5OiJUb2RheSJ9fSx1PXttb2Rlczpbe2Nsc05hbWU6ImRheXMiLG5hdkZuYzoiTW9udGgiLG5hdlN0ZXA6MX0se2Nsc05hbWU6Im1vbnRocyIsbmF2Rm5jOiJGdWxsWWVhciIsbmF2U3RlcDoxfSx7Y2xzTmFtZToieWVhcnMiLG5hdkZuYzoiRnVsbFllYXIiLG5hdlN0ZXA6MTB9XSxpc0xlYXBZZWFyOmZ1bmN0aW9uKGUpe3JldHVybiBlJTQ9PT0wJiZlJTEwMCE9PTB8fGUlNDAwPT09MH0sZ2V0RGF5c0luTW9udGg6ZnVuY3Rpb24oZSx0KXtyZXR1cm5bMzEsdS5pc0xlYXBZZWFyKGUpPzI5OjI4LDMxLDMwLDMxLDMwLDMxLDMxLDMwLDMxLDMwLDMxXVt0XX0sdmFsaWRQYXJ0czovZGQ/fEREP3xtbT98TU0/fHl5KD86eXkpPy9nLG5vbnB1bmN0dWF0aW9uOi9bXiAtXC86LUBcW1x1MzQwMC1cdTlmZmYtYHstflx0XG5ccl0rL2cscGFyc2VGb3JtYXQ6ZnVuY3Rpb24oZSl7dmFyIHQ9ZS5yZXBsYWNlKHRoaXMudmFsaWRQYXJ0cywiXDAiKS5zcGxpdCgiXDAiKSxuPWUubWF0Y2godGhpcy52YWxpZFBhcnRzKTtpZighdHx8IXQubGVuZ3RofHwhbnx8bi5sZW5ndGg9PT0wKXRocm93IG5ldyBFcnJvcigiSW52YWxpZCBkYXRlIGZvcm1hdC4iKTtyZXR1cm57c2VwYXJhdG9yczp0LHBhcnRzOm59fSxwYXJzZURhdGU6ZnVuY3Rpb24obixpLHMpe2lmKG4gaW5zdGFuY2VvZiBEYXRlKXJldHVybiBuO2lmKC9eW1wtK11cZCtbZG13eV0oW1xzLF0rW1wtK11cZCtbZG13eV0pKiQvLnRlc3Qobikpe3ZhciB1PS8oW1wtK11cZCspKFtkbXd5XSkvLGE9bi5tYXRjaCgvKFtcLStdXGQrKShbZG13eV0pL2cpLGYsbDtuPW5ldyBEYXRlO2Zvcih2YXIgYz0wO2M8YS5sZW5ndGg7YysrKXtmPXUuZXhlYyhhW2NdKSxsPXBhcnNlSW50KGZbMV0pO3N3aXRjaChmWzJdKXtjYXNlImQiOm4uc2V0VVRDRGF0ZShuLmdldFVUQ0RhdGUoKStsKTticmVhaztjYXNlIm0iOm49ci5wcm90b3R5cGUubW92ZU1vbnRoLmNhbGwoci5wcm90b3R5cGUsbixsKTticmVhaztjYXNlInciOm4uc2V0VVRDRGF0ZShuLmdldFVUQ0RhdGUoKStsKjcpO2JyZWFrO2Nhc2UieSI6bj1yLnByb3RvdHlwZS5tb3ZlWWVhci5jYWxsKHIucHJvdG90eXBlLG4sbCl9fXJldHVybiB0KG4uZ2V0VVRDRnVsbFllYXIoKSxuLmdldFVUQ01vbnRoKCksbi5nZXRVVENEYXRlKCksMCwwLDApfXZhciBhPW4mJm4ubWF0Y2godGhpcy5ub25wdW5jdHVhdGlvbil8fFtdLG49bmV3IERhdGUsaD17fSxwPVsieXl5eSIsInl5IiwiTSIsIk1NIiwibSIsIm1tIiwiZCIsImRkIl0sZD17eXl5eTpmdW5jdGlvbihlLHQpe3JldHVybiBlLnNldFVUQ0Z1bGxZZWFyKHQpfSx5eTpmdW5jdGlvbihlLHQpe3JldHVybiBlLnNldFVUQ0Z1bGxZZWFyKDJlMyt0KX0sbTpmdW5jdGlvbihlLHQpe3QtPTE7d2hpbGUodDwwKXQrPTEyO3QlPTEyLGUuc2V0VVRDTW9udGgodCk7d2hpbGUoZS5nZXRVVENNb250aCgpIT10KWUuc2V0VVRDRGF0ZShlLmdldFVUQ0RhdGUoKS0xKTtyZXR1cm4gZX0sZDpmdW5jdGlvbihlLHQpe3JldHVybiBlLnNldFVUQ0RhdGUodCl9fSx2LG0sZjtkLk09ZC5NTT1kLm1tPWQubSxkLmRkPWQuZCxuPXQobi5nZXRGdWxsWWVhcigpLG4uZ2V0TW9udGgoKSxuLmdldERhdGUoKSwwLDAsMCk7dmFyIGc9aS5wYXJ0cy5zbGljZSgpO2EubGVuZ3RoIT1nLmxlbmd0aCYmKGc9ZShnKS5maWx0ZXIoZnVuY3Rpb24odCxuKXtyZXR1cm4gZS5pbkFycmF5KG4scCkhPT0tMX0pLnRvQXJyYXkoKSk7aWYoYS5sZW5ndGg9PWcubGVuZ3RoKXtmb3IodmFyIGM9MCx5PWcubGVuZ3RoO2M8eTtjKyspe3Y9cGFyc2VJbnQoYVtjXSwxMCksZj1nW2NdO2lmKGlzTmFOKHYpKXN3aXRjaChmKXtjYXNlIk1NIjptPWUob1tzXS5tb250aHMpLmZpbHRlcihmdW5jdGlvbigpe3ZhciBlPXRoaXMuc2xpY2UoMCxhW2NdLmxlbmd0aCksdD1hW2NdLnNsaWNlKDAsZS5sZW5ndGgpO3JldHVybiBlPT10fSksdj1lLmluQXJyYXkobVswXSxvW3NdLm1vbnRocykrMTticmVhaztjYXNlIk0iOm09ZShvW3NdLm1vbnRoc1Nob3J0KS5maWx0ZXIoZnVuY3Rpb24oKXt2YXIgZT10aGlzLnNsaWNlKDAsYVtjXS5sZW5ndGgpLHQ9YVtjXS5zbGljZSgwLGUubGVuZ3RoKTtyZXR1cm4gZT09dH0pLHY9ZS5pbkFycmF5KG1bMF0sb1tzXS5tb250aHNTaG9ydCkrMX1oW2ZdPXZ9Zm9yKHZhciBjPTAsYjtjPHAubGVuZ3RoO2MrKyliPXBbY10sYiBpbiBoJiYhaXNOYU4oaFtiXSkmJmRbYl0obixoW2JdKX1yZXR1cm4gbn0sZm9ybWF0RGF0ZTpmdW5jdGlvbih0LG4scil7dmFyIGk9e2Q6dC5nZXRVVENEYXRlKCksRDpvW3JdLmRheXNTaG9ydFt0LmdldFVUQ0RheSgpXSxERDpvW3JdLmRheXNbdC5nZXRVVENEYXkoKV0sbTp0LmdldFVUQ01vbnRoKCkrMSxNOm9bcl0ubW9udGhzU2hvcnRbdC5nZXRVVENNb250aCgpXSxNTTpvW3JdLm1vbnRoc1t0LmdldFVUQ01vbnRoKCldLHl5OnQuZ2V0VVRDRnVsbFllYXIoKS50b1N0cmluZygpLnN1YnN0cmluZygyKSx5eXl5OnQuZ2V0VVRDRnVsbFllYXIoKX07aS5kZD0oaS5kPDEwPyIwIjoiIikraS5kLGkubW09KGkubTwxMD8iMCI6IiIpK2kubTt2YXIgdD1bXSxzPWUuZXh0ZW5kKFtdLG4uc2VwYXJhdG9ycyk7Zm9yKHZhciB1PTAsYT1uLnBhcnRzLmxlbmd0aDt1PGE7dSsrKXMubGVuZ3RoJiZ0LnB1c2gocy5zaGlmdCgpKSx0LnB1c2goaVtuLnBhcnRzW3VdXSk7cmV0dXJuIHQuam9pbigiIil9LGhlYWRUZW1wbGF0ZTonPHRoZWFkPjx0cj48dGggY2xhc3M9InByZXYiPjxpIGNsYXNzPSJpY29uLWFycm93LWxlZnQiLz48L3RoPjx0aCBjb2xzcGFuPSI1IiBjbGFzcz0iZGF0ZXBpY2tlci1zd2l0Y2giPjwvdGg+PHRoIGNsYXNzPSJuZXh0Ij48aSBjbGFzcz0iaWNvbi1hcnJvdy1yaWdodCIvPjwvdGg+PC90cj48L3RoZWFkPicsY29udFRlbXBsYXRlOic8dGJvZHk+PHRyPjx0ZCBjb2xzcGFuPSI3Ij48L3RkPjwvdHI+PC90Ym9keT4nLGZvb3RUZW1wbGF0ZTonPHRmb290Pjx0cj48dGggY29sc3Bhbj0iNyIgY2xhc3M9InRvZGF5Ij48L3RoPjwvdHI+PC90Zm9vdD4nfTt1LnRlbXBsYXRlPSc8ZGl2IGNsYXNzPSJkYXRlcGlja2VyIj48ZGl2IGNsYXNzPSJkYXRlcGlja2VyLWRheXMiPjx0YWJsZSBjbGFzcz0iIHRhYmxlLWNvbmRlbnNlZCI+Jyt1LmhlYWRUZW1wbGF0ZSsiPHRib2R5PjwvdGJvZHk+Iit1LmZvb3RUZW1wbGF0ZSsiPC90YWJsZT4iKyI8L2Rpdj4iKyc8ZGl2IGNsYXNzPSJkYXRlcGlja2VyLW1vbnRocyI+JysnPHRhYmxlIGNsYXNzPSJ0YWJsZS1jb25kZW5zZWQiPicrdS5oZWFkVGVtcGxhdGUrdS5jb250VGVtcGxhdGUrdS5mb290VGVtcGxhdGUrIjwvdGFibGU+IisiPC9kaXY+IisnPGRpdiBjbGFzcz0iZGF0ZXBpY2tlci15ZWFycyI+JysnPHRhYmxlIGNsYXNzPSJ0YWJsZS1jb25kZW5zZWQiPicrdS5oZWFkVGVtcGxhdGUrdS5jb250VGVtcGxhdGUrdS5mb290VGVtcGxhdGUrIjwvdGFibGU+IisiPC9kaXY+IisiPC9kaXY+IixlLmZuLmRhdGVwaWNrZXIuRFBHbG9iYWw9dSxlLmZuLmRhdGVwaWNrZXIubm9Db25mbGljdD1mdW5jdGlvbigpe3JldHVybiBlLmZuLmRhdGVwaWNrZXI9cyx0aGlzfSxlKGRvY3VtZW50KS5vbigiZm9jdXMuZGF0ZXBpY2tlci5kYXRhLWFwaSBjbGljay5kYXRlcGlja2VyLmRhdGEtYXBpIiwnW2RhdGEtcHJvdmlkZT0iZGF0ZXBpY2tlciJdJyxmdW5jdGlvbih0KXt2YXIgbj1lKHRoaXMpO2lmKG4uZGF0YSgiZGF0ZXBpY2tlciIpKXJldHVybjt0LnByZXZlbnREZWZhdWx0KCksbi5kYXRlcGlja2VyKCJzaG93Iil9KSxlKGZ1bmN0aW9uKCl7ZSgnW2RhdGEtcHJvdmlkZT0iZGF0ZXBpY2tlci1pbmxpbmUiXScpLmRhdGVwaWNrZXIoKX0pfSh3aW5kb3cualF1ZXJ5KTs=",
 		"datepicker.css":              "LyohCiAqIERhdGVwaWNrZXIgZm9yIEJvb3RzdHJhcAogKgogKiBDb3B5cmlnaHQgMjAxMiBTdGVmYW4gUGV0cmUKICogSW1wcm92ZW1lbnRzIGJ5IEFuZHJldyBSb3dscwogKiBVcGRhdGVkIGZvciBCb290c3RyYXAgMy54IGJ5IElhbiBTZXJsaW4KICoKICogTGljZW5zZWQgdW5kZXIgdGhlIEFwYWNoZSBMaWNlbnNlIHYyLjAKICogaHR0cDovL3d3dy5hcGFjaGUub3JnL2xpY2Vuc2VzL0xJQ0VOU0UtMi4wCiAqCiAqLwouZGF0ZXBpY2tlciB7CiAgcGFkZGluZzogNHB4OwogIC13ZWJraXQtYm9yZGVyLXJhZGl1czogNHB4OwogIC1tb3otYm9yZGVyLXJhZGl1czogNHB4OwogIGJvcmRlci1yYWRpdXM6IDRweDsKICBkaXJlY3Rpb246IGx0cjsKICAvKi5kb3cgewogICAgYm9yZGVyLXRvcDogMXB4IHNvbGlkICNkZGQgIWltcG9ydGFudDsKICB9Ki8KCn0KLmRhdGVwaWNrZXItaW5saW5lIHsKICB3aWR0aDogMjIwcHg7Cn0KLmRhdGVwaWNrZXIuZGF0ZXBpY2tlci1ydGwgewogIGRpcmVjdGlvbjogcnRsOwp9Ci5kYXRlcGlja2VyLmRhdGVwaWNrZXItcnRsIHRhYmxlIHRyIHRkIHNwYW4gewogIGZsb2F0OiByaWdodDsKfQouZGF0ZXBpY2tlci1kcm9wZG93biB7CiAgdG9wOiAwOwogIGxlZnQ6IDA7Cn0KLmRhdGVwaWNrZXItZHJvcGRvd246YmVmb3JlIHsKICBjb250ZW50OiAnJzsKICBkaXNwbGF5OiBpbmxpbmUtYmxvY2s7CiAgYm9yZGVyLWxlZnQ6IDdweCBzb2xpZCB0cmFuc3BhcmVudDsKICBib3JkZXItcmlnaHQ6IDdweCBzb2xpZCB0cmFuc3BhcmVudDsKICBib3JkZXItYm90dG9tOiA3cHggc29saWQgI2NjYzsKICBib3JkZXItYm90dG9tLWNvbG9yOiByZ2JhKDAsIDAsIDAsIDAuMik7CiAgcG9zaXRpb246IGFic29sdXRlOwogIHRvcDogLTdweDsKICBsZWZ0OiA2cHg7Cn0KLmRhdGVwaWNrZXItZHJvcGRvd246YWZ0ZXIgewogIGNvbnRlbnQ6ICcnOwogIGRpc3BsYXk6IGlubGluZS1ibG9jazsKICBib3JkZXItbGVmdDogNnB4IHNvbGlkIHRyYW5zcGFyZW50OwogIGJvcmRlci1yaWdodDogNnB4IHNvbGlkIHRyYW5zcGFyZW50OwogIGJvcmRlci1ib3R0b206IDZweCBzb2xpZCAjRkZGOwogIHBvc2l0aW9uOiBhYnNvbHV0ZTsKICB0b3A6IC02cHg7CiAgbGVmdDogN3B4Owp9Ci5kYXRlcGlja2VyID4gZGl2IHsKICBkaXNwbGF5OiBub25lOwp9Ci5kYXRlcGlja2VyLmRheXMgZGl2LmRhdGVwaWNrZXItZGF5cyB7CiAgZGlzcGxheTogYmxvY2s7Cn0KLmRhdGVwaWNrZXIubW9udGhzIGRpdi5kYXRlcGlja2VyLW1vbnRocyB7CiAgZGlzcGxheTogYmxvY2s7Cn0KLmRhdGVwaWNrZXIueWVhcnMgZGl2LmRhdGVwaWNrZXIteWVhcnMgewogIGRpc3BsYXk6IGJsb2NrOwp9Ci5kYXRlcGlja2VyIHRhYmxlIHsKICBtYXJnaW46IDA7Cn0KLmRhdGVwaWNrZXIgdGQsCi5kYXRlcGlja2VyIHRoIHsKICB0ZXh0LWFsaWduOiBjZW50ZXI7CiAgd2lkdGg6IDIwcHg7CiAgaGVpZ2h0OiAyMHB4OwogIC13ZWJraXQtYm9yZGVyLXJhZGl1czogNHB4OwogIC1tb3otYm9yZGVyLXJhZGl1czogNHB4OwogIGJvcmRlci1yYWRpdXM6IDRweDsKICBib3JkZXI6IG5vbmU7Cn0KLnRhYmxlLXN0cmlwZWQgLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQsCi50YWJsZS1zdHJpcGVkIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRoIHsKICBiYWNrZ3JvdW5kLWNvbG9yOiB0cmFuc3BhcmVudDsKfQouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5kYXk6aG92ZXIgewogIGJhY2tncm91bmQ6ICNlZWVlZWU7CiAgY3Vyc29yOiBwb2ludGVyOwp9Ci5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLm9sZCwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQubmV3IHsKICBjb2xvcjogIzk5OTk5OTsKfQouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5kaXNhYmxlZCwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuZGlzYWJsZWQ6aG92ZXIgewogIGJhY2tncm91bmQ6IG5vbmU7CiAgY29sb3I6ICM5OTk5OTk7CiAgY3Vyc29yOiBkZWZhdWx0Owp9Ci5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheTpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXkuZGlzYWJsZWQsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkOmhvdmVyIHsKICBiYWNrZ3JvdW5kLWNvbG9yOiAjZmNlZWRiOwogIGJvcmRlci1jb2xvcjogI2ZjZjlkYjsKICBjb2xvcjogIzAwMCAhaW1wb3J0YW50Owp9Ci5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5OmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheTpob3Zlcjpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXkuZGlzYWJsZWQ6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkOmhvdmVyOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheTpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXk6aG92ZXI6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5kaXNhYmxlZDpob3Zlcjpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXk6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheTpob3ZlcjphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXkuZGlzYWJsZWQ6aG92ZXI6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5OmhvdmVyLmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXkuZGlzYWJsZWQuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5kaXNhYmxlZDpob3Zlci5hY3RpdmUgewogIGJhY2tncm91bmQtY29sb3I6ICNmYWUzYzQ7CiAgYm9yZGVyLWNvbG9yOiAjZjhmMmFjOwp9Ci5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheTpob3Zlci5kaXNhYmxlZDpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXkuZGlzYWJsZWQuZGlzYWJsZWQ6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkOmhvdmVyLmRpc2FibGVkOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheVtkaXNhYmxlZF06aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5OmhvdmVyW2Rpc2FibGVkXTpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXkuZGlzYWJsZWRbZGlzYWJsZWRdOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5kaXNhYmxlZDpob3ZlcltkaXNhYmxlZF06aG92ZXIsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheTpob3ZlciwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5OmhvdmVyOmhvdmVyLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXkuZGlzYWJsZWQ6aG92ZXIsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5kaXNhYmxlZDpob3Zlcjpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXkuZGlzYWJsZWQ6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5OmhvdmVyLmRpc2FibGVkOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5kaXNhYmxlZC5kaXNhYmxlZDpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXkuZGlzYWJsZWQ6aG92ZXIuZGlzYWJsZWQ6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5W2Rpc2FibGVkXTpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXk6aG92ZXJbZGlzYWJsZWRdOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5kaXNhYmxlZFtkaXNhYmxlZF06Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkOmhvdmVyW2Rpc2FibGVkXTpmb2N1cywKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5OmZvY3VzLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXk6aG92ZXI6Zm9jdXMsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5kaXNhYmxlZDpmb2N1cywKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkOmhvdmVyOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5kaXNhYmxlZDphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5OmhvdmVyLmRpc2FibGVkOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXkuZGlzYWJsZWQuZGlzYWJsZWQ6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5kaXNhYmxlZDpob3Zlci5kaXNhYmxlZDphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5W2Rpc2FibGVkXTphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5OmhvdmVyW2Rpc2FibGVkXTphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkW2Rpc2FibGVkXTphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkOmhvdmVyW2Rpc2FibGVkXTphY3RpdmUsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheTphY3RpdmUsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheTpob3ZlcjphY3RpdmUsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5kaXNhYmxlZDphY3RpdmUsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5kaXNhYmxlZDpob3ZlcjphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkLmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXk6aG92ZXIuZGlzYWJsZWQuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC50b2RheS5kaXNhYmxlZC5kaXNhYmxlZC5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkOmhvdmVyLmRpc2FibGVkLmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXlbZGlzYWJsZWRdLmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXk6aG92ZXJbZGlzYWJsZWRdLmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXkuZGlzYWJsZWRbZGlzYWJsZWRdLmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQudG9kYXkuZGlzYWJsZWQ6aG92ZXJbZGlzYWJsZWRdLmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5OmhvdmVyLmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkLmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnRvZGF5LmRpc2FibGVkOmhvdmVyLmFjdGl2ZSB7CiAgYmFja2dyb3VuZC1jb2xvcjogI2ZjZWVkYjsKICBib3JkZXItY29sb3I6ICNmY2Y5ZGI7Cn0KLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS5kaXNhYmxlZCwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UuZGlzYWJsZWQ6aG92ZXIgewogIGJhY2tncm91bmQ6ICNlZWVlZWU7CiAgLXdlYmtpdC1ib3JkZXItcmFkaXVzOiAwOwogIC1tb3otYm9yZGVyLXJhZGl1czogMDsKICBib3JkZXItcmFkaXVzOiAwOwp9Ci5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheTpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkOmhvdmVyIHsKICBiYWNrZ3JvdW5kLWNvbG9yOiAjZWZjZTllOwogIGJvcmRlci1jb2xvcjogI2VmZTk5ZTsKICAtd2Via2l0LWJvcmRlci1yYWRpdXM6IDA7CiAgLW1vei1ib3JkZXItcmFkaXVzOiAwOwogIGJvcmRlci1yYWRpdXM6IDA7Cn0KLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXk6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5OmhvdmVyOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheS5kaXNhYmxlZDpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQ6aG92ZXI6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5OmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheTpob3Zlcjpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQ6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkOmhvdmVyOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheTphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5OmhvdmVyOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQ6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheS5kaXNhYmxlZDpob3ZlcjphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXk6aG92ZXIuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheS5kaXNhYmxlZC5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkOmhvdmVyLmFjdGl2ZSB7CiAgYmFja2dyb3VuZC1jb2xvcjogI2ViYzI4ODsKICBib3JkZXItY29sb3I6ICNlOGRlNzI7Cn0KLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQ6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5OmhvdmVyLmRpc2FibGVkOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheS5kaXNhYmxlZC5kaXNhYmxlZDpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQ6aG92ZXIuZGlzYWJsZWQ6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5W2Rpc2FibGVkXTpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXk6aG92ZXJbZGlzYWJsZWRdOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheS5kaXNhYmxlZFtkaXNhYmxlZF06aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkOmhvdmVyW2Rpc2FibGVkXTpob3ZlciwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5OmhvdmVyLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXk6aG92ZXI6aG92ZXIsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheS5kaXNhYmxlZDpob3ZlciwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkOmhvdmVyOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheS5kaXNhYmxlZDpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXk6aG92ZXIuZGlzYWJsZWQ6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkLmRpc2FibGVkOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheS5kaXNhYmxlZDpob3Zlci5kaXNhYmxlZDpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXlbZGlzYWJsZWRdOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheTpob3ZlcltkaXNhYmxlZF06Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkW2Rpc2FibGVkXTpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQ6aG92ZXJbZGlzYWJsZWRdOmZvY3VzLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXk6Zm9jdXMsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheTpob3Zlcjpmb2N1cywKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkOmZvY3VzLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQ6aG92ZXI6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXk6aG92ZXIuZGlzYWJsZWQ6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheS5kaXNhYmxlZC5kaXNhYmxlZDphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkOmhvdmVyLmRpc2FibGVkOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXlbZGlzYWJsZWRdOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXk6aG92ZXJbZGlzYWJsZWRdOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWRbZGlzYWJsZWRdOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQ6aG92ZXJbZGlzYWJsZWRdOmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5OmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5OmhvdmVyOmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkOmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkOmhvdmVyOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheTpob3Zlci5kaXNhYmxlZC5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnJhbmdlLnRvZGF5LmRpc2FibGVkLmRpc2FibGVkLmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQ6aG92ZXIuZGlzYWJsZWQuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheVtkaXNhYmxlZF0uYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheTpob3ZlcltkaXNhYmxlZF0uYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheS5kaXNhYmxlZFtkaXNhYmxlZF0uYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5yYW5nZS50b2RheS5kaXNhYmxlZDpob3ZlcltkaXNhYmxlZF0uYWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuYWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXk6aG92ZXIuYWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQuYWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQucmFuZ2UudG9kYXkuZGlzYWJsZWQ6aG92ZXIuYWN0aXZlIHsKICBiYWNrZ3JvdW5kLWNvbG9yOiAjZWZjZTllOwogIGJvcmRlci1jb2xvcjogI2VmZTk5ZTsKfQouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZCwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQ6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZC5kaXNhYmxlZDpob3ZlciB7CiAgYmFja2dyb3VuZC1jb2xvcjogI2IzYjNiMzsKICBib3JkZXItY29sb3I6ICM4MDgwODA7CiAgY29sb3I6ICNmZmY7CiAgdGV4dC1zaGFkb3c6IDAgLTFweCAwIHJnYmEoMCwgMCwgMCwgMC4yNSk7Cn0KLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQ6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkOmhvdmVyOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZC5kaXNhYmxlZDpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWQ6aG92ZXI6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZDpob3Zlcjpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWQ6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkOmhvdmVyOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZDphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkOmhvdmVyOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWQ6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZC5kaXNhYmxlZDpob3ZlcjphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQ6aG92ZXIuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZC5kaXNhYmxlZC5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkOmhvdmVyLmFjdGl2ZSB7CiAgYmFja2dyb3VuZC1jb2xvcjogI2E2YTZhNjsKICBib3JkZXItY29sb3I6ICM2NjY2NjY7Cn0KLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWQ6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkOmhvdmVyLmRpc2FibGVkOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZC5kaXNhYmxlZC5kaXNhYmxlZDpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWQ6aG92ZXIuZGlzYWJsZWQ6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkW2Rpc2FibGVkXTpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQ6aG92ZXJbZGlzYWJsZWRdOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZC5kaXNhYmxlZFtkaXNhYmxlZF06aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkOmhvdmVyW2Rpc2FibGVkXTpob3ZlciwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkOmhvdmVyLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQ6aG92ZXI6aG92ZXIsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZC5kaXNhYmxlZDpob3ZlciwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkOmhvdmVyOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZC5kaXNhYmxlZDpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQ6aG92ZXIuZGlzYWJsZWQ6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkLmRpc2FibGVkOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZC5kaXNhYmxlZDpob3Zlci5kaXNhYmxlZDpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWRbZGlzYWJsZWRdOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZDpob3ZlcltkaXNhYmxlZF06Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkW2Rpc2FibGVkXTpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWQ6aG92ZXJbZGlzYWJsZWRdOmZvY3VzLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQ6Zm9jdXMsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZDpob3Zlcjpmb2N1cywKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkOmZvY3VzLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWQ6aG92ZXI6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQ6aG92ZXIuZGlzYWJsZWQ6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZC5kaXNhYmxlZC5kaXNhYmxlZDphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkOmhvdmVyLmRpc2FibGVkOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWRbZGlzYWJsZWRdOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQ6aG92ZXJbZGlzYWJsZWRdOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWRbZGlzYWJsZWRdOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWQ6aG92ZXJbZGlzYWJsZWRdOmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkOmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkOmhvdmVyOmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkOmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkOmhvdmVyOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWQuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZDpob3Zlci5kaXNhYmxlZC5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLnNlbGVjdGVkLmRpc2FibGVkLmRpc2FibGVkLmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWQ6aG92ZXIuZGlzYWJsZWQuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZFtkaXNhYmxlZF0uYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZDpob3ZlcltkaXNhYmxlZF0uYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZC5kaXNhYmxlZFtkaXNhYmxlZF0uYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5zZWxlY3RlZC5kaXNhYmxlZDpob3ZlcltkaXNhYmxlZF0uYWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuYWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQ6aG92ZXIuYWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWQuYWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuc2VsZWN0ZWQuZGlzYWJsZWQ6aG92ZXIuYWN0aXZlIHsKICBiYWNrZ3JvdW5kLWNvbG9yOiAjYjNiM2IzOwogIGJvcmRlci1jb2xvcjogIzgwODA4MDsKfQouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZTpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlLmRpc2FibGVkLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQ6aG92ZXIgewogIGJhY2tncm91bmQtY29sb3I6ICM0MjhiY2E7CiAgYm9yZGVyLWNvbG9yOiAjNDI1ZWNhOwogIGNvbG9yOiAjZmZmOwogIHRleHQtc2hhZG93OiAwIC0xcHggMCByZ2JhKDAsIDAsIDAsIDAuMjUpOwp9Ci5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZTpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlOmhvdmVyOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQ6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZS5kaXNhYmxlZDpob3Zlcjpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmU6aG92ZXI6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZS5kaXNhYmxlZDpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlLmRpc2FibGVkOmhvdmVyOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmU6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmU6aG92ZXI6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQ6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQ6aG92ZXI6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmU6aG92ZXIuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQ6aG92ZXIuYWN0aXZlIHsKICBiYWNrZ3JvdW5kLWNvbG9yOiAjMzU3ZWJkOwogIGJvcmRlci1jb2xvcjogIzMwNDhhOTsKfQouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQ6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZTpob3Zlci5kaXNhYmxlZDpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlLmRpc2FibGVkLmRpc2FibGVkOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQ6aG92ZXIuZGlzYWJsZWQ6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZVtkaXNhYmxlZF06aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZTpob3ZlcltkaXNhYmxlZF06aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZS5kaXNhYmxlZFtkaXNhYmxlZF06aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZS5kaXNhYmxlZDpob3ZlcltkaXNhYmxlZF06aG92ZXIsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmU6aG92ZXIsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmU6aG92ZXI6aG92ZXIsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQ6aG92ZXIsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQ6aG92ZXI6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZS5kaXNhYmxlZDpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlOmhvdmVyLmRpc2FibGVkOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQuZGlzYWJsZWQ6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZS5kaXNhYmxlZDpob3Zlci5kaXNhYmxlZDpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlW2Rpc2FibGVkXTpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlOmhvdmVyW2Rpc2FibGVkXTpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlLmRpc2FibGVkW2Rpc2FibGVkXTpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlLmRpc2FibGVkOmhvdmVyW2Rpc2FibGVkXTpmb2N1cywKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZTpmb2N1cywKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZTpob3Zlcjpmb2N1cywKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZS5kaXNhYmxlZDpmb2N1cywKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZS5kaXNhYmxlZDpob3Zlcjpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlLmRpc2FibGVkOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlOmhvdmVyLmRpc2FibGVkOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlLmRpc2FibGVkLmRpc2FibGVkOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlLmRpc2FibGVkOmhvdmVyLmRpc2FibGVkOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlW2Rpc2FibGVkXTphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZTpob3ZlcltkaXNhYmxlZF06YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWRbZGlzYWJsZWRdOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlLmRpc2FibGVkOmhvdmVyW2Rpc2FibGVkXTphY3RpdmUsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmU6YWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlOmhvdmVyOmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZS5kaXNhYmxlZDphY3RpdmUsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQ6aG92ZXI6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmU6aG92ZXIuZGlzYWJsZWQuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQuZGlzYWJsZWQuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQ6aG92ZXIuZGlzYWJsZWQuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmVbZGlzYWJsZWRdLmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlOmhvdmVyW2Rpc2FibGVkXS5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZS5kaXNhYmxlZFtkaXNhYmxlZF0uYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmUuZGlzYWJsZWQ6aG92ZXJbZGlzYWJsZWRdLmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZS5hY3RpdmUsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZC5hY3RpdmU6aG92ZXIuYWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQuYWN0aXZlLmRpc2FibGVkLmFjdGl2ZSwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkLmFjdGl2ZS5kaXNhYmxlZDpob3Zlci5hY3RpdmUgewogIGJhY2tncm91bmQtY29sb3I6ICM0MjhiY2E7CiAgYm9yZGVyLWNvbG9yOiAjNDI1ZWNhOwp9Ci5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4gewogIGRpc3BsYXk6IGJsb2NrOwogIHdpZHRoOiAyMyU7CiAgaGVpZ2h0OiA1NHB4OwogIGxpbmUtaGVpZ2h0OiA1NHB4OwogIGZsb2F0OiBsZWZ0OwogIG1hcmdpbjogMSU7CiAgY3Vyc29yOiBwb2ludGVyOwogIC13ZWJraXQtYm9yZGVyLXJhZGl1czogNHB4OwogIC1tb3otYm9yZGVyLXJhZGl1czogNHB4OwogIGJvcmRlci1yYWRpdXM6IDRweDsKfQouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuOmhvdmVyIHsKICBiYWNrZ3JvdW5kOiAjZWVlZWVlOwp9Ci5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uZGlzYWJsZWQsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uZGlzYWJsZWQ6aG92ZXIgewogIGJhY2tncm91bmQ6IG5vbmU7CiAgY29sb3I6ICM5OTk5OTk7CiAgY3Vyc29yOiBkZWZhdWx0Owp9Ci5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZTpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWQsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLmRpc2FibGVkOmhvdmVyIHsKICBiYWNrZ3JvdW5kLWNvbG9yOiAjNDI4YmNhOwogIGJvcmRlci1jb2xvcjogIzQyNWVjYTsKICBjb2xvcjogI2ZmZjsKICB0ZXh0LXNoYWRvdzogMCAtMXB4IDAgcmdiYSgwLCAwLCAwLCAwLjI1KTsKfQouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZTpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmU6aG92ZXI6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLmRpc2FibGVkOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZDpob3Zlcjpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmU6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlOmhvdmVyOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZDpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWQ6aG92ZXI6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmU6aG92ZXI6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZDphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLmRpc2FibGVkOmhvdmVyOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZTpob3Zlci5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLmRpc2FibGVkLmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWQ6aG92ZXIuYWN0aXZlIHsKICBiYWNrZ3JvdW5kLWNvbG9yOiAjMzU3ZWJkOwogIGJvcmRlci1jb2xvcjogIzMwNDhhOTsKfQouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZDpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmU6aG92ZXIuZGlzYWJsZWQ6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLmRpc2FibGVkLmRpc2FibGVkOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZDpob3Zlci5kaXNhYmxlZDpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmVbZGlzYWJsZWRdOmhvdmVyLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZTpob3ZlcltkaXNhYmxlZF06aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLmRpc2FibGVkW2Rpc2FibGVkXTpob3ZlciwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWQ6aG92ZXJbZGlzYWJsZWRdOmhvdmVyLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmU6aG92ZXIsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZTpob3Zlcjpob3ZlciwKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLmRpc2FibGVkOmhvdmVyLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWQ6aG92ZXI6aG92ZXIsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLmRpc2FibGVkOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZTpob3Zlci5kaXNhYmxlZDpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWQuZGlzYWJsZWQ6Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLmRpc2FibGVkOmhvdmVyLmRpc2FibGVkOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZVtkaXNhYmxlZF06Zm9jdXMsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlOmhvdmVyW2Rpc2FibGVkXTpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWRbZGlzYWJsZWRdOmZvY3VzLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZDpob3ZlcltkaXNhYmxlZF06Zm9jdXMsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZTpmb2N1cywKZmllbGRzZXRbZGlzYWJsZWRdIC5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlOmhvdmVyOmZvY3VzLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWQ6Zm9jdXMsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZDpob3Zlcjpmb2N1cywKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWQ6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZTpob3Zlci5kaXNhYmxlZDphY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLmRpc2FibGVkLmRpc2FibGVkOmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWQ6aG92ZXIuZGlzYWJsZWQ6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZVtkaXNhYmxlZF06YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZTpob3ZlcltkaXNhYmxlZF06YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZFtkaXNhYmxlZF06YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZDpob3ZlcltkaXNhYmxlZF06YWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmU6YWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmU6aG92ZXI6YWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWQ6YWN0aXZlLApmaWVsZHNldFtkaXNhYmxlZF0gLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWQ6aG92ZXI6YWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZC5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlOmhvdmVyLmRpc2FibGVkLmFjdGl2ZSwKLmRhdGVwaWNrZXIgdGFibGUgdHIgdGQgc3Bhbi5hY3RpdmUuZGlzYWJsZWQuZGlzYWJsZWQuYWN0aXZlLAouZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZDpob3Zlci5kaXNhYmxlZC5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlW2Rpc2FibGVkXS5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlOmhvdmVyW2Rpc2FibGVkXS5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLmRpc2FibGVkW2Rpc2FibGVkXS5hY3RpdmUsCi5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4uYWN0aXZlLmRpc2FibGVkOmhvdmVyW2Rpc2FibGVkXS5hY3RpdmUsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5hY3RpdmUsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZTpob3Zlci5hY3RpdmUsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZC5hY3RpdmUsCmZpZWxkc2V0W2Rpc2FibGVkXSAuZGF0ZXBpY2tlciB0YWJsZSB0ciB0ZCBzcGFuLmFjdGl2ZS5kaXNhYmxlZDpob3Zlci5hY3RpdmUgewogIGJhY2tncm91bmQtY29sb3I6ICM0MjhiY2E7CiAgYm9yZGVyLWNvbG9yOiAjNDI1ZWNhOwp9Ci5kYXRlcGlja2VyIHRhYmxlIHRyIHRkIHNwYW4ub2xkIHsKICBjb2xvcjogIzk5OTk5OTsKfQouZGF0ZXBpY2tlciB0aC5kYXRlcGlja2VyLXN3aXRjaCB7CiAgd2lkdGg6IDE0NXB4Owp9Ci5kYXRlcGlja2VyIHRoZWFkIHRyOmZpcnN0LWNoaWxkIHRoLAouZGF0ZXBpY2tlciB0Zm9vdCB0cjpmaXJzdC1jaGlsZCB0aCB7CiAgY3Vyc29yOiBwb2ludGVyOwp9Ci5kYXRlcGlja2VyIHRoZWFkIHRyOmZpcnN0LWNoaWxkIHRoOmhvdmVyLAouZGF0ZXBpY2tlciB0Zm9vdCB0cjpmaXJzdC1jaGlsZCB0aDpob3ZlciB7CiAgYmFja2dyb3VuZDogI2VlZWVlZTsKfQouZGF0ZXBpY2tlciAuY3cgewogIGZvbnQtc2l6ZTogMTBweDsKICB3aWR0aDogMTJweDsKICBwYWRkaW5nOiAwIDJweCAwIDVweDsKICB2ZXJ0aWNhbC1hbGlnbjogbWlkZGxlOwp9Ci5kYXRlcGlja2VyIHRoZWFkIHRyOmZpcnN0LWNoaWxkIHRoLmN3IHsKICBjdXJzb3I6IGRlZmF1bHQ7CiAgYmFja2dyb3VuZC1jb2xvcjogdHJhbnNwYXJlbnQ7Cn0KLmlucHV0LWdyb3VwLmRhdGUgLmlucHV0LWdyb3VwLWFkZG9uIGkgewogIGRpc3BsYXk6IGJsb2NrOwogIGN1cnNvcjogcG9pbnRlcjsKICB3aWR0aDogMTZweDsKICBoZWlnaHQ6IDE2cHg7Cn0KLmlucHV0LWRhdGVyYW5nZSBpbnB1dCB7CiAgdGV4dC1hbGlnbjogY2VudGVyOwp9Ci5pbnB1dC1kYXRlcmFuZ2UgaW5wdXQ6Zmlyc3QtY2hpbGQgewogIC13ZWJraXQtYm9yZGVyLXJhZGl1czogM3B4IDAgMCAzcHg7CiAgLW1vei1ib3JkZXItcmFkaXVzOiAzcHggMCAwIDNweDsKICBib3JkZXItcmFkaXVzOiAzcHggMCAwIDNweDsKfQouaW5wdXQtZGF0ZXJhbmdlIGlucHV0Omxhc3QtY2hpbGQgewogIC13ZWJraXQtYm9yZGVyLXJhZGl1czogMCAzcHggM3B4IDA7CiAgLW1vei1ib3JkZXItcmFkaXVzOiAwIDNweCAzcHggMDsKICBib3JkZXItcmFkaXVzOiAwIDNweCAzcHggMDsKfQouaW5wdXQtZGF0ZXJhbmdlIC5pbnB1dC1ncm91cC1hZGRvbiB7CiAgZGlzcGxheTogaW5saW5lLWJsb2NrOwogIHdpZHRoOiBhdXRvOwogIG1pbi13aWR0aDogMTZweDsKICBoZWlnaHQ6IDIwcHg7CiAgcGFkZGluZzogNHB4IDVweDsKICBmb250LXdlaWdodDogbm9ybWFsOwogIGxpbmUtaGVpZ2h0OiAyMHB4OwogIHRleHQtYWxpZ246IGNlbnRlcjsKICB0ZXh0LXNoYWRvdzogMCAxcHggMCAjRkZGOwogIHZlcnRpY2FsLWFsaWduOiBtaWRkbGU7CiAgYmFja2dyb3VuZC1jb2xvcjogI2VlZWVlZTsKICBib3JkZXI6IDFweCBzb2xpZCAjY2NjOwogIG1hcmdpbi1sZWZ0OiAtNXB4OwogIG1hcmdpbi1yaWdodDogLTVweDsKfQ==",
-		"handler.go.tpl":              "cGFja2FnZSB7ey5wTmFtZX19CgppbXBvcnQgKAoJImJpdGJ1Y2tldC5vcmcvamF5YmlsbC9zYXdzaWovZnJhbWV3b3JrIgoJImJpdGJ1Y2tldC5vcmcvamF5YmlsbC9zYXdzaWovZnJhbWV3b3JrL21vZGVsIgkKCSJsb2cie3tpZiAuaW1wb3J0U3RyY29udn19Cgkic3RyY29udiJ7eyBlbmQgfX0KCSJuZXQvaHR0cCJ7e2lmIC5pbXBvcnRUaW1lfX0KCSJ0aW1lInt7IGVuZCB9fXt7aWYgLmltcG9ydEZtdH19CQoJImZtdCJ7eyBlbmQgfX0KKQoKdHlwZSB7ey50eXBlTmFtZX19IHN0cnVjdCB7IHt7IHJhbmdlICRmaWVsZCA6PSAuc3RydWN0IH19Cgl7eyRmaWVsZC5GTmFtZX19IHt7IGlmICRmaWVsZC5DYW5CZU51bGx9fSp7eyBlbmQgfX17eyRmaWVsZC5GVHlwZX19e3sgZW5kIH19IAp9CgoKZnVuYyB7ey50eXBlTmFtZX19Um91dGVzKHJnIG1hcFtzdHJpbmddW11pbnQpIHsKCglmcmFtZXdvcmsuUm91dGUoZnJhbWV3b3JrLlJvdXRlQ29uZmlne1BhdHRlcm46ICIvYWRtaW4ve3sudHlwZVZhcn19IiwgSGFuZGxlcjoge3sudHlwZU5hbWV9fUFkbWluTGlzdEhhbmRsZXIsIFJvbGVzOiByZ1siYWRtaW4iXX0pCglmcmFtZXdvcmsuUm91dGUoZnJhbWV3b3JrLlJvdXRlQ29uZmlne1BhdHRlcm46ICIvYWRtaW4ve3sudHlwZVZhcn19L2VkaXQiLCBIYW5kbGVyOiB7ey50eXBlTmFtZX19QWRtaW5FZGl0SGFuZGxlciwgUm9sZXM6IHJnWyJhZG1pbiJdfSkKCWZyYW1ld29yay5Sb3V0ZShmcmFtZXdvcmsuUm91dGVDb25maWd7UGF0dGVybjogIi9hZG1pbi97ey50eXBlVmFyfX0vZGVsZXRlIiwgSGFuZGxlcjoge3sudHlwZU5hbWV9fUFkbWluRGVsZXRlSGFuZGxlciwgUm9sZXM6IHJnWyJhZG1pbiJdfSkKCn0KCmZ1bmMgKG8gKnt7LnR5cGVOYW1lfX0pIEdldFZhbGlkYXRpb25FcnJvcnMoYSAqZnJhbWV3b3JrLkFwcFNjb3BlKSAoZXJyb3JzIFtdc3RyaW5nKSB7CgkvLyBBZGQgdmFsaWRhdGlvbiBoZXJlCgoJcmV0dXJuCn0KCmZ1bmMge3sudHlwZU5hbWV9fUFkbWluRWRpdEhhbmRsZXIociAqaHR0cC5SZXF1ZXN0LCBhICpmcmFtZXdvcmsuQXBwU2NvcGUsIHJzICpmcmFtZXdvcmsuUmVxdWVzdFNjb3BlKSAoaCBmcmFtZXdvcmsuSGFuZGxlclJlc3BvbnNlLCBlcnIgZXJyb3IpIHsKCWguSW5pdCgpCgkKCXQgOj0gJm1vZGVsLlRhYmxle0RiOiBhLkRifQoJe3sudHlwZVZhcn19IDo9ICZ7ey50eXBlTmFtZX19e30KCgl7ey50eXBlVmFyfX0uSWQgPSBmcmFtZXdvcmsuR2V0SW50SWQocnMuVXJsUGFyYW1NYXBbImlkIl0pCglpZiB7ey50eXBlVmFyfX0uSWQgIT0gLTEgewoJCWVyciA9IHQuRmV0Y2goe3sudHlwZVZhcn19KQoJCWlmIGVyciAhPSBuaWwgewoJCQlsb2cuUHJpbnQoZXJyKQoJCQloLlJlZGlyZWN0ID0gIi9lcnJvciIKCQkJcmV0dXJuCgkJfSBlbHNlIHsKCQkJaC5WaWV3WyJ7ey50eXBlVmFyfX0iXSA9IHt7LnR5cGVWYXJ9fQoJCX0KCX0KCglpZiByLk1ldGhvZCA9PSAiUE9TVCIgewoJCXt7IHJhbmdlICRmaWVsZCA6PSAuc3RydWN0IH19e3sgaWYgJGZpZWxkLklzUGsgfX17eyBlbHNlIH19e3tpZiBlcSAkZmllbGQuRGlzcGxheVR5cGUgInRpbWVzdGFtcCJ9fS8vIFRpbWVzdGFtcCBmaWVsZCBzZXQgdG8gY3VycmVudCB0aW1lLiBUaGlzIG1pZ2h0IG5vdCBiZSB3aGF0IHlvdSB3YW50LgoJCXR7eyRmaWVsZC5GTmFtZX19IDo9IHRpbWUuTm93KCkKCQl7eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gPSB7eyBpZiAkZmllbGQuQ2FuQmVOdWxsIH19Jnt7IGVuZH19dHt7JGZpZWxkLkZOYW1lfX17e2VuZH19CgkJe3tpZiBlcSAkZmllbGQuRlR5cGUgInN0cmluZyJ9fXR7eyRmaWVsZC5GTmFtZX19IDo9IHIuRm9ybVZhbHVlKCJ7eyRmaWVsZC5GTmFtZX19IikKCQl7eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gPSB7eyBpZiAkZmllbGQuQ2FuQmVOdWxsIH19Jnt7IGVuZH19dHt7JGZpZWxkLkZOYW1lfX17e2VuZH19CgkJe3tpZiBlcSAkZmllbGQuRlR5cGUgImludDY0In19dHt7JGZpZWxkLkZOYW1lfX0sXyA6PSBzdHJjb252LlBhcnNlSW50KHIuRm9ybVZhbHVlKCJ7eyRmaWVsZC5GTmFtZX19IiksMTAsMCkKCQl7eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gPSB7eyBpZiAkZmllbGQuQ2FuQmVOdWxsIH19Jnt7IGVuZH19dHt7JGZpZWxkLkZOYW1lfX17e2VuZH19CgkJe3tpZiBlcSAkZmllbGQuRlR5cGUgImZsb2F0NjQifX10e3skZmllbGQuRk5hbWV9fSxfIDo9IHN0cmNvbnYuUGFyc2VGbG9hdChyLkZvcm1WYWx1ZSgie3skZmllbGQuRk5hbWV9fSIpLDY0KQoJCXt7JC50eXBlVmFyfX0ue3skZmllbGQuRk5hbWV9fSA9IHt7IGlmICRmaWVsZC5DYW5CZU51bGwgfX0me3sgZW5kfX10e3skZmllbGQuRk5hbWV9fXt7ZW5kfX0JCQoJCXt7aWYgZXEgJGZpZWxkLkZUeXBlICJib29sIn19dmFyIHR7eyRmaWVsZC5GTmFtZX19IGJvb2wKCQl0e3skZmllbGQuRk5hbWV9fSxlcnIgPSBzdHJjb252LlBhcnNlQm9vbChyLkZvcm1WYWx1ZSgie3skZmllbGQuRk5hbWV9fSIpKQoJCWlmIGVyciAhPSBuaWx7CgkJCXR7eyRmaWVsZC5GTmFtZX19ID0gZmFsc2U7CgkJfQoJCXt7JC50eXBlVmFyfX0ue3skZmllbGQuRk5hbWV9fSA9IHt7IGlmICRmaWVsZC5DYW5CZU51bGwgfX0me3sgZW5kfX10e3skZmllbGQuRk5hbWV9fXt7ZW5kfX0KCQl7eyBpZiBlcSAkZmllbGQuRGlzcGxheVR5cGUgImRhdGUifX0JCQoJCXRze3skZmllbGQuRk5hbWV9fSA6PSByLkZvcm1WYWx1ZSgie3skZmllbGQuRk5hbWV9fSIpCgkJaWYgdHN7eyRmaWVsZC5GTmFtZX19ICE9ICIiewoJCQl0e3skZmllbGQuRk5hbWV9fSwgXyA6PSB0aW1lLlBhcnNlKCIwMS8wMi8yMDA2Iix0c3t7JGZpZWxkLkZOYW1lfX0pCQkJCgkJCXt7JC50eXBlVmFyfX0ue3skZmllbGQuRk5hbWV9fSA9IHt7IGlmICRmaWVsZC5DYW5CZU51bGwgfX0me3sgZW5kfX10e3skZmllbGQuRk5hbWV9fQkJCQkJCQkKCQl9e3sgZW5kIH19e3sgZW5kIH19e3sgZW5kIH19CgkJZXJyb3JzIDo9IHt7LnR5cGVWYXJ9fS5HZXRWYWxpZGF0aW9uRXJyb3JzKGEpCgkJaWYgbGVuKGVycm9ycykgPT0gMCB7CgkJCWlmIHt7LnR5cGVWYXJ9fS5JZCA9PSAtMSB7CgkJCQkvLyBUaGlzIGlzIGFuIGluc2VydAoKCQkJCWVyciA9IHQuSW5zZXJ0KHt7LnR5cGVWYXJ9fSkKCgkJCQlpZiBlcnIgIT0gbmlsIHsKCQkJCQlsb2cuUHJpbnQoZXJyKQoJCQkJCWguUmVkaXJlY3QgPSAiL2Vycm9yIgoJCQkJCXJldHVybiBoLGVycgoJCQkJfSBlbHNlIHsKCQkJCQloLlZpZXdbInN1Y2Nlc3MiXSA9ICJSZWNvcmQgY3JlYXRlZC4iCgkJCQl9CgkJCX0gZWxzZSB7CgkJCQkvLyBUaGlzIGlzIGFuIHVwZGF0ZQoJCQkJZXJyID0gdC5VcGRhdGUoe3sudHlwZVZhcn19KQoJCQkJaWYgZXJyICE9IG5pbCB7CgkJCQkJbG9nLlByaW50KGVycikKCQkJCQloLlJlZGlyZWN0ID0gIi9lcnJvciIKCQkJCQlyZXR1cm4gaCxlcnIKCQkJCX0gZWxzZSB7CgkJCQkJaC5WaWV3WyJzdWNjZXNzIl0gPSAiUmVjb3JkIHVwZGF0ZWQuIgoJCQkJfQoKCQkJfQoKCQl9IGVsc2UgewoJCQloLlZpZXdbImVycm9ycyJdID0gZXJyb3JzCgkJfQoKCQkvLyBQYXNzIGJhY2sgbWFyc2hhbGVkIHN0cnVjdCwgZXZlbiBpZiBpdCBpc24ndCB2YWxpZCwgdG8gYWxsb3cgY29ycmVjdGlvbiBvZiBtaXN0YWtlcy4KCQloLlZpZXdbInt7LnR5cGVWYXJ9fSJdID0ge3sudHlwZVZhcn19CgkJCgl9CglpZiB7ey50eXBlVmFyfX0uSWQgIT0gLTEgewoJCWguVmlld1sidXBkYXRlIl0gPSB0cnVlCgl9CglyZXR1cm4KfQoKZnVuYyB7ey50eXBlTmFtZX19QWRtaW5MaXN0SGFuZGxlcihyICpodHRwLlJlcXVlc3QsIGEgKmZyYW1ld29yay5BcHBTY29wZSwgcnMgKmZyYW1ld29yay5SZXF1ZXN0U2NvcGUpIChoIGZyYW1ld29yay5IYW5kbGVyUmVzcG9uc2UsIGVyciBlcnJvcikgewoJaC5Jbml0KCkKCgl0IDo9ICZtb2RlbC5UYWJsZXtEYjogYS5EYn0KCXt7LnR5cGVWYXJ9fSA6PSAme3sudHlwZU5hbWV9fXt9CglxIDo9IG1vZGVsLlF1ZXJ5e30KCXEuT3JkZXIgPSBtb2RlbC5NYWtlRGJOYW1lKCJJZCIpCgl7ey50eXBlVmFyfX1zLCBlcnIgOj0gdC5GZXRjaEFsbCh7ey50eXBlVmFyfX0sIHEpCglpZiBlcnIgPT0gbmlsIHsKCQloLlZpZXdbInt7LnR5cGVWYXJ9fXMiXSA9IHt7LnR5cGVWYXJ9fXMKCX0gZWxzZSB7CgkJaC5SZWRpcmVjdCA9ICIvZXJyb3IiCgl9CgoJcmV0dXJuCn0KCmZ1bmMge3sudHlwZU5hbWV9fUFkbWluRGVsZXRlSGFuZGxlcihyICpodHRwLlJlcXVlc3QsIGEgKmZyYW1ld29yay5BcHBTY29wZSwgcnMgKmZyYW1ld29yay5SZXF1ZXN0U2NvcGUpIChoIGZyYW1ld29yay5IYW5kbGVyUmVzcG9uc2UsIGVyciBlcnJvcikgewoJaC5Jbml0KCkKCgl0IDo9ICZtb2RlbC5UYWJsZXtEYjogYS5EYn0KCXt7LnR5cGVWYXJ9fSA6PSAme3sudHlwZU5hbWV9fXt9CgoJe3sudHlwZVZhcn19LklkID0gZnJhbWV3b3JrLkdldEludElkKHJzLlVybFBhcmFtTWFwWyJpZCJdKQoJaWYge3sudHlwZVZhcn19LklkICE9IC0xIHsKCQllcnIgPSB0LkZldGNoKHt7LnR5cGVWYXJ9fSkKCQlpZiBlcnIgIT0gbmlsIHsKCQkJbG9nLlByaW50KGVycikKCQkJaC5SZWRpcmVjdCA9ICIvZXJyb3IiCgkJCXJldHVybgoJCX0gZWxzZSB7CgkJCWguVmlld1sie3sudHlwZVZhcn19Il0gPSB7ey50eXBlVmFyfX0KCQl9Cgl9IGVsc2UgewoJCWxvZy5QcmludCgiRGVsZXRlIHt7LnR5cGVWYXJ9fSBjYWxsZWQgd2l0aG91dCB7ey50eXBlVmFyfX0gaWQuIikKCQloLlJlZGlyZWN0ID0gIi9lcnJvciIKCQlyZXR1cm4KCX0KCgloLlZpZXdbInt7LnR5cGVWYXJ9fSJdID0ge3sudHlwZVZhcn19CgoJaWYgci5NZXRob2QgPT0gIlBPU1QiIHsKCQl0LkRlbGV0ZSh7ey50eXBlVmFyfX0pCgkJaC5SZWRpcmVjdCA9ICIvYWRtaW4ve3sudHlwZVZhcn19IgoJfQoKCXJldHVybgp9Cg==",
+		"handler.go.tpl":              "cGFja2FnZSB7ey5wTmFtZX19CgppbXBvcnQgKAoJImJpdGJ1Y2tldC5vcmcvamF5YmlsbC9zYXdzaWovZnJhbWV3b3JrIgoJImJpdGJ1Y2tldC5vcmcvamF5YmlsbC9zYXdzaWovZnJhbWV3b3JrL21vZGVsInt7aWYgLmltcG9ydFN0cmNvbnZ9fQoJInN0cmNvbnYie3sgZW5kIH19CgkibmV0L2h0dHAie3tpZiAuaW1wb3J0VGltZX19CgkidGltZSJ7eyBlbmQgfX17e2lmIC5pbXBvcnRGbXR9fQkKCSJmbXQie3sgZW5kIH19CikKCnR5cGUge3sudHlwZU5hbWV9fSBzdHJ1Y3QgeyB7eyByYW5nZSAkZmllbGQgOj0gLnN0cnVjdCB9fQoJe3skZmllbGQuRk5hbWV9fSB7eyBpZiAkZmllbGQuQ2FuQmVOdWxsfX0qe3sgZW5kIH19e3skZmllbGQuRlR5cGV9fXt7IGVuZCB9fSAKfQoKCi8vIHt7LnR5cGVOYW1lfX1Sb3V0ZXMgc2V0cyB1cCB0aGUgYWRtaW4gcGFnZXMgZm9yIHt7LnR5cGVOYW1lfX0gYW5kIGdpdmVzIGFkbWlucyB0aGUgcGVybWlzc2lvbnMgdGhleSBuZWVkIHRvIHVzZSB0aGVtLgpmdW5jIHt7LnR5cGVOYW1lfX1Sb3V0ZXMoYXMgKmZyYW1ld29yay5BcHBTZXR1cCkgewoKCWFzLkdyYW50KFJfQURNSU4sICJ7ey50eXBlVmFyfX0udmlldyIsICJ7ey50eXBlVmFyfX0uZWRpdCIsICJ7ey50eXBlVmFyfX0uZGVsZXRlIikKCglmcmFtZXdvcmsuUm91dGUoZnJhbWV3b3JrLlJvdXRlQ29uZmlne1BhdHRlcm46ICIvYWRtaW4ve3sudHlwZVZhcn19IiwgSGFuZGxlcjoge3sudHlwZU5hbWV9fUFkbWluTGlzdEhhbmRsZXIsIFBlcm1pc3Npb246ICJ7ey50eXBlVmFyfX0udmlldyJ9KQoJZnJhbWV3b3JrLlJvdXRlKGZyYW1ld29yay5Sb3V0ZUNvbmZpZ3tQYXR0ZXJuOiAiL2FkbWluL3t7LnR5cGVWYXJ9fS9lZGl0IiwgTWV0aG9kczogW11zdHJpbmd7IkdFVCIsICJQT1NUIn0sIEhhbmRsZXI6IHt7LnR5cGVOYW1lfX1BZG1pbkVkaXRIYW5kbGVyLCBQZXJtaXNzaW9uOiAie3sudHlwZVZhcn19LmVkaXQifSkKCWZyYW1ld29yay5Sb3V0ZShmcmFtZXdvcmsuUm91dGVDb25maWd7UGF0dGVybjogIi9hZG1pbi97ey50eXBlVmFyfX0vZGVsZXRlIiwgTWV0aG9kczogW11zdHJpbmd7IkdFVCIsICJQT1NUIn0sIEhhbmRsZXI6IHt7LnR5cGVOYW1lfX1BZG1pbkRlbGV0ZUhhbmRsZXIsIFBlcm1pc3Npb246ICJ7ey50eXBlVmFyfX0uZGVsZXRlIn0pCgp9CgpmdW5jIChvICp7ey50eXBlTmFtZX19KSBHZXRWYWxpZGF0aW9uRXJyb3JzKGEgKmZyYW1ld29yay5BcHBTY29wZSkgKGVycm9ycyBbXXN0cmluZykgewoJLy8gQWRkIHZhbGlkYXRpb24gaGVyZQoKCXJldHVybgp9CgpmdW5jIHt7LnR5cGVOYW1lfX1BZG1pbkVkaXRIYW5kbGVyKHIgKmh0dHAuUmVxdWVzdCwgYSAqZnJhbWV3b3JrLkFwcFNjb3BlLCBycyAqZnJhbWV3b3JrLlJlcXVlc3RTY29wZSkgKGggZnJhbWV3b3JrLkhhbmRsZXJSZXNwb25zZSwgZXJyIGVycm9yKSB7CgloLkluaXQoKQoJCgl0IDo9ICZtb2RlbC5UYWJsZXtEYjogYS5EYn0KCXt7LnR5cGVWYXJ9fSA6PSAme3sudHlwZU5hbWV9fXt9CgoJe3sudHlwZVZhcn19LklkID0gZnJhbWV3b3JrLkdldEludElkKHJzLlVybFBhcmFtTWFwWyJpZCJdKQoJaWYge3sudHlwZVZhcn19LklkICE9IC0xIHsKCQllcnIgPSB0LkZldGNoKHt7LnR5cGVWYXJ9fSkKCQlpZiBlcnIgIT0gbmlsIHsKCQkJYS5Mb2cuRXJyb3IoIkRhdGFiYXNlIGVycm9yIiwgImVycm9yIiwgZXJyKQoJCQloLlJlZGlyZWN0ID0gIi9lcnJvciIKCQkJcmV0dXJuCgkJfSBlbHNlIHsKCQkJaC5WaWV3WyJ7ey50eXBlVmFyfX0iXSA9IHt7LnR5cGVWYXJ9fQoJCX0KCX0KCglpZiByLk1ldGhvZCA9PSAiUE9TVCIgewoJCXt7IHJhbmdlICRmaWVsZCA6PSAuc3RydWN0IH19e3sgaWYgJGZpZWxkLklzUGsgfX17eyBlbHNlIH19e3tpZiBlcSAkZmllbGQuRGlzcGxheVR5cGUgInRpbWVzdGFtcCJ9fS8vIFRpbWVzdGFtcCBmaWVsZCBzZXQgdG8gY3VycmVudCB0aW1lLiBUaGlzIG1pZ2h0IG5vdCBiZSB3aGF0IHlvdSB3YW50LgoJCXR7eyRmaWVsZC5GTmFtZX19IDo9IHRpbWUuTm93KCkKCQl7eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gPSB7eyBpZiAkZmllbGQuQ2FuQmVOdWxsIH19Jnt7IGVuZH19dHt7JGZpZWxkLkZOYW1lfX17e2VuZH19CgkJe3tpZiBlcSAkZmllbGQuRlR5cGUgInN0cmluZyJ9fXR7eyRmaWVsZC5GTmFtZX19IDo9IHIuRm9ybVZhbHVlKCJ7eyRmaWVsZC5GTmFtZX19IikKCQl7eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gPSB7eyBpZiAkZmllbGQuQ2FuQmVOdWxsIH19Jnt7IGVuZH19dHt7JGZpZWxkLkZOYW1lfX17e2VuZH19CgkJe3tpZiBlcSAkZmllbGQuRlR5cGUgImludDY0In19dHt7JGZpZWxkLkZOYW1lfX0sXyA6PSBzdHJjb252LlBhcnNlSW50KHIuRm9ybVZhbHVlKCJ7eyRmaWVsZC5GTmFtZX19IiksMTAsMCkKCQl7eyQudHlwZVZhcn19Lnt7JGZpZWxkLkZOYW1lfX0gPSB7eyBpZiAkZmllbGQuQ2FuQmVOdWxsIH19Jnt7IGVuZH19dHt7JGZpZWxkLkZOYW1lfX17e2VuZH19CgkJe3tpZiBlcSAkZmllbGQuRlR5cGUgImZsb2F0NjQifX10e3skZmllbGQuRk5hbWV9fSxfIDo9IHN0cmNvbnYuUGFyc2VGbG9hdChyLkZvcm1WYWx1ZSgie3skZmllbGQuRk5hbWV9fSIpLDY0KQoJCXt7JC50eXBlVmFyfX0ue3skZmllbGQuRk5hbWV9fSA9IHt7IGlmICRmaWVsZC5DYW5CZU51bGwgfX0me3sgZW5kfX10e3skZmllbGQuRk5hbWV9fXt7ZW5kfX0JCQoJCXt7aWYgZXEgJGZpZWxkLkZUeXBlICJib29sIn19dmFyIHR7eyRmaWVsZC5GTmFtZX19IGJvb2wKCQl0e3skZmllbGQuRk5hbWV9fSxlcnIgPSBzdHJjb252LlBhcnNlQm9vbChyLkZvcm1WYWx1ZSgie3skZmllbGQuRk5hbWV9fSIpKQoJCWlmIGVyciAhPSBuaWx7CgkJCXR7eyRmaWVsZC5GTmFtZX19ID0gZmFsc2U7CgkJfQoJCXt7JC50eXBlVmFyfX0ue3skZmllbGQuRk5hbWV9fSA9IHt7IGlmICRmaWVsZC5DYW5CZU51bGwgfX0me3sgZW5kfX10e3skZmllbGQuRk5hbWV9fXt7ZW5kfX0KCQl7eyBpZiBlcSAkZmllbGQuRGlzcGxheVR5cGUgImRhdGUifX0JCQoJCXRze3skZmllbGQuRk5hbWV9fSA6PSByLkZvcm1WYWx1ZSgie3skZmllbGQuRk5hbWV9fSIpCgkJaWYgdHN7eyRmaWVsZC5GTmFtZX19ICE9ICIiewoJCQl0e3skZmllbGQuRk5hbWV9fSwgXyA6PSB0aW1lLlBhcnNlKCIwMS8wMi8yMDA2Iix0c3t7JGZpZWxkLkZOYW1lfX0pCQkJCgkJCXt7JC50eXBlVmFyfX0ue3skZmllbGQuRk5hbWV9fSA9IHt7IGlmICRmaWVsZC5DYW5CZU51bGwgfX0me3sgZW5kfX10e3skZmllbGQuRk5hbWV9fQkJCQkJCQkKCQl9e3sgZW5kIH19e3sgZW5kIH19e3sgZW5kIH19CgkJZXJyb3JzIDo9IHt7LnR5cGVWYXJ9fS5HZXRWYWxpZGF0aW9uRXJyb3JzKGEpCgkJaWYgbGVuKGVycm9ycykgPT0gMCB7CgkJCWlmIHt7LnR5cGVWYXJ9fS5JZCA9PSAtMSB7CgkJCQkvLyBUaGlzIGlzIGFuIGluc2VydAoKCQkJCWVyciA9IHQuSW5zZXJ0KHt7LnR5cGVWYXJ9fSkKCgkJCQlpZiBlcnIgIT0gbmlsIHsKCQkJCQlhLkxvZy5FcnJvcigiRGF0YWJhc2UgZXJyb3IiLCAiZXJyb3IiLCBlcnIpCgkJCQkJaC5SZWRpcmVjdCA9ICIvZXJyb3IiCgkJCQkJcmV0dXJuIGgsZXJyCgkJCQl9IGVsc2UgewoJCQkJCWguVmlld1sic3VjY2VzcyJdID0gIlJlY29yZCBjcmVhdGVkLiIKCQkJCX0KCQkJfSBlbHNlIHsKCQkJCS8vIFRoaXMgaXMgYW4gdXBkYXRlCgkJCQllcnIgPSB0LlVwZGF0ZSh7ey50eXBlVmFyfX0pCgkJCQlpZiBlcnIgIT0gbmlsIHsKCQkJCQlhLkxvZy5FcnJvcigiRGF0YWJhc2UgZXJyb3IiLCAiZXJyb3IiLCBlcnIpCgkJCQkJaC5SZWRpcmVjdCA9ICIvZXJyb3IiCgkJCQkJcmV0dXJuIGgsZXJyCgkJCQl9IGVsc2UgewoJCQkJCWguVmlld1sic3VjY2VzcyJdID0gIlJlY29yZCB1cGRhdGVkLiIKCQkJCX0KCgkJCX0KCgkJfSBlbHNlIHsKCQkJaC5WaWV3WyJlcnJvcnMiXSA9IGVycm9ycwoJCX0KCgkJLy8gUGFzcyBiYWNrIG1hcnNoYWxlZCBzdHJ1Y3QsIGV2ZW4gaWYgaXQgaXNuJ3QgdmFsaWQsIHRvIGFsbG93IGNvcnJlY3Rpb24gb2YgbWlzdGFrZXMuCgkJaC5WaWV3WyJ7ey50eXBlVmFyfX0iXSA9IHt7LnR5cGVWYXJ9fQoJCQoJfQoJaWYge3sudHlwZVZhcn19LklkICE9IC0xIHsKCQloLlZpZXdbInVwZGF0ZSJdID0gdHJ1ZQoJfQoJcmV0dXJuCn0KCmZ1bmMge3sudHlwZU5hbWV9fUFkbWluTGlzdEhhbmRsZXIociAqaHR0cC5SZXF1ZXN0LCBhICpmcmFtZXdvcmsuQXBwU2NvcGUsIHJzICpmcmFtZXdvcmsuUmVxdWVzdFNjb3BlKSAoaCBmcmFtZXdvcmsuSGFuZGxlclJlc3BvbnNlLCBlcnIgZXJyb3IpIHsKCWguSW5pdCgpCgoJdCA6PSAmbW9kZWwuVGFibGV7RGI6IGEuRGJ9Cgl7ey50eXBlVmFyfX0gOj0gJnt7LnR5cGVOYW1lfX17fQoJcSA6PSBtb2RlbC5RdWVyeXt9CglxLk9yZGVyID0gbW9kZWwuTWFrZURiTmFtZSgiSWQiKQoJe3sudHlwZVZhcn19cywgZXJyIDo9IHQuRmV0Y2hBbGwoe3sudHlwZVZhcn19LCBxKQoJaWYgZXJyID09IG5pbCB7CgkJaC5WaWV3WyJ7ey50eXBlVmFyfX1zIl0gPSB7ey50eXBlVmFyfX1zCgl9IGVsc2UgewoJCWguUmVkaXJlY3QgPSAiL2Vycm9yIgoJfQoKCXJldHVybgp9CgpmdW5jIHt7LnR5cGVOYW1lfX1BZG1pbkRlbGV0ZUhhbmRsZXIociAqaHR0cC5SZXF1ZXN0LCBhICpmcmFtZXdvcmsuQXBwU2NvcGUsIHJzICpmcmFtZXdvcmsuUmVxdWVzdFNjb3BlKSAoaCBmcmFtZXdvcmsuSGFuZGxlclJlc3BvbnNlLCBlcnIgZXJyb3IpIHsKCWguSW5pdCgpCgoJdCA6PSAmbW9kZWwuVGFibGV7RGI6IGEuRGJ9Cgl7ey50eXBlVmFyfX0gOj0gJnt7LnR5cGVOYW1lfX17fQoKCXt7LnR5cGVWYXJ9fS5JZCA9IGZyYW1ld29yay5HZXRJbnRJZChycy5VcmxQYXJhbU1hcFsiaWQiXSkKCWlmIHt7LnR5cGVWYXJ9fS5JZCAhPSAtMSB7CgkJZXJyID0gdC5GZXRjaCh7ey50eXBlVmFyfX0pCgkJaWYgZXJyICE9IG5pbCB7CgkJCWEuTG9nLkVycm9yKCJEYXRhYmFzZSBlcnJvciIsICJlcnJvciIsIGVycikKCQkJaC5SZWRpcmVjdCA9ICIvZXJyb3IiCgkJCXJldHVybgoJCX0gZWxzZSB7CgkJCWguVmlld1sie3sudHlwZVZhcn19Il0gPSB7ey50eXBlVmFyfX0KCQl9Cgl9IGVsc2UgewoJCWEuTG9nLldhcm4oIkRlbGV0ZSB7ey50eXBlVmFyfX0gY2FsbGVkIHdpdGhvdXQge3sudHlwZVZhcn19IGlkLiIpCgkJaC5SZWRpcmVjdCA9ICIvZXJyb3IiCgkJcmV0dXJuCgl9CgoJaC5WaWV3WyJ7ey50eXBlVmFyfX0iXSA9IHt7LnR5cGVWYXJ9fQoKCWlmIHIuTWV0aG9kID09ICJQT1NUIiB7CgkJdC5EZWxldGUoe3sudHlwZVZhcn19KQoJCWguUmVkaXJlY3QgPSAiL2FkbWluL3t7LnR5cGVWYXJ9fSIKCX0KCglyZXR1cm4KfQo=",
 		"sawsij.js":                   "KGZ1bmN0aW9uKHNhd3NpaiwgJCwgdW5kZWZpbmVkKSB7CgoJJChmdW5jdGlvbigpIHsKCQl3aXJlQ2xpY2tSb3dzKCk7CiAgICB9KTsKCgoJZnVuY3Rpb24gd2lyZUNsaWNrUm93cygpewoJCSQoInRhYmxlLnRhYmxlLWNsaWNrcm93cyIpLmVhY2goZnVuY3Rpb24oKXsKCQkJJCh0aGlzKS5maW5kKCJ0ciIpLmVhY2goZnVuY3Rpb24oKXsKCQkJCXZhciBsaW5rID0gJCh0aGlzKS5maW5kKCdhJykuZmlyc3QoKTsKCQkJCWlmKHR5cGVvZiBsaW5rLmF0dHIoJ2hyZWYnKSAhPSAidW5kZWZpbmVkIil7CgkJCQkJbGluay5jbGljayhmdW5jdGlvbihlKXsKCQkJCQkJZS5wcmV2ZW50RGVmYXVsdCgpOwoJCQkJCX0pOwkJCQkJCgkJCQl9CgkJCQkkKHRoaXMpLmNsaWNrKGZ1bmN0aW9uKCl7CgkJCQkJd2luZG93LmxvY2F0aW9uID0gbGluay5hdHRyKCdocmVmJyk7CgkJCQl9KTsKCQkJfSk7CgkJfSk7Cgl9CgoKCSQoJy5kYXRlcGlja2VyJykuZGF0ZXBpY2tlcigpOwoKfSh3aW5kb3cuc2F3c2lqID0gd2luZG93LnNhd3NpaiB8fCB7fSwgalF1ZXJ5KSk7",
 		"site.css":                    "LyogU2l0ZSBzcGVjaWZpYyBzdHlsZXMuICovCgpib2R5IHsgcGFkZGluZy10b3A6IDcwcHg7IH0=",
 	}
@@ -21,29 +21,27 @@ func GetStaticResources() (r map[string]string) {
 func GetTemplateResources() (r map[string]string) {
 
 	r = map[string]string{
-		"admin-footer.html.tpl":       "ICA8L2Rpdj48IS0tIC8uY29udGFpbmVyIC0tPgogIDxzY3JpcHQgc3JjPSIvL2FqYXguZ29vZ2xlYXBpcy5jb20vYWpheC9saWJzL2pxdWVyeS8yLjAuMy9qcXVlcnkubWluLmpzIj48L3NjcmlwdD4gIAogIDxzY3JpcHQgc3JjPSIvL25ldGRuYS5ib290c3RyYXBjZG4uY29tL2Jvb3RzdHJhcC8zLjAuMC13aXAvanMvYm9vdHN0cmFwLm1pbi5qcyI+PC9zY3JpcHQ+CiAgPHNjcmlwdCBzcmM9Ii9zdGF0aWMvanMvYm9vdHN0cmFwLWRhdGVwaWNrZXIubWluLmpzIj48L3NjcmlwdD4gIAogIDxzY3JpcHQgdHlwZT0idGV4dC9qYXZhc2NyaXB0IiBzcmM9Imh0dHBzOi8vd3d3Lmdvb2dsZS5jb20vanNhcGkiPjwvc2NyaXB0PgogIDxzY3JpcHQgc3JjPSIvc3RhdGljL2pzL3Nhd3Npai5qcyI+PC9zY3JpcHQ+ICAKICA8ISAtLSBQZXIgcGFnZSBzY3JpcHRzIC0tPgogIDwlIGlmIGVxdWFsIC5nbG9iYWwudXJsICIvYWRtaW4iICU+CiAgPHNjcmlwdCBzcmM9Ii9zdGF0aWMvanMvYWRtaW4tZGFzaGJvYXJkLmpzIj48L3NjcmlwdD4gIAogIDwlIGVuZCAlPgogIDwvYm9keT4KPC9odG1sPg==",
-		"admin-header.html.tpl":       "PCFET0NUWVBFIGh0bWw+CjxodG1sIGxhbmc9ImVuIj4KICA8aGVhZD4KICAgIDxtZXRhIGNoYXJzZXQ9InV0Zi04Ij4KICAgIDxtZXRhIG5hbWU9InZpZXdwb3J0IiBjb250ZW50PSJ3aWR0aD1kZXZpY2Utd2lkdGgsIGluaXRpYWwtc2NhbGU9MS4wIj4KICAgIDxtZXRhIG5hbWU9ImRlc2NyaXB0aW9uIiBjb250ZW50PSIiPgogICAgPG1ldGEgbmFtZT0iYXV0aG9yIiBjb250ZW50PSIiPgoKICAgIDx0aXRsZT57ey5uYW1lfX0gQWRtaW48L3RpdGxlPgoKICAgIDwhLS0gQm9vdHN0cmFwIGNvcmUgQ1NTIC0tPgogICAgIDxsaW5rIHJlbD0ic3R5bGVzaGVldCIgaHJlZj0iLy9uZXRkbmEuYm9vdHN0cmFwY2RuLmNvbS9ib290c3RyYXAvMy4wLjAtd2lwL2Nzcy9ib290c3RyYXAubWluLmNzcyI+CgogICAgPCEtLSBDdXN0b20gc3R5bGVzIGZvciB0aGlzIHRlbXBsYXRlIC0tPgogICAgPGxpbmsgaHJlZj0iL3N0YXRpYy9jc3MvYWRtaW4uY3NzIiByZWw9InN0eWxlc2hlZXQiPgogICAgPGxpbmsgaHJlZj0iL3N0YXRpYy9jc3MvZGF0ZXBpY2tlci5jc3MiIHJlbD0ic3R5bGVzaGVldCI+CiAgPC9oZWFkPgoKICA8Ym9keT4KCiAgPGRpdiBjbGFzcz0ibmF2YmFyIG5hdmJhci1kZWZhdWx0IG5hdmJhci1maXhlZC10b3AiPgogICAgPGRpdiBjbGFzcz0ibmF2YmFyLWhlYWRlciI+CiAgICAgIDxhIGNsYXNzPSJuYXZiYXItYnJhbmQiIGhyZWY9Ii9hZG1pbiI+e3submFtZX19IEFkbWluPC9hPiAgICAgIAogICAgPC9kaXY+CiAgICA8dWwgY2xhc3M9Im5hdiBuYXZiYXItbmF2Ij4gICAgICAKICAgICAgPGxpIDwlIGlmIGVxdWFsIC5nbG9iYWwudXJsICIvYWRtaW4iICU+Y2xhc3M9ImFjdGl2ZSI8JSBlbmQgJT4+PGEgaHJlZj0iL2FkbWluIj5EYXNoYm9hcmQ8L2E+PC9saT4gICAKICAgICAgPGxpIDwlIGlmIGVxdWFsIC5nbG9iYWwudXJsICIvYWRtaW4vdXNlcnMiICU+Y2xhc3M9ImFjdGl2ZSI8JSBlbmQgJT4+PGEgaHJlZj0iL2FkbWluL3VzZXJzIj5Vc2VyczwvYT48L2xpPgogICAgPC91bD4KICAgIDx1bCBjbGFzcz0ibmF2IG5hdmJhci1uYXYgbmF2YmFyLXJpZ2h0Ij4gCiAgICAgIDxsaT48cCBjbGFzcz0ibmF2YmFyLXRleHQiPkxvZ2dlZCBpbiBhcyA8c3Ryb25nPjwlIC5nbG9iYWwudXNlci5Vc2VybmFtZSAlPjwvc3Ryb25nPjwvcD48L2xpPgogICAgICA8bGk+PGEgaHJlZj0iL2xvZ291dCI+TG9nIE91dDwvYT48L2xpPiAgICAgCiAgICA8L3VsPgogIDwvZGl2PgogIDxkaXYgY2xhc3M9ImNvbnRhaW5lciI+CiAgPCUgdGVtcGxhdGUgIm1lc3NhZ2VzLmh0bWwiIC4lPg==",
-		"admin-users-delete.html.tpl": "PCUgdGVtcGxhdGUgImFkbWluLWhlYWRlci5odG1sIiAuJT4KCgo8c3BhbiBjbGFzcz0icHVsbC1yaWdodCI+PGEgaHJlZj0iL2FkbWluL3VzZXJzIj5CYWNrIHRvIGxpc3QgJnJhcXVvOzwvYT48L3NwYW4+CjxoMT5EZWxldGUgVXNlcjwvaDE+Cgo8Zm9ybSBtZXRob2Q9IlBPU1QiIGFjdGlvbj0iL2FkbWluL3VzZXJzL2RlbGV0ZS9pZC88JSAudXNlci5JZCAlPiI+Cgo8cD5Zb3UgYXJlIGFib3V0IHRvIGRlbGV0ZSB0aGUgdXNlciAiPCUgLnVzZXIuVXNlcm5hbWUgJT4iPC9wPgoKPHA+QXJlIHlvdSBzdXJlIHlvdSB3YW50IHRvIGRvIHRoaXM/PC9wPgoKPGRpdiBjbGFzcz0iZm9ybS1hY3Rpb25zIj4KCTxidXR0b24gdHlwZT0ic3VibWl0IiBjbGFzcz0iYnRuIGJ0bi1kYW5nZXIiPkRlbGV0ZTwvYnV0dG9uPgoJPGEgaHJlZj0iL2FkbWluL3VzZXJzL2VkaXQvaWQvPCUgLnVzZXIuSWQgJT4iIGNsYXNzPSJidG4iPkNhbmNlbDwvYT4KPC9kaXY+CjwvZm9ybT4KCjwlIHRlbXBsYXRlICJhZG1pbi1mb290ZXIuaHRtbCIgLiU+",
-		"admin-users-edit.html.tpl":   "PCUgdGVtcGxhdGUgImFkbWluLWhlYWRlci5odG1sIiAuJT4KCjxzcGFuIGNsYXNzPSJwdWxsLXJpZ2h0Ij48YSBocmVmPSIvYWRtaW4vdXNlcnMiPkJhY2sgdG8gbGlzdCAmcmFxdW87PC9hPjwvc3Bhbj4KPGgxPk1hbmFnZSBVc2VyczwvaDE+CjxoMz48JSBpZiAudXBkYXRlICU+RWRpdCBVc2VyPCUgZWxzZSAlPk5ldyBVc2VyPCUgZW5kICU+PC9oMz4KCjxkaXYgY2xhc3M9InJvdyI+CiAgPGRpdiBjbGFzcz0iY29sLW1kLTYiPgogIDxmb3JtIHJvbGU9ImZvcm0iIG1ldGhvZD0iUE9TVCIgYWN0aW9uPSIvYWRtaW4vdXNlcnMvZWRpdDwlIGlmIC51cGRhdGUgJT4vaWQvPCUgLnVzZXIuSWQgJT48JSBlbmQgJT4iPiAKICAgICAKICAgICA8ZGl2IGNsYXNzPSJmb3JtLWdyb3VwIj4gICAKICAgICAgICA8bGFiZWwgZm9yPSJ1c2VybmFtZSI+VXNlcm5hbWU8L2xhYmVsPiAgICAgIAogICAgICAgIDxpbnB1dCBjbGFzcz0iZm9ybS1jb250cm9sIiB0eXBlPSJ0ZXh0IiBpZD0idXNlcm5hbWUiIG5hbWU9IlVzZXJuYW1lIiB2YWx1ZT0iPCUgaWYgLnVzZXIuVXNlcm5hbWUgJT48JSAudXNlci5Vc2VybmFtZSAlPjwlIGVuZCAlPiI+CiAgICAgIDwvZGl2PgoKICAgICAgIDxkaXYgY2xhc3M9ImZvcm0tZ3JvdXAiPgogICAgICAgIDxsYWJlbCBmb3I9ImZ1bGxfbmFtZSI+RnVsbCBOYW1lPC9sYWJlbD4gICAgICAKICAgICAgICA8aW5wdXQgY2xhc3M9ImZvcm0tY29udHJvbCIgdHlwZT0idGV4dCIgaWQ9ImZ1bGxfbmFtZSIgbmFtZT0iRnVsbE5hbWUiIHZhbHVlPSI8JWlmIC51c2VyLkZ1bGxOYW1lICU+PCUgLnVzZXIuRnVsbE5hbWUgJT48JSBlbmQgJT4iPiAKICAgICAgPC9kaXY+CgogICAgICA8ZGl2IGNsYXNzPSJmb3JtLWdyb3VwIj4KICAgICAgICA8bGFiZWwgZm9yPSJlbWFpbCI+RW1haWw8L2xhYmVsPgogICAgICAgIDxpbnB1dCBjbGFzcz0iZm9ybS1jb250cm9sIiB0eXBlPSJ0ZXh0IiBpZD0iZW1haWwiIG5hbWU9IkVtYWlsIiB2YWx1ZT0iPCVpZiAudXNlci5FbWFpbCAlPjwlIC51c2VyLkVtYWlsICU+PCUgZW5kICU+Ij4KICAgICAgPC9kaXY+CgogICAgIDxkaXYgY2xhc3M9ImZvcm0tZ3JvdXAiPgogICAgICA8bGFiZWwgZm9yPSJyb2xlIj5Sb2xlPC9sYWJlbD4gICAgIAogICAgICA8c2VsZWN0IG5hbWU9IlJvbGUiIGlkPSJyb2xlIiBjbGFzcz0iZm9ybS1jb250cm9sIiA+CiAgICAgICAgPCUgJGN1cl9yb2xlIDo9IC51c2VyLlJvbGUgJT4KICAgICAgICA8JSByYW5nZSAkbmFtZSwkdmFsIDo9IC5yb2xlcyU+CiAgICAgICAgICA8b3B0aW9uIDwlIGlmIGVxdWFsICR2YWwgJGN1cl9yb2xlICU+c2VsZWN0ZWQ9InNlbGVjdGVkIiA8JSBlbmQgJT4gdmFsdWU9IjwlICR2YWwgJT4iPjwlICRuYW1lICU+PC9vcHRpb24+CiAgICAgICAgPCUgZW5kICU+CiAgICAgIDwvc2VsZWN0PiAgICAgIAogICAgICA8L2Rpdj4KCgogICAgIDxkaXYgY2xhc3M9ImZvcm0tZ3JvdXAiPgogICAgICA8bGFiZWwgZm9yPSJwYXNzd29yZCI+UGFzc3dvcmQ8L2xhYmVsPiAgICAgIAogICAgICA8aW5wdXQgY2xhc3M9ImZvcm0tY29udHJvbCIgdHlwZT0icGFzc3dvcmQiIGlkPSJwYXNzd29yZCIgbmFtZT0iUGFzc3dvcmQiPiAgCiAgICAgIDwvZGl2PgoKICAgICAgPGRpdiBjbGFzcz0iZm9ybS1ncm91cCI+CiAgICAgICAgPGxhYmVsIGNsYXNzPSJjb250cm9sLWxhYmVsIiBmb3I9InBhc3N3b3JkX2FnYWluIj5QYXNzd29yZCAoQWdhaW4pPC9sYWJlbD4KICAgICAgICA8aW5wdXQgY2xhc3M9ImZvcm0tY29udHJvbCIgdHlwZT0icGFzc3dvcmQiIGlkPSJwYXNzd29yZF9hZ2FpbiIgbmFtZT0iUGFzc3dvcmRBZ2FpbiI+IAogICAgICA8L2Rpdj4KCiAgICAgICA8ZGl2IGNsYXNzPSJmb3JtLWdyb3VwIj4KICAgICAgICA8YnV0dG9uIHR5cGU9InN1Ym1pdCIgY2xhc3M9ImJ0biBidG4tcHJpbWFyeSI+U2F2ZTwvYnV0dG9uPgogICAgICAgIDwlIGlmIC51cGRhdGUgJT48YSBjbGFzcz0iYnRuIGJ0bi1kYW5nZXIiIGhyZWY9Ii9hZG1pbi91c2Vycy9kZWxldGUvaWQvPCUgLnVzZXIuSWQgJT4iPkRlbGV0ZTwvYT48JSBlbmQgJT4KICAgICAgICA8YSBjbGFzcz0iYnRuIGJ0bi1kZWZhdWx0IiBocmVmPSIvYWRtaW4vdXNlcnMiPkNhbmNlbDwvYT4KICAgICAgPC9kaXY+CgogICAgPC9mb3JtPgogIDwvZGl2PgoKCgo8L2Rpdj4KPCUgdGVtcGxhdGUgImFkbWluLWZvb3Rlci5odG1sIiAuJT4=",
-		"admin-users.html.tpl":        "PCUgdGVtcGxhdGUgImFkbWluLWhlYWRlci5odG1sIiAuJT4KCjxzcGFuIGNsYXNzPSJwdWxsLXJpZ2h0Ij48YSBjbGFzcz0iYnRuIGJ0bi1wcmltYXJ5IiBocmVmPSIvYWRtaW4vdXNlcnMvZWRpdCIgdGl0bGU9IkFkZCBuZXcgdXNlciI+PGkgY2xhc3M9Imljb24tcGx1cy1zaWduIGljb24td2hpdGUiPjwvaT4gQWRkIE5ldzwvYT48L3NwYW4+CjxoMT5NYW5hZ2UgVXNlcnM8L2gxPgoKPHRhYmxlIGNsYXNzPSJ0YWJsZSB0YWJsZS1ob3ZlciB0YWJsZS1jbGlja3Jvd3MiPgogIDx0aGVhZD4KICAgIDx0cj4KICAgICAgPHRoPlVzZXJuYW1lPC90aD4KICAgICAgPHRoPkZ1bGwgTmFtZTwvdGg+CiAgICAgIDx0aD5FbWFpbDwvdGg+CiAgICAgIDx0aD5DcmVhdGVkIE9uPC90aD4KICAgIDwvdHI+CiAgPC90aGVhZD4KICA8dGJvZHk+CiAgICA8JXJhbmdlICRpbmRleCwkdXNlciA6PSAudXNlcnMlPgogICAgPHRyPgogICAgICA8dGQ+PGEgaHJlZj0iL2FkbWluL3VzZXJzL2VkaXQvaWQvPCUgJHVzZXIuSWQgJT4iPjwlICR1c2VyLlVzZXJuYW1lICU+PC9hPjwvdGQ+CiAgICAgIDx0ZD48JSAkdXNlci5GdWxsTmFtZSAlPjwvdGQ+CiAgICAgIDx0ZD48JSAkdXNlci5FbWFpbCAlPjwvdGQ+CiAgICAgIDx0ZD48JSBkYXRlZm9ybWF0ICR1c2VyLkNyZWF0ZWRPbiAiMiBKYW4gMjAwNiIlPjwvdGQ+IAogICAgPC90cj4KICAgIDwlZW5kJT4KICA8L3Rib2R5Pgo8L3RhYmxlPgoKPCUgdGVtcGxhdGUgImFkbWluLWZvb3Rlci5odG1sIiAuJT4=",
-		"admin.html.tpl":              "PCUgdGVtcGxhdGUgImFkbWluLWhlYWRlci5odG1sIiAuJT4KCjxoMT5EYXNoYm9hcmQ8L2gxPgoKPHA+VGhpcyBkYXNoYm9hcmQgY291bGQgYmUgdXNlZCB0byBkaXNwbGF5IGludGVyZXN0aW5nIHN0YXRpc3RpY3MgYWJvdXQgeW91ciBhcHBsaWNhdGlvbi48L3A+Cgo8ZGl2IGlkPSJkYXNoYm9hcmQtY2hhcnRzIj4KCTxkaXYgY2xhc3M9InJvdyI+CgkJPGRpdiBjbGFzcz0iY29sLWxnLTYiPgoJCQk8aDM+UGllIEkgSGF2ZSBFYXRlbjwvaDM+CgkJCTxkaXYgaWQ9InBpZWNoYXJ0Ij48L2Rpdj4KCQk8L2Rpdj4KCQk8ZGl2IGNsYXNzPSJjb2wtbGctNiI+CgkJCTxoMz5PYnNjdXJlIFdvcmRzPC9oMz4KCQkJCgkJCTxkbD4KCQkJICA8ZHQ+bWFjaGludWxlPC9kdD4KCQkJICA8ZGQ+PGVtPm4uPC9lbT4gLSBzdXJ2ZXlvcidzIGluc3RydW1lbnQgZm9yIG9idGFpbmluZyByaWdodCBhbmdsZTwvZGQ+CgkJCTwvZGw+CQkJCgkJCTxkbD4KCQkJICA8ZHQ+bWFjcm9waG9iaWE8L2R0PgoJCQkgIDxkZD48ZW0+bi48L2VtPiAtIGZlYXIgb2YgbG9uZyB3YWl0czwvZGQ+CgkJCTwvZGw+CgkJCTxkbD4KCQkJICA8ZHQ+cXVvaW48L2R0PgoJCQkgIDxkZD48ZW0+bi48L2VtPiAtIGFuZ2xlOyB3ZWRnZTsgY29ybmVyc3RvbmU8L2RkPgoJCQk8L2RsPgoJCTwvZGl2PgoJPC9kaXY+CgoKPC9kaXY+Cgo8JSB0ZW1wbGF0ZSAiYWRtaW4tZm9vdGVyLmh0bWwiIC4lPgo=",
-		"appserver.go.tpl":            "Ly8gQ29weXJpZ2h0IDx5ZWFyPiA8bmFtZT4uIEFsbCByaWdodHMgcmVzZXJ2ZWQuCi8vIFVzZSBvZiB0aGlzIHNvdXJjZSBjb2RlIGlzIGdvdmVybmVkIGJ5IGxpY2Vuc2UgCi8vIHRoYXQgY2FuIGJlIGZvdW5kIGluIHRoZSBMSUNFTlNFIGZpbGUuCgpwYWNrYWdlIG1haW4KCmltcG9ydCAoCgkiYml0YnVja2V0Lm9yZy9qYXliaWxsL3Nhd3Npai9mcmFtZXdvcmsiCgkiYml0YnVja2V0Lm9yZy9qYXliaWxsL3Nhd3Npai9mcmFtZXdvcmsvbW9kZWwiCgkiZW5jb2RpbmcvZ29iIgoJInt7IC5uYW1lIH19IgoJImxvZyIKCSJuZXQvaHR0cCIKCSJ0aW1lIgoJImZtdCIKKQoKLy8gUmV0dXJucyBhIHR5cGUgdGhhdCBjb25mb3JtcyB0byB0aGUgZnJhbWV3b3JrLlVzZXIgaW50ZXJmYWNlLgpmdW5jIEdldFVzZXIodXNlcm5hbWUgc3RyaW5nLCBhICpmcmFtZXdvcmsuQXBwU2NvcGUpICh1c2VyIGZyYW1ld29yay5Vc2VyKSB7Cgl0IDo9ICZtb2RlbC5UYWJsZXtEYjogYS5EYn0KCWRidXNlciA6PSAme3sgLm5hbWUgfX0uVXNlcnt9CglxIDo9IG1vZGVsLlF1ZXJ5e1doZXJlOiBmbXQuU3ByaW50ZigidXNlcm5hbWUgPSAldiIsYS5EYi5HZXRRdWVyaWVzKCkuUCgxKSl9Cgl1c2VycywgXyA6PSB0LkZldGNoQWxsKGRidXNlciwgcSwgdXNlcm5hbWUpCglpZiBsZW4odXNlcnMpID09IDEgewoJCXVzZXIgPSB1c2Vyc1swXS4oKnt7IC5uYW1lIH19LlVzZXIpCgl9CglyZXR1cm4KfQoKLy8gSGFuZGxlcyB0aGUgYWRtaW4gbGFuZGluZyBwYWdlLgpmdW5jIGFkbWluSGFuZGxlcihyICpodHRwLlJlcXVlc3QsIGEgKmZyYW1ld29yay5BcHBTY29wZSwgcnMgKmZyYW1ld29yay5SZXF1ZXN0U2NvcGUpIChoIGZyYW1ld29yay5IYW5kbGVyUmVzcG9uc2UsIGVyciBlcnJvcikgewoJaC5Jbml0KCkKCWguVmlld1sidGltZSJdID0gdGltZS5Ob3coKQoJcmV0dXJuCn0KCi8vIEhhbmRsZXMgdGhlIG1haW4gYXBwbGljYXRpb24gbGFuZGluZyBwYWdlLgpmdW5jIGluZGV4SGFuZGxlcihyICpodHRwLlJlcXVlc3QsIGEgKmZyYW1ld29yay5BcHBTY29wZSwgcnMgKmZyYW1ld29yay5SZXF1ZXN0U2NvcGUpIChoIGZyYW1ld29yay5IYW5kbGVyUmVzcG9uc2UsIGVyciBlcnJvcikgewoJaC5Jbml0KCkKCWguVmlld1sidGltZSJdID0gdGltZS5Ob3coKQoJcmV0dXJuCn0KCmZ1bmMgbWFpbigpIHsKCWxvZy5QcmludCgiU3RhcnRpbmcge3sgLm5hbWUgfX0uLi4iKQoKCS8vIFJlcXVpcmVkIHNvIHRoYXQgb3VyIFVzZXIgdHlwZSBjYW4gYmUgdXNlZCBieSB0aGUgZnJhbWV3b3JrIGluIGEgc2Vzc2lvbiAKCWdvYi5SZWdpc3Rlcigme3sgLm5hbWUgfX0uVXNlcnt9KQoKCS8vIGRlZmluZSBzb21lIHJvbGUgYXJyYXlzCgoJcmcgOj0gbWFwW3N0cmluZ11bXWludHsKCQkiYWRtaW4iOiBbXWludHsge3sgLm5hbWUgfX0uUl9BRE1JTn0sCgkJImFsbCI6ICAgW11pbnR7IHt7IC5uYW1lIH19LlJfQURNSU4sIGZyYW1ld29yay5SX0dVRVNULCB7eyAubmFtZSB9fS5SX01FTUJFUn0sCgl9CgoJLy8gQ3JlYXRlIGEgbmV3IEFwcFNldHVwICAKCWFzIDo9IG5ldyhmcmFtZXdvcmsuQXBwU2V0dXApCgoJLy8gUmVnaXN0ZXIgQ2FsbGJhY2sgZnVuY3Rpb25zIGFuZCByb2xlcwoJYXMuR2V0VXNlciA9IEdldFVzZXIKCWFzLlJvbGVzID0gJm1hcFtzdHJpbmddaW50eyJhZG1pbiI6IHt7IC5uYW1lIH19LlJfQURNSU4sICJndWVzdCI6IGZyYW1ld29yay5SX0dVRVNULCAibWVtYmVyIjoge3sgLm5hbWUgfX0uUl9NRU1CRVJ9CgoJLy8gQ29uZmlndXJlIHRoZSBhcHBsaWNhdGlvbgoJZnJhbWV3b3JrLkNvbmZpZ3VyZShhcywgIiIpCgoJLy8gUm91dGUgcGF0dGVybnMgdG8gaGFuZGxlcnMKCWZyYW1ld29yay5Sb3V0ZShmcmFtZXdvcmsuUm91dGVDb25maWd7UGF0dGVybjogIi8iLCBIYW5kbGVyOiBpbmRleEhhbmRsZXIsIFJvbGVzOiByZ1siYWxsIl19KQoJZnJhbWV3b3JrLlJvdXRlKGZyYW1ld29yay5Sb3V0ZUNvbmZpZ3tQYXR0ZXJuOiAiL2FkbWluIiwgSGFuZGxlcjogYWRtaW5IYW5kbGVyLCBSb2xlczogcmdbImFkbWluIl19KQoJZnJhbWV3b3JrLlJvdXRlKGZyYW1ld29yay5Sb3V0ZUNvbmZpZ3tQYXR0ZXJuOiAiL2FkbWluL3VzZXJzIiwgSGFuZGxlcjoge3sgLm5hbWUgfX0uVXNlckFkbWluTGlzdEhhbmRsZXIsIFJvbGVzOiByZ1siYWRtaW4iXX0pCglmcmFtZXdvcmsuUm91dGUoZnJhbWV3b3JrLlJvdXRlQ29uZmlne1BhdHRlcm46ICIvYWRtaW4vdXNlcnMvZWRpdCIsIEhhbmRsZXI6IHt7IC5uYW1lIH19LlVzZXJBZG1pbkVkaXRIYW5kbGVyLCBSb2xlczogcmdbImFkbWluIl19KQoJZnJhbWV3b3JrLlJvdXRlKGZyYW1ld29yay5Sb3V0ZUNvbmZpZ3tQYXR0ZXJuOiAiL2FkbWluL3VzZXJzL2RlbGV0ZSIsIEhhbmRsZXI6IHt7IC5uYW1lIH19LlVzZXJBZG1pbkRlbGV0ZUhhbmRsZXIsIFJvbGVzOiByZ1siYWRtaW4iXX0pCglmcmFtZXdvcmsuUm91dGUoZnJhbWV3b3JrLlJvdXRlQ29uZmlne1BhdHRlcm46ICIvbG9naW4iLCBIYW5kbGVyOiBmcmFtZXdvcmsuTG9naW5IYW5kbGVyLCBSb2xlczogcmdbImFsbCJdfSkKCWZyYW1ld29yay5Sb3V0ZShmcmFtZXdvcmsuUm91dGVDb25maWd7UGF0dGVybjogIi9sb2dvdXQiLCBIYW5kbGVyOiBmcmFtZXdvcmsuTG9nb3V0SGFuZGxlciwgUm9sZXM6IHJnWyJhbGwiXX0pCglmcmFtZXdvcmsuUm91dGUoZnJhbWV3b3JrLlJvdXRlQ29uZmlne1BhdHRlcm46ICIvZGVuaWVkIiwgSGFuZGxlcjogZnJhbWV3b3JrLkRlbmllZEhhbmRsZXIsIFJvbGVzOiByZ1siYWxsIl19KQoJZnJhbWV3b3JrLlJvdXRlKGZyYW1ld29yay5Sb3V0ZUNvbmZpZ3tQYXR0ZXJuOiAiL2Vycm9yIiwgSGFuZGxlcjogZnJhbWV3b3JrLkVycm9ySGFuZGxlciwgUm9sZXM6IHJnWyJhbGwiXX0pCgoJLy8gQ3VzdG9tIFJvdXRlcwoKCS8vIFN0YXJ0IHRoZSBzZXJ2ZXIKCWZyYW1ld29yay5SdW4oKQp9Cg==",
-		"config.yaml.tpl":             "IyBDb3B5cmlnaHQgPHllYXI+IDxuYW1lPi4gQWxsIHJpZ2h0cyByZXNlcnZlZC4KIyBVc2Ugb2YgdGhpcyBzb3VyY2UgY29kZSBpcyBnb3Zlcm5lZCBieSBsaWNlbnNlIAojIHRoYXQgY2FuIGJlIGZvdW5kIGluIHRoZSBMSUNFTlNFIGZpbGUuCgphcHA6IAogICBjbWQ6IHt7IC5uYW1lIH19c2VydmVyCiAgIHBrZzoge3sgLm5hbWUgfX0KCnNlcnZlcjoKICBwb3J0OiB7eyAucG9ydCB9fQogIGNhY2hlVGVtcGxhdGVzOiBmYWxzZQoKZGF0YWJhc2U6CiAgZHJpdmVyOiB7eyAuZHJpdmVyIH19CiAgY29ubmVjdDoge3sgLmNvbm5lY3QgfX0KCmVuY3J5cHRpb246CiAgc2FsdDoge3sgLnNhbHQgfX0KICBrZXk6IHt7IC5rZXkgfX0K",
+		"admin-layout.html.tpl":       "PCFET0NUWVBFIGh0bWw+CjxodG1sIGxhbmc9ImVuIj4KICA8aGVhZD4KICAgIDxtZXRhIGNoYXJzZXQ9InV0Zi04Ij4KICAgIDxtZXRhIG5hbWU9InZpZXdwb3J0IiBjb250ZW50PSJ3aWR0aD1kZXZpY2Utd2lkdGgsIGluaXRpYWwtc2NhbGU9MS4wIj4KICAgIDxtZXRhIG5hbWU9ImRlc2NyaXB0aW9uIiBjb250ZW50PSIiPgogICAgPG1ldGEgbmFtZT0iYXV0aG9yIiBjb250ZW50PSIiPgoKICAgIDx0aXRsZT57ey5uYW1lfX0gQWRtaW48L3RpdGxlPgoKICAgIDwhLS0gQm9vdHN0cmFwIGNvcmUgQ1NTIC0tPgogICAgIDxsaW5rIHJlbD0ic3R5bGVzaGVldCIgaHJlZj0iLy9uZXRkbmEuYm9vdHN0cmFwY2RuLmNvbS9ib290c3RyYXAvMy4wLjAtd2lwL2Nzcy9ib290c3RyYXAubWluLmNzcyI+CgogICAgPCEtLSBDdXN0b20gc3R5bGVzIGZvciB0aGlzIHRlbXBsYXRlIC0tPgogICAgPGxpbmsgaHJlZj0iPCUgYXNzZXQgImNzcy9hZG1pbi5jc3MiICU+IiByZWw9InN0eWxlc2hlZXQiPgogICAgPGxpbmsgaHJlZj0iPCUgYXNzZXQgImNzcy9kYXRlcGlja2VyLmNzcyIgJT4iIHJlbD0ic3R5bGVzaGVldCI+CiAgPC9oZWFkPgoKICA8Ym9keT4KCiAgPGRpdiBjbGFzcz0ibmF2YmFyIG5hdmJhci1kZWZhdWx0IG5hdmJhci1maXhlZC10b3AiPgogICAgPGRpdiBjbGFzcz0ibmF2YmFyLWhlYWRlciI+CiAgICAgIDxhIGNsYXNzPSJuYXZiYXItYnJhbmQiIGhyZWY9Ii9hZG1pbiI+e3submFtZX19IEFkbWluPC9hPiAgICAgIAogICAgPC9kaXY+CiAgICA8dWwgY2xhc3M9Im5hdiBuYXZiYXItbmF2Ij4gICAgICAKICAgICAgPGxpIDwlIGlmIGVxdWFsIC5nbG9iYWwudXJsICIvYWRtaW4iICU+Y2xhc3M9ImFjdGl2ZSI8JSBlbmQgJT4+PGEgaHJlZj0iL2FkbWluIj5EYXNoYm9hcmQ8L2E+PC9saT4gICAKICAgICAgPGxpIDwlIGlmIGVxdWFsIC5nbG9iYWwudXJsICIvYWRtaW4vdXNlcnMiICU+Y2xhc3M9ImFjdGl2ZSI8JSBlbmQgJT4+PGEgaHJlZj0iL2FkbWluL3VzZXJzIj5Vc2VyczwvYT48L2xpPgogICAgPC91bD4KICAgIDx1bCBjbGFzcz0ibmF2IG5hdmJhci1uYXYgbmF2YmFyLXJpZ2h0Ij4gCiAgICAgIDxsaT48cCBjbGFzcz0ibmF2YmFyLXRleHQiPkxvZ2dlZCBpbiBhcyA8c3Ryb25nPjwlIC5nbG9iYWwudXNlci5Vc2VybmFtZSAlPjwvc3Ryb25nPjwvcD48L2xpPgogICAgICA8bGk+PGEgaHJlZj0iL2xvZ291dCI+TG9nIE91dDwvYT48L2xpPiAgICAgCiAgICA8L3VsPgogIDwvZGl2PgogIDxkaXYgY2xhc3M9ImNvbnRhaW5lciI+CiAgPCUgdGVtcGxhdGUgIm1lc3NhZ2VzLmh0bWwiIC4lPgogIDwlIGJsb2NrICJjb250ZW50IiAuICU+PCUgZW5kICU+CiAgPC9kaXY+PCEtLSAvLmNvbnRhaW5lciAtLT4KICA8c2NyaXB0IHNyYz0iLy9hamF4Lmdvb2dsZWFwaXMuY29tL2FqYXgvbGlicy9qcXVlcnkvMi4wLjMvanF1ZXJ5Lm1pbi5qcyI+PC9zY3JpcHQ+ICAKICA8c2NyaXB0IHNyYz0iLy9uZXRkbmEuYm9vdHN0cmFwY2RuLmNvbS9ib290c3RyYXAvMy4wLjAtd2lwL2pzL2Jvb3RzdHJhcC5taW4uanMiPjwvc2NyaXB0PgogIDxzY3JpcHQgc3JjPSI8JSBhc3NldCAianMvYm9vdHN0cmFwLWRhdGVwaWNrZXIubWluLmpzIiAlPiI+PC9zY3JpcHQ+ICAKICA8c2NyaXB0IHR5cGU9InRleHQvamF2YXNjcmlwdCIgc3JjPSJodHRwczovL3d3dy5nb29nbGUuY29tL2pzYXBpIj48L3NjcmlwdD4KICA8c2NyaXB0IHNyYz0iPCUgYXNzZXQgImpzL3Nhd3Npai5qcyIgJT4iPjwvc2NyaXB0PiAgCiAgPCEtLSBQZXIgcGFnZSBzY3JpcHRzIC0tPgogIDwlIGJsb2NrICJzY3JpcHRzIiAuICU+PCUgZW5kICU+CiAgPC9ib2R5Pgo8L2h0bWw+",
+		"admin-users-delete.html.tpl": "PCUgbGF5b3V0ICJsYXlvdXRzL2FkbWluLmh0bWwiICU+CjwlIGRlZmluZSAiY29udGVudCIgJT4KCgo8c3BhbiBjbGFzcz0icHVsbC1yaWdodCI+PGEgaHJlZj0iL2FkbWluL3VzZXJzIj5CYWNrIHRvIGxpc3QgJnJhcXVvOzwvYT48L3NwYW4+CjxoMT5EZWxldGUgVXNlcjwvaDE+Cgo8Zm9ybSBtZXRob2Q9IlBPU1QiIGFjdGlvbj0iL2FkbWluL3VzZXJzL2RlbGV0ZS9pZC88JSAudXNlci5JZCAlPiI+CjwlIGNzcmZGaWVsZCAuICU+Cgo8cD5Zb3UgYXJlIGFib3V0IHRvIGRlbGV0ZSB0aGUgdXNlciAiPCUgLnVzZXIuVXNlcm5hbWUgJT4iPC9wPgoKPHA+QXJlIHlvdSBzdXJlIHlvdSB3YW50IHRvIGRvIHRoaXM/PC9wPgoKPGRpdiBjbGFzcz0iZm9ybS1hY3Rpb25zIj4KCTxidXR0b24gdHlwZT0ic3VibWl0IiBjbGFzcz0iYnRuIGJ0bi1kYW5nZXIiPkRlbGV0ZTwvYnV0dG9uPgoJPGEgaHJlZj0iL2FkbWluL3VzZXJzL2VkaXQvaWQvPCUgLnVzZXIuSWQgJT4iIGNsYXNzPSJidG4iPkNhbmNlbDwvYT4KPC9kaXY+CjwvZm9ybT4KCjwlIGVuZCAlPg==",
+		"admin-users-edit.html.tpl":   "PCUgbGF5b3V0ICJsYXlvdXRzL2FkbWluLmh0bWwiICU+CjwlIGRlZmluZSAiY29udGVudCIgJT4KCjxzcGFuIGNsYXNzPSJwdWxsLXJpZ2h0Ij48YSBocmVmPSIvYWRtaW4vdXNlcnMiPkJhY2sgdG8gbGlzdCAmcmFxdW87PC9hPjwvc3Bhbj4KPGgxPk1hbmFnZSBVc2VyczwvaDE+CjxoMz48JSBpZiAudXBkYXRlICU+RWRpdCBVc2VyPCUgZWxzZSAlPk5ldyBVc2VyPCUgZW5kICU+PC9oMz4KCjxkaXYgY2xhc3M9InJvdyI+CiAgPGRpdiBjbGFzcz0iY29sLW1kLTYiPgogIDxmb3JtIHJvbGU9ImZvcm0iIG1ldGhvZD0iUE9TVCIgYWN0aW9uPSIvYWRtaW4vdXNlcnMvZWRpdDwlIGlmIC51cGRhdGUgJT4vaWQvPCUgLnVzZXIuSWQgJT48JSBlbmQgJT4iPiAKICAgIDwlIGNzcmZGaWVsZCAuICU+CiAgICAgCiAgICAgPGRpdiBjbGFzcz0iZm9ybS1ncm91cCI+ICAgCiAgICAgICAgPGxhYmVsIGZvcj0idXNlcm5hbWUiPlVzZXJuYW1lPC9sYWJlbD4gICAgICAKICAgICAgICA8aW5wdXQgY2xhc3M9ImZvcm0tY29udHJvbCIgdHlwZT0idGV4dCIgaWQ9InVzZXJuYW1lIiBuYW1lPSJVc2VybmFtZSIgdmFsdWU9IjwlIGlmIC51c2VyLlVzZXJuYW1lICU+PCUgLnVzZXIuVXNlcm5hbWUgJT48JSBlbmQgJT4iPgogICAgICA8L2Rpdj4KCiAgICAgICA8ZGl2IGNsYXNzPSJmb3JtLWdyb3VwIj4KICAgICAgICA8bGFiZWwgZm9yPSJmdWxsX25hbWUiPkZ1bGwgTmFtZTwvbGFiZWw+ICAgICAgCiAgICAgICAgPGlucHV0IGNsYXNzPSJmb3JtLWNvbnRyb2wiIHR5cGU9InRleHQiIGlkPSJmdWxsX25hbWUiIG5hbWU9IkZ1bGxOYW1lIiB2YWx1ZT0iPCVpZiAudXNlci5GdWxsTmFtZSAlPjwlIC51c2VyLkZ1bGxOYW1lICU+PCUgZW5kICU+Ij4gCiAgICAgIDwvZGl2PgoKICAgICAgPGRpdiBjbGFzcz0iZm9ybS1ncm91cCI+CiAgICAgICAgPGxhYmVsIGZvcj0iZW1haWwiPkVtYWlsPC9sYWJlbD4KICAgICAgICA8aW5wdXQgY2xhc3M9ImZvcm0tY29udHJvbCIgdHlwZT0idGV4dCIgaWQ9ImVtYWlsIiBuYW1lPSJFbWFpbCIgdmFsdWU9IjwlaWYgLnVzZXIuRW1haWwgJT48JSAudXNlci5FbWFpbCAlPjwlIGVuZCAlPiI+CiAgICAgIDwvZGl2PgoKICAgICA8ZGl2IGNsYXNzPSJmb3JtLWdyb3VwIj4KICAgICAgPGxhYmVsIGZvcj0icm9sZSI+Um9sZTwvbGFiZWw+ICAgICAKICAgICAgPHNlbGVjdCBuYW1lPSJSb2xlIiBpZD0icm9sZSIgY2xhc3M9ImZvcm0tY29udHJvbCIgPgogICAgICAgIDwlICRjdXJfcm9sZSA6PSAudXNlci5Sb2xlICU+CiAgICAgICAgPCUgcmFuZ2UgJG5hbWUsJHZhbCA6PSAucm9sZXMlPgogICAgICAgICAgPG9wdGlvbiA8JSBpZiBlcXVhbCAkdmFsICRjdXJfcm9sZSAlPnNlbGVjdGVkPSJzZWxlY3RlZCIgPCUgZW5kICU+IHZhbHVlPSI8JSAkdmFsICU+Ij48JSAkbmFtZSAlPjwvb3B0aW9uPgogICAgICAgIDwlIGVuZCAlPgogICAgICA8L3NlbGVjdD4gICAgICAKICAgICAgPC9kaXY+CgoKICAgICA8ZGl2IGNsYXNzPSJmb3JtLWdyb3VwIj4KICAgICAgPGxhYmVsIGZvcj0icGFzc3dvcmQiPlBhc3N3b3JkPC9sYWJlbD4gICAgICAKICAgICAgPGlucHV0IGNsYXNzPSJmb3JtLWNvbnRyb2wiIHR5cGU9InBhc3N3b3JkIiBpZD0icGFzc3dvcmQiIG5hbWU9IlBhc3N3b3JkIj4gIAogICAgICA8L2Rpdj4KCiAgICAgIDxkaXYgY2xhc3M9ImZvcm0tZ3JvdXAiPgogICAgICAgIDxsYWJlbCBjbGFzcz0iY29udHJvbC1sYWJlbCIgZm9yPSJwYXNzd29yZF9hZ2FpbiI+UGFzc3dvcmQgKEFnYWluKTwvbGFiZWw+CiAgICAgICAgPGlucHV0IGNsYXNzPSJmb3JtLWNvbnRyb2wiIHR5cGU9InBhc3N3b3JkIiBpZD0icGFzc3dvcmRfYWdhaW4iIG5hbWU9IlBhc3N3b3JkQWdhaW4iPiAKICAgICAgPC9kaXY+CgogICAgICAgPGRpdiBjbGFzcz0iZm9ybS1ncm91cCI+CiAgICAgICAgPGJ1dHRvbiB0eXBlPSJzdWJtaXQiIGNsYXNzPSJidG4gYnRuLXByaW1hcnkiPlNhdmU8L2J1dHRvbj4KICAgICAgICA8JSBpZiAudXBkYXRlICU+PGEgY2xhc3M9ImJ0biBidG4tZGFuZ2VyIiBocmVmPSIvYWRtaW4vdXNlcnMvZGVsZXRlL2lkLzwlIC51c2VyLklkICU+Ij5EZWxldGU8L2E+PCUgZW5kICU+CiAgICAgICAgPGEgY2xhc3M9ImJ0biBidG4tZGVmYXVsdCIgaHJlZj0iL2FkbWluL3VzZXJzIj5DYW5jZWw8L2E+CiAgICAgIDwvZGl2PgoKICAgIDwvZm9ybT4KCiAgICA8JSBpZiAudXBkYXRlICU+CiAgICA8Zm9ybSByb2xlPSJmb3JtIiBtZXRob2Q9IlBPU1QiIGFjdGlvbj0iL2FkbWluL3VzZXJzL3Jldm9rZS9pZC88JSAudXNlci5JZCAlPiI+CiAgICAgIDwlIGNzcmZGaWVsZCAuICU+CiAgICAgIDxkaXYgY2xhc3M9ImZvcm0tZ3JvdXAiPgogICAgICAgIDxidXR0b24gdHlwZT0ic3VibWl0IiBjbGFzcz0iYnRuIGJ0bi13YXJuaW5nIj5Mb2cgb3V0IGV2ZXJ5d2hlcmU8L2J1dHRvbj4KICAgICAgICA8c3BhbiBjbGFzcz0iaGVscC1ibG9jayI+RW5kcyBldmVyeSBzZXNzaW9uIHRoaXMgdXNlciBoYXMsIG9uIGFsbCBvZiB0aGVpciBkZXZpY2VzLjwvc3Bhbj4KICAgICAgPC9kaXY+CiAgICA8L2Zvcm0+CiAgICA8JSBlbmQgJT4KICA8L2Rpdj4KCgoKPC9kaXY+CjwlIGVuZCAlPg==",
+		"admin-users.html.tpl":        "PCUgbGF5b3V0ICJsYXlvdXRzL2FkbWluLmh0bWwiICU+CjwlIGRlZmluZSAiY29udGVudCIgJT4KCjxzcGFuIGNsYXNzPSJwdWxsLXJpZ2h0Ij48YSBjbGFzcz0iYnRuIGJ0bi1wcmltYXJ5IiBocmVmPSIvYWRtaW4vdXNlcnMvZWRpdCIgdGl0bGU9IkFkZCBuZXcgdXNlciI+PGkgY2xhc3M9Imljb24tcGx1cy1zaWduIGljb24td2hpdGUiPjwvaT4gQWRkIE5ldzwvYT48L3NwYW4+CjxoMT5NYW5hZ2UgVXNlcnM8L2gxPgoKPHRhYmxlIGNsYXNzPSJ0YWJsZSB0YWJsZS1ob3ZlciB0YWJsZS1jbGlja3Jvd3MiPgogIDx0aGVhZD4KICAgIDx0cj4KICAgICAgPHRoPlVzZXJuYW1lPC90aD4KICAgICAgPHRoPkZ1bGwgTmFtZTwvdGg+CiAgICAgIDx0aD5FbWFpbDwvdGg+CiAgICAgIDx0aD5DcmVhdGVkIE9uPC90aD4KICAgIDwvdHI+CiAgPC90aGVhZD4KICA8dGJvZHk+CiAgICA8JXJhbmdlICRpbmRleCwkdXNlciA6PSAudXNlcnMlPgogICAgPHRyPgogICAgICA8dGQ+PGEgaHJlZj0iL2FkbWluL3VzZXJzL2VkaXQvaWQvPCUgJHVzZXIuSWQgJT4iPjwlICR1c2VyLlVzZXJuYW1lICU+PC9hPjwvdGQ+CiAgICAgIDx0ZD48JSAkdXNlci5GdWxsTmFtZSAlPjwvdGQ+CiAgICAgIDx0ZD48JSAkdXNlci5FbWFpbCAlPjwvdGQ+CiAgICAgIDx0ZD48JSBkYXRlZm9ybWF0ICR1c2VyLkNyZWF0ZWRPbiAiMiBKYW4gMjAwNiIlPjwvdGQ+IAogICAgPC90cj4KICAgIDwlZW5kJT4KICA8L3Rib2R5Pgo8L3RhYmxlPgoKPCUgZW5kICU+",
+		"admin.html.tpl":              "PCUgbGF5b3V0ICJsYXlvdXRzL2FkbWluLmh0bWwiICU+CjwlIGRlZmluZSAiY29udGVudCIgJT4KCjxoMT5EYXNoYm9hcmQ8L2gxPgoKPHA+VGhpcyBkYXNoYm9hcmQgY291bGQgYmUgdXNlZCB0byBkaXNwbGF5IGludGVyZXN0aW5nIHN0YXRpc3RpY3MgYWJvdXQgeW91ciBhcHBsaWNhdGlvbi48L3A+Cgo8ZGl2IGlkPSJkYXNoYm9hcmQtY2hhcnRzIj4KCTxkaXYgY2xhc3M9InJvdyI+CgkJPGRpdiBjbGFzcz0iY29sLWxnLTYiPgoJCQk8aDM+UGllIEkgSGF2ZSBFYXRlbjwvaDM+CgkJCTxkaXYgaWQ9InBpZWNoYXJ0Ij48L2Rpdj4KCQk8L2Rpdj4KCQk8ZGl2IGNsYXNzPSJjb2wtbGctNiI+CgkJCTxoMz5PYnNjdXJlIFdvcmRzPC9oMz4KCQkJCgkJCTxkbD4KCQkJICA8ZHQ+bWFjaGludWxlPC9kdD4KCQkJICA8ZGQ+PGVtPm4uPC9lbT4gLSBzdXJ2ZXlvcidzIGluc3RydW1lbnQgZm9yIG9idGFpbmluZyByaWdodCBhbmdsZTwvZGQ+CgkJCTwvZGw+CQkJCgkJCTxkbD4KCQkJICA8ZHQ+bWFjcm9waG9iaWE8L2R0PgoJCQkgIDxkZD48ZW0+bi48L2VtPiAtIGZlYXIgb2YgbG9uZyB3YWl0czwvZGQ+CgkJCTwvZGw+CgkJCTxkbD4KCQkJICA8ZHQ+cXVvaW48L2R0PgoJCQkgIDxkZD48ZW0+bi48L2VtPiAtIGFuZ2xlOyB3ZWRnZTsgY29ybmVyc3RvbmU8L2RkPgoJCQk8L2RsPgoJCTwvZGl2PgoJPC9kaXY+CgoKPC9kaXY+Cgo8JSBlbmQgJT4KPCUgZGVmaW5lICJzY3JpcHRzIiAlPgogIDxzY3JpcHQgc3JjPSI8JSBhc3NldCAianMvYWRtaW4tZGFzaGJvYXJkLmpzIiAlPiI+PC9zY3JpcHQ+CjwlIGVuZCAlPg==",
+		"appserver.go.tpl":            "Ly8gQ29weXJpZ2h0IDx5ZWFyPiA8bmFtZT4uIEFsbCByaWdodHMgcmVzZXJ2ZWQuCi8vIFVzZSBvZiB0aGlzIHNvdXJjZSBjb2RlIGlzIGdvdmVybmVkIGJ5IGxpY2Vuc2UgCi8vIHRoYXQgY2FuIGJlIGZvdW5kIGluIHRoZSBMSUNFTlNFIGZpbGUuCgpwYWNrYWdlIG1haW4KCmltcG9ydCAoCgkiYml0YnVja2V0Lm9yZy9qYXliaWxsL3Nhd3Npai9mcmFtZXdvcmsiCgkiYml0YnVja2V0Lm9yZy9qYXliaWxsL3Nhd3Npai9mcmFtZXdvcmsvbW9kZWwiCgkiZW5jb2RpbmcvZ29iIgoJInt7IC5uYW1lIH19IgoJImxvZyIKCSJuZXQvaHR0cCIKCSJ0aW1lIgoJImZtdCIKKQoKLy8gUmV0dXJucyBhIHR5cGUgdGhhdCBjb25mb3JtcyB0byB0aGUgZnJhbWV3b3JrLlVzZXIgaW50ZXJmYWNlLgpmdW5jIEdldFVzZXIodXNlcm5hbWUgc3RyaW5nLCBhICpmcmFtZXdvcmsuQXBwU2NvcGUpICh1c2VyIGZyYW1ld29yay5Vc2VyKSB7Cgl0IDo9ICZtb2RlbC5UYWJsZXtEYjogYS5EYn0KCWRidXNlciA6PSAme3sgLm5hbWUgfX0uVXNlcnt9CglxIDo9IG1vZGVsLlF1ZXJ5e1doZXJlOiBmbXQuU3ByaW50ZigidXNlcm5hbWUgPSAldiIsYS5EYi5HZXRRdWVyaWVzKCkuUCgxKSl9Cgl1c2VycywgXyA6PSB0LkZldGNoQWxsKGRidXNlciwgcSwgdXNlcm5hbWUpCglpZiBsZW4odXNlcnMpID09IDEgewoJCXVzZXIgPSB1c2Vyc1swXS4oKnt7IC5uYW1lIH19LlVzZXIpCgl9CglyZXR1cm4KfQoKLy8gSGFuZGxlcyB0aGUgYWRtaW4gbGFuZGluZyBwYWdlLgpmdW5jIGFkbWluSGFuZGxlcihyICpodHRwLlJlcXVlc3QsIGEgKmZyYW1ld29yay5BcHBTY29wZSwgcnMgKmZyYW1ld29yay5SZXF1ZXN0U2NvcGUpIChoIGZyYW1ld29yay5IYW5kbGVyUmVzcG9uc2UsIGVyciBlcnJvcikgewoJaC5Jbml0KCkKCWguVmlld1sidGltZSJdID0gdGltZS5Ob3coKQoJcmV0dXJuCn0KCi8vIEhhbmRsZXMgdGhlIG1haW4gYXBwbGljYXRpb24gbGFuZGluZyBwYWdlLgpmdW5jIGluZGV4SGFuZGxlcihyICpodHRwLlJlcXVlc3QsIGEgKmZyYW1ld29yay5BcHBTY29wZSwgcnMgKmZyYW1ld29yay5SZXF1ZXN0U2NvcGUpIChoIGZyYW1ld29yay5IYW5kbGVyUmVzcG9uc2UsIGVyciBlcnJvcikgewoJaC5Jbml0KCkKCWguVmlld1sidGltZSJdID0gdGltZS5Ob3coKQoJcmV0dXJuCn0KCmZ1bmMgbWFpbigpIHsKCWxvZy5QcmludCgiU3RhcnRpbmcge3sgLm5hbWUgfX0uLi4iKQoKCS8vIFJlcXVpcmVkIHNvIHRoYXQgb3VyIFVzZXIgdHlwZSBjYW4gYmUgdXNlZCBieSB0aGUgZnJhbWV3b3JrIGluIGEgc2Vzc2lvbiAKCWdvYi5SZWdpc3Rlcigme3sgLm5hbWUgfX0uVXNlcnt9KQoKCS8vIENyZWF0ZSBhIG5ldyBBcHBTZXR1cCAgCglhcyA6PSBuZXcoZnJhbWV3b3JrLkFwcFNldHVwKQoKCS8vIFJlZ2lzdGVyIENhbGxiYWNrIGZ1bmN0aW9ucyBhbmQgcm9sZXMKCWFzLkdldFVzZXIgPSBHZXRVc2VyCglhcy5Sb2xlcyA9ICZtYXBbc3RyaW5nXWludHsiYWRtaW4iOiB7eyAubmFtZSB9fS5SX0FETUlOLCAiZ3Vlc3QiOiBmcmFtZXdvcmsuUl9HVUVTVCwgIm1lbWJlciI6IHt7IC5uYW1lIH19LlJfTUVNQkVSfQoKCS8vIEdpdmUgZWFjaCByb2xlIGl0cyBwZXJtaXNzaW9ucy4gRXZlcnlvbmUsIGxvZ2dlZCBpbiBvciBub3QsIGhhcyB0aGUgZ3Vlc3QgcGVybWlzc2lvbnMuIEFkbWlucyBjYW4gZG8gZXZlcnl0aGluZyBtZW1iZXJzIGNhbi4KCWFzLkdyYW50KGZyYW1ld29yay5SX0dVRVNULCAic2l0ZS52aWV3IikKCWFzLkdyYW50KHt7IC5uYW1lIH19LlJfQURNSU4sICJhZG1pbi52aWV3IiwgInVzZXJzLnZpZXciLCAidXNlcnMuZWRpdCIsICJ1c2Vycy5kZWxldGUiLCAibWV0cmljcy52aWV3IikKCWFzLkluaGVyaXQoe3sgLm5hbWUgfX0uUl9BRE1JTiwge3sgLm5hbWUgfX0uUl9NRU1CRVIpCgoJLy8gQ29uZmlndXJlIHRoZSBhcHBsaWNhdGlvbgoJZnJhbWV3b3JrLkNvbmZpZ3VyZShhcywgIiIpCgoJLy8gUm91dGUgcGF0dGVybnMgdG8gaGFuZGxlcnMKCWZyYW1ld29yay5Sb3V0ZShmcmFtZXdvcmsuUm91dGVDb25maWd7UGF0dGVybjogIi8iLCBIYW5kbGVyOiBpbmRleEhhbmRsZXIsIFBlcm1pc3Npb246ICJzaXRlLnZpZXcifSkKCWZyYW1ld29yay5Sb3V0ZShmcmFtZXdvcmsuUm91dGVDb25maWd7UGF0dGVybjogIi9hZG1pbiIsIEhhbmRsZXI6IGFkbWluSGFuZGxlciwgUGVybWlzc2lvbjogImFkbWluLnZpZXcifSkKCWZyYW1ld29yay5Sb3V0ZShmcmFtZXdvcmsuUm91dGVDb25maWd7UGF0dGVybjogIi9hZG1pbi91c2VycyIsIEhhbmRsZXI6IHt7IC5uYW1lIH19LlVzZXJBZG1pbkxpc3RIYW5kbGVyLCBQZXJtaXNzaW9uOiAidXNlcnMudmlldyJ9KQoJZnJhbWV3b3JrLlJvdXRlKGZyYW1ld29yay5Sb3V0ZUNvbmZpZ3tQYXR0ZXJuOiAiL2FkbWluL3VzZXJzL2VkaXQiLCBNZXRob2RzOiBbXXN0cmluZ3siR0VUIiwgIlBPU1QifSwgSGFuZGxlcjoge3sgLm5hbWUgfX0uVXNlckFkbWluRWRpdEhhbmRsZXIsIFBlcm1pc3Npb246ICJ1c2Vycy5lZGl0In0pCglmcmFtZXdvcmsuUm91dGUoZnJhbWV3b3JrLlJvdXRlQ29uZmlne1BhdHRlcm46ICIvYWRtaW4vdXNlcnMvZGVsZXRlIiwgTWV0aG9kczogW11zdHJpbmd7IkdFVCIsICJQT1NUIn0sIEhhbmRsZXI6IHt7IC5uYW1lIH19LlVzZXJBZG1pbkRlbGV0ZUhhbmRsZXIsIFBlcm1pc3Npb246ICJ1c2Vycy5kZWxldGUiLAoJCVJlY2VudExvZ2luOiAxMCAqIHRpbWUuTWludXRlfSkKCWZyYW1ld29yay5Sb3V0ZShmcmFtZXdvcmsuUm91dGVDb25maWd7UGF0dGVybjogIi9hZG1pbi91c2Vycy9yZXZva2UiLCBNZXRob2RzOiBbXXN0cmluZ3siUE9TVCJ9LCBIYW5kbGVyOiB7eyAubmFtZSB9fS5Vc2VyQWRtaW5SZXZva2VIYW5kbGVyLCBQZXJtaXNzaW9uOiAidXNlcnMuZWRpdCJ9KQoJZnJhbWV3b3JrLlJvdXRlKGZyYW1ld29yay5Sb3V0ZUNvbmZpZ3tQYXR0ZXJuOiAiL2xvZ2luIiwgTWV0aG9kczogW11zdHJpbmd7IkdFVCIsICJQT1NUIn0sIEhhbmRsZXI6IGZyYW1ld29yay5Mb2dpbkhhbmRsZXIsIFBlcm1pc3Npb246ICJzaXRlLnZpZXciLAoJCVJhdGVMaW1pdDogJmZyYW1ld29yay5SYXRlTGltaXR7UmVxdWVzdHM6IDUsIFBlcjogdGltZS5NaW51dGUsIE1ldGhvZHM6IFtdc3RyaW5neyJQT1NUIn19fSkKCWZyYW1ld29yay5Sb3V0ZShmcmFtZXdvcmsuUm91dGVDb25maWd7UGF0dGVybjogIi9sb2dvdXQiLCBIYW5kbGVyOiBmcmFtZXdvcmsuTG9nb3V0SGFuZGxlciwgUGVybWlzc2lvbjogInNpdGUudmlldyJ9KQoJZnJhbWV3b3JrLlJvdXRlKGZyYW1ld29yay5Sb3V0ZUNvbmZpZ3tQYXR0ZXJuOiAiL2RlbmllZCIsIEhhbmRsZXI6IGZyYW1ld29yay5EZW5pZWRIYW5kbGVyLCBQZXJtaXNzaW9uOiAic2l0ZS52aWV3In0pCglmcmFtZXdvcmsuUm91dGUoZnJhbWV3b3JrLlJvdXRlQ29uZmlne1BhdHRlcm46ICIvZXJyb3IiLCBIYW5kbGVyOiBmcmFtZXdvcmsuRXJyb3JIYW5kbGVyLCBQZXJtaXNzaW9uOiAic2l0ZS52aWV3In0pCgoJLy8gQ3VzdG9tIFJvdXRlcwoKCS8vIFN0YXJ0IHRoZSBzZXJ2ZXIKCWZyYW1ld29yay5SdW4oKQp9Cg==",
+		"config.yaml.tpl":             "IyBDb3B5cmlnaHQgPHllYXI+IDxuYW1lPi4gQWxsIHJpZ2h0cyByZXNlcnZlZC4KIyBVc2Ugb2YgdGhpcyBzb3VyY2UgY29kZSBpcyBnb3Zlcm5lZCBieSBsaWNlbnNlIAojIHRoYXQgY2FuIGJlIGZvdW5kIGluIHRoZSBMSUNFTlNFIGZpbGUuCgphcHA6IAogICBjbWQ6IHt7IC5uYW1lIH19c2VydmVyCiAgIHBrZzoge3sgLm5hbWUgfX0KCnNlcnZlcjoKICBwb3J0OiB7eyAucG9ydCB9fQogIGNhY2hlVGVtcGxhdGVzOiBmYWxzZQogICMgU2hvdyBzdGFjayB0cmFjZXMgYW5kIHJlcXVlc3QgZGV0YWlscyBvbiBlcnJvciBwYWdlcy4gVHVybiB0aGlzIG9mZiBpbiBwcm9kdWN0aW9uLgogIGRldk1vZGU6IHRydWUKICByZWFkVGltZW91dDogMzBzCiAgd3JpdGVUaW1lb3V0OiA2MHMKICBpZGxlVGltZW91dDogMTIwcwogIG1heEhlYWRlckJ5dGVzOiAxMDQ4NTc2CiAgc2h1dGRvd25UaW1lb3V0OiAzMHMKICAjIFJldmVyc2UgcHJveGllcyB3aG9zZSBYLUZvcndhcmRlZC1Gb3IgaGVhZGVyIGlzIHRydXN0ZWQgZm9yIHRoZSBjbGllbnQgYWRkcmVzcywgaS5lLiAxMC4wLjAuMSwgMTAuMS4wLjAvMTYKICAjIHRydXN0ZWRQcm94aWVzOiAxMjcuMC4wLjEKICBzdGF0aWM6CiAgICBwYXRoOiAvc3RhdGljLwogICAgIyBGaW5nZXJwcmludGVkIFVSTHMgZnJvbSB0aGUgYXNzZXQgdGVtcGxhdGUgZnVuY3Rpb24gYXJlIGFsd2F5cyBjYWNoZWQgZm9yZXZlci4KICAgIGNhY2hlQ29udHJvbDogcHVibGljLCBtYXgtYWdlPTM2MDAKICAgIGV0YWdzOiB0cnVlCiAgY29tcHJlc3Npb246CiAgICBlbmFibGVkOiB0cnVlCiAgICBtaW5TaXplOiAxMDI0CiAgICB0eXBlczogdGV4dC9odG1sLCB0ZXh0L3BsYWluLCB0ZXh0L2NzcywgYXBwbGljYXRpb24vanNvbiwgYXBwbGljYXRpb24veG1sLCB0ZXh0L3htbCwgYXBwbGljYXRpb24vamF2YXNjcmlwdAogICMgL2hlYWx0aHogc2F5cyB0aGUgcHJvY2VzcyBpcyB1cDsgL3JlYWR5eiBjaGVja3MgdGhlIGRhdGFiYXNlLCBzY2hlbWEgdmVyc2lvbnMgYW5kIEFwcFNldHVwLkhlYWx0aENoZWNrcy4KICBoZWFsdGg6CiAgICBlbmFibGVkOiB0cnVlCiAgICB0aW1lb3V0OiA1cwogICMgSG93IG9mdGVuIGVhY2ggY2xpZW50IGNhbiBtYWtlIHJlcXVlc3RzLiBSb3V0ZXMgY2FuIGhhdmUgdGhlaXIgb3duIGxpbWl0cyB0b28sIGxpa2UgL2xvZ2luIGRvZXMuCiAgcmF0ZUxpbWl0OgogICAgcmVxdWVzdHM6IDYwMAogICAgcGVyOiAxbQogICAgYnVyc3Q6IDEwMAogICAgYnk6IGlwCiAgICAjIFVzZSBkYiB0byBzaGFyZSBsaW1pdHMgYmV0d2VlbiBzZXZlcmFsIHNlcnZlcnMuCiAgICBzdG9yZTogbWVtb3J5CiAgIyBVbmNvbW1lbnQgdG8gc2VydmUgSFRUUFMuIFBhdGhzIGFyZSByZWxhdGl2ZSB0byB0aGUgYXBwbGljYXRpb24gZGlyZWN0b3J5LgogICMgdGxzOgogICMgICBjZXJ0OiBldGMvc2VydmVyLmNydAogICMgICBrZXk6IGV0Yy9zZXJ2ZXIua2V5CiAgIyAgIG1pblZlcnNpb246IDEuMgogICMgICByZWRpcmVjdExpc3RlbjogOjgwODAKCmxvZzoKICAjIGRlYnVnIGxvZ3MgZXZlcnkgcXVlcnkgYW5kIHJlcXVlc3Q7IHVzZSBpbmZvIG9yIHdhcm4gaW4gcHJvZHVjdGlvbi4KICBsZXZlbDogZGVidWcKICAjIHRleHQgb3IganNvbgogIGZvcm1hdDogdGV4dAogICMgRmllbGRzIHdpdGggdGhlc2UgaW4gdGhlaXIgbmFtZXMgYXJlIHdyaXR0ZW4gYXMgW1JFREFDVEVEXSwgYXMgd2VsbCBhcyBwYXNzd29yZCwgdG9rZW4sIHNlc3Npb24sIGNvb2tpZSBhbmQgdGhlIGxpa2UuCiAgIyByZWRhY3Q6IGNyZWRpdENhcmQsIHNzbgogIGFjY2VzczoKICAgICMgRXZlcnkgcmVxdWVzdCBpcyB3cml0dGVuIGhlcmUgaW4gQ29tYmluZWQgTG9nIEZvcm1hdC4gU2VuZCB0aGUgc2VydmVyIFNJR0hVUCB0byByZW9wZW4gaXQgYWZ0ZXIgcm90YXRpbmcuCiAgICBmaWxlOiBsb2cvYWNjZXNzLmxvZwogICAgZm9ybWF0OiBjb21iaW5lZAoKIyBSZXF1ZXN0IGNvdW50cyBhbmQgbGF0ZW5jaWVzLCB0ZW1wbGF0ZSBlcnJvcnMgYW5kIHF1ZXJ5IHRpbWVzIGluIHRoZSBQcm9tZXRoZXVzIHRleHQgZm9ybWF0LgptZXRyaWNzOgogIGVuYWJsZWQ6IHRydWUKICBwYXRoOiAvbWV0cmljcwogIHBlcm1pc3Npb246IG1ldHJpY3MudmlldwogICMgT3IgbGVhdmUgb3V0IHBhdGggYW5kIHNlcnZlIHRoZSBtZXRyaWNzIG9uIHRoZWlyIG93biBhZGRyZXNzLCBpLmUuIG9uZSBvbmx5IHJlYWNoYWJsZSBmcm9tIHlvdXIgbW9uaXRvcmluZyBuZXR3b3JrLgogICMgbGlzdGVuOiAxMjcuMC4wLjE6OTEwMAoKe3sgaWYgZXEgLmRyaXZlciAibm9uZSIgfX0jIFNlc3Npb25zIGFyZSBrZXB0IGluIGFuIGVuY3J5cHRlZCBjb29raWUuIE9uY2UgdGhlcmUncyBhIGRhdGFiYXNlLCBzZXQgc3RvcmUgdG8gZGIgdG8ga2VlcCB0aGVtIGluIHRoZSBzYXdzaWpfc2Vzc2lvbiB0YWJsZSwKIyBzbyBvbmx5IGFuIElEIGdvZXMgaW4gdGhlIGNvb2tpZSBhbmQgdXNlcnMgY2FuIGJlIGxvZ2dlZCBvdXQgZXZlcnl3aGVyZS4Kc2Vzc2lvbjoKICAjIGNvb2tpZSwgZGIsIGZpbGUgb3IgbWVtb3J5CiAgc3RvcmU6IGNvb2tpZQp7eyBlbHNlIH19IyBTZXNzaW9ucyBhcmUga2VwdCBpbiB0aGUgc2F3c2lqX3Nlc3Npb24gdGFibGUsIHNvIG9ubHkgYW4gSUQgZ29lcyBpbiB0aGUgY29va2llIGFuZCB1c2VycyBjYW4gYmUgbG9nZ2VkIG91dCBldmVyeXdoZXJlLgpzZXNzaW9uOgogICMgY29va2llLCBkYiwgZmlsZSBvciBtZW1vcnkKICBzdG9yZTogZGIKe3sgZW5kIH19ICAjIFNlc3Npb25zIGVuZCBhIHdlZWsgYWZ0ZXIgbG9naW4sIG9yIGFmdGVyIHR3byBob3VycyB3aXRob3V0IGEgcmVxdWVzdCwgd2hpY2hldmVyIGNvbWVzIGZpcnN0LgogIGxpZmV0aW1lOiAxNjhoCiAgaWRsZVRpbWVvdXQ6IDJoCiAgY2xlYW51cEludGVydmFsOiAxMG0KICBjb29raWU6CiAgICBuYW1lOiBzZXNzaW9uCiAgICBwYXRoOiAvCiAgICAjIFNlY3VyZSBpcyBvbiBieSBkZWZhdWx0IHdoZW4gc2VydmVyLnRscyBpcyBzZXQuIFR1cm4gaXQgb24gaGVyZSBpZiBIVFRQUyBpcyBkb25lIGJ5IGEgcHJveHkgaW4gZnJvbnQgb2YgdGhlIHNlcnZlci4KICAgICMgc2VjdXJlOiB0cnVlCiAgICBodHRwT25seTogdHJ1ZQogICAgc2FtZVNpdGU6IGxheAoKZGF0YWJhc2U6CiAgZHJpdmVyOiB7eyAuZHJpdmVyIH19CiAgY29ubmVjdDoge3sgLmNvbm5lY3QgfX0KCmVuY3J5cHRpb246CiAgc2FsdDoge3sgLnNhbHQgfX0KICBrZXk6IHt7IC5rZXkgfX0KICAjIEtleXMgdGhhdCBzaWduIGFuZCBlbmNyeXB0IHNlc3Npb24gY29va2llcywgbmV3ZXN0IGZpcnN0LiAic2F3c2lqY21kIGtleWdlbiIgbWFrZXMgbmV3IG9uZXMuCiAgc2Vzc2lvbktleXM6CiAgICAtIHt7IC5zZXNzaW9uS2V5IH19Cg==",
 		"constants.go.tpl":            "Ly8gQ29weXJpZ2h0IDx5ZWFyPiA8bmFtZT4uIEFsbCByaWdodHMgcmVzZXJ2ZWQuCi8vIFVzZSBvZiB0aGlzIHNvdXJjZSBjb2RlIGlzIGdvdmVybmVkIGJ5IGxpY2Vuc2UgCi8vIHRoYXQgY2FuIGJlIGZvdW5kIGluIHRoZSBMSUNFTlNFIGZpbGUuCgpwYWNrYWdlIHt7IC5uYW1lIH19Cgpjb25zdCgKICAgIFJfTUVNQkVSID0gMgogICAgUl9BRE1JTiA9IDMKKQo=",
 		"dbversions.yaml.tpl":         "IyBDb3B5cmlnaHQgPHllYXI+IDxuYW1lPi4gQWxsIHJpZ2h0cyByZXNlcnZlZC4KIyBVc2Ugb2YgdGhpcyBzb3VyY2UgY29kZSBpcyBnb3Zlcm5lZCBieSBsaWNlbnNlIAojIHRoYXQgY2FuIGJlIGZvdW5kIGluIHRoZSBMSUNFTlNFIGZpbGUuCgpkZWZhdWx0X3NjaGVtYToge3sgLnNjaGVtYSB9fQoKc2NoZW1hX3ZlcnNpb25zOgogICAge3sgLnNjaGVtYSB9fTogMQo=",
-		"denied.html.tpl":             "PCUgdGVtcGxhdGUgImhlYWRlci5odG1sIiAuJT4KPGgxPkFjY2VzcyBEZW5pZWQ8L2gxPgo8JSB0ZW1wbGF0ZSAiZm9vdGVyLmh0bWwiIC4lPg==",
-		"error.html.tpl":              "PCUgdGVtcGxhdGUgImhlYWRlci5odG1sIiAuJT4KPGgxPkVycm9yPC9oMT4KPHA+QW4gYXBwbGljYXRpb24gZXJyb3IgaGFzIG9jY3VyZWQuPC9wPgo8JSB0ZW1wbGF0ZSAiZm9vdGVyLmh0bWwiIC4lPg==",
-		"footer.html.tpl":             "ICA8L2Rpdj48IS0tIC8uY29udGFpbmVyIC0tPgogIDxzY3JpcHQgc3JjPSIvL25ldGRuYS5ib290c3RyYXBjZG4uY29tL2Jvb3RzdHJhcC8zLjAuMC13aXAvanMvYm9vdHN0cmFwLm1pbi5qcyI+PC9zY3JpcHQ+CiAgPHNjcmlwdCBzcmM9Ii8vYWpheC5nb29nbGVhcGlzLmNvbS9hamF4L2xpYnMvanF1ZXJ5LzIuMC4zL2pxdWVyeS5taW4uanMiPjwvc2NyaXB0PiAgCiAgPC9ib2R5Pgo8L2h0bWw+",
-		"header.html.tpl":             "PCFET0NUWVBFIGh0bWw+CjxodG1sIGxhbmc9ImVuIj4KICA8aGVhZD4KICAgIDxtZXRhIGNoYXJzZXQ9InV0Zi04Ij4KICAgIDxtZXRhIG5hbWU9InZpZXdwb3J0IiBjb250ZW50PSJ3aWR0aD1kZXZpY2Utd2lkdGgsIGluaXRpYWwtc2NhbGU9MS4wIj4KICAgIDxtZXRhIG5hbWU9ImRlc2NyaXB0aW9uIiBjb250ZW50PSIiPgogICAgPG1ldGEgbmFtZT0iYXV0aG9yIiBjb250ZW50PSIiPgoKICAgIDx0aXRsZT57ey5uYW1lfX08L3RpdGxlPgoKICAgIDwhLS0gQm9vdHN0cmFwIGNvcmUgQ1NTIC0tPgogICAgPGxpbmsgcmVsPSJzdHlsZXNoZWV0IiBocmVmPSIvL25ldGRuYS5ib290c3RyYXBjZG4uY29tL2Jvb3RzdHJhcC8zLjAuMC13aXAvY3NzL2Jvb3RzdHJhcC5taW4uY3NzIj4KCiAgICA8IS0tIEN1c3RvbSBzdHlsZXMgZm9yIHRoaXMgdGVtcGxhdGUgLS0+CiAgICA8bGluayBocmVmPSIvc3RhdGljL2Nzcy9zaXRlLmNzcyIgcmVsPSJzdHlsZXNoZWV0Ij4KICA8L2hlYWQ+CgogIDxib2R5PgoKICA8ZGl2IGNsYXNzPSJuYXZiYXIgbmF2YmFyLWRlZmF1bHQgbmF2YmFyLWZpeGVkLXRvcCI+CiAgICA8ZGl2IGNsYXNzPSJuYXZiYXItaGVhZGVyIj4KICAgICAgPGEgY2xhc3M9Im5hdmJhci1icmFuZCIgaHJlZj0iLyI+e3submFtZX19PC9hPiAgICAgIAogICAgPC9kaXY+CiAgICAgIDx1bCBjbGFzcz0ibmF2IG5hdmJhci1uYXYgbmF2YmFyLXJpZ2h0Ij4KICAgICAgPCUgaWYgLmdsb2JhbC51c2VyICU+ICAKICAgICAgICA8JSBpZiBlcXVhbCAuZ2xvYmFsLnVzZXIuUm9sZSAuZ2xvYmFsLnJvbGVzLmFkbWluICU+ICAKICAgICAgICA8bGk+PGEgaHJlZj0iL2FkbWluIj5BZG1pbjwvYT48L2xpPgogICAgICAgIDwlIGVuZCAlPiAgICAgICAgICAgICAgCiAgICAgIDxsaT48cCBjbGFzcz0ibmF2YmFyLXRleHQiPkxvZ2dlZCBpbiBhcyA8c3Ryb25nPjwlIC5nbG9iYWwudXNlci5Vc2VybmFtZSAlPjwvc3Ryb25nPjwvcD48L2xpPgogICAgICA8bGk+PGEgaHJlZj0iL2xvZ291dCI+TG9nIE91dDwvYT48L2xpPiAKICAgICAgPCUgZWxzZSAlPgogICAgICA8bGk+PGEgaHJlZj0iL2xvZ2luIj5Mb2cgSW48L2E+PC9saT4KICAgICAgPCUgZW5kICU+CiAgICAgIDwvdWw+CiAgPC9kaXY+CiAgPGRpdiBjbGFzcz0iY29udGFpbmVyIj4KICA8JSB0ZW1wbGF0ZSAibWVzc2FnZXMuaHRtbCIgLiU+",
-		"index.html.tpl":              "PCUgdGVtcGxhdGUgImhlYWRlci5odG1sIiAuJT4KCjxkaXYgY2xhc3M9Imp1bWJvdHJvbiI+CiA8aDE+V2VsY29tZSE8L2gxPgogIDxwPllvdXIgbmV3IHNhd3NpaiBhcHBsaWNhdGlvbiBpcyB1cCBhbmQgcnVubmluZy48L3A+CjwvZGl2PgoKPGRpdiBjbGFzcz0icm93Ij4KCgk8ZGl2IGNsYXNzPSJzcGFuNiI+CgkJPGgyPktleSBGaWxlczwvaDI+CgkJPHA+SGVyZSdzIGEgbGlzdCBvZiBzb21lIGtleSBmaWxlcyBhbmQgZGlyZWN0b3JpZXMgaW4geW91ciBhcHBsaWNhdGlvbi48L3A+CgoJCTx1bD4KCQkJPGxpPjxiPnNyYy97ey5uYW1lfX1zZXJ2ZXIve3sgLm5hbWUgfX1zZXJ2ZXIuZ288L2I+PGJyIC8+CgkJCQlUaGUgbWFpbiBhcHBsaWNhdGlvbiBzZXJ2ZXIgc291cmNlLiBUaGlzIGlzIHdoZXJlIHRoZSA8Yj5tYWluKCk8L2I+IGZ1bmN0aW9uIGlzLgoJCQkJR2VuZXJhbGx5LCB0aGlzIGlzIHdoZXJlIHlvdSdsbCBhZGQgcm91dGVzIGFuZCBoYW5kbGVycy4KCQkJPC9saT4KCQkJPGxpPjxiPmV0Yy9jb25maWcueWFtbDwvYj48YnIgLz4KCQkJCVRoZSBwcmltYXJ5IGNvbmZpZ3VyYXRpb24gZmlsZS4gQ29udHJvbHMgdGhpbmdzIGxpa2Ugd2hhdCBwb3J0IHlvdXIgYXBwIGFuc3dlcnMgb24KCQkJCWFuZCB5b3VyIGRhdGFiYXNlIHBhcmFtZXRlcnMuCgkJCTwvbGk+CgkJCTxsaT48Yj50ZW1wbGF0ZXMvPC9iPjxiciAvPgoJCQkJVGhlIGh0bWwgdGVtcGxhdGVzIGZvciB5b3VyIGFwcGxpY2F0aW9uLiBUaGUgdGVtcGxhdGUgZmlsZXMgYXJlIG5hbWVkIGFjY29yZGluZyB0byB0aGUgVVJMIHBhdHRlcm4gZm9yIHRoZSByb3V0ZS4KCQkJPC9saT4KCQkJPGxpPjxiPnN0YXRpYy88L2I+PGJyIC8+CgkJCQlXaGVyZSBzdGF0aWMgY29udGVudCBsaXZlcy4gVGhpbmdzIGxpa2UgaW1hZ2VzLCBDU1MgZmlsZXMgYW5kIEphdmFzY3JpcHQuCgkJCTwvbGk+CgkJCTxsaT48Yj50ZW1wbGF0ZXMvaW5kZXguaHRtbDwvYj48YnIgLz4KCQkJCVRoZSBodG1sIHRlbXBsYXRlIGZvciB0aGUgcGFnZSB5b3UncmUgY3VycmVudGx5IHZpZXdpbmcuIFlvdSBjYW4gZGVsZXRlIHRoZSBjb250ZW50cyBhbmQgcmVwbGFjZSBpdCB3aXRoIHlvdXIgb3duLgoJCQk8L2xpPgkJCQoJCTwvdWw+Cgk8L2Rpdj4KCTxkaXYgY2xhc3M9InNwYW42Ij4JCQoJCTxoMj5Eb2N1bWVudGF0aW9uPC9oMj4KCQk8cD5IZXJlJ3MgYWxsIHRoZSByZWxldmFudCBkb2N1bWVudGF0aW9uLjwvcD4KCQk8bGk+PGEgaHJlZj0iaHR0cHM6Ly9iaXRidWNrZXQub3JnL2pheWJpbGwvc2F3c2lqL3dpa2kvSG9tZSI+RG9jdW1lbnRhdGlvbiBXaWtpPC9hPjwvbGk+CgkJPGxpPjxhIGhyZWY9Imh0dHA6Ly9nby5wa2dkb2Mub3JnL2JpdGJ1Y2tldC5vcmcvamF5YmlsbC9zYXdzaWovZnJhbWV3b3JrIj5BUEkgRG9jdW1lbnRhdGlvbjwvYT48L2xpPgoJCTxsaT48YSBocmVmPSJodHRwOi8vZ29sYW5nLm9yZy9yZWYvIj5HbyBEb2N1bWVudGF0aW9uPC9hPjwvbGk+CgkJPGxpPjxhIGhyZWY9Imh0dHA6Ly9nb2xhbmcub3JnL3BrZy90ZXh0L3RlbXBsYXRlLyI+VGVtcGxhdGUgRG9jdW1lbnRhdGlvbjwvYT48L2xpPgoJCTxsaT48YSBocmVmPSJodHRwOi8vZ2V0Ym9vdHN0cmFwLmNvbS8iPkJvb3RzdHJhcDwvYT48L2xpPgoJPC9kaXY+CQo8L2Rpdj4KCgo8JSB0ZW1wbGF0ZSAiZm9vdGVyLmh0bWwiIC4lPg==",
+		"denied.html.tpl":             "PCUgbGF5b3V0ICJsYXlvdXRzL21haW4uaHRtbCIgJT4KPCUgZGVmaW5lICJjb250ZW50IiAlPgo8aDE+QWNjZXNzIERlbmllZDwvaDE+CjwlIGVuZCAlPg==",
+		"error.html.tpl":              "PCUgbGF5b3V0ICJsYXlvdXRzL21haW4uaHRtbCIgJT4KPCUgZGVmaW5lICJjb250ZW50IiAlPgo8aDE+RXJyb3I8JSBpZiAuc3RhdHVzICU+IDwlIC5zdGF0dXMgJT48JSBlbmQgJT48L2gxPgo8JSBpZiAubWVzc2FnZSAlPjxwPjwlIC5tZXNzYWdlICU+PC9wPjwlIGVsc2UgJT48cD5BbiBhcHBsaWNhdGlvbiBlcnJvciBoYXMgb2NjdXJlZC48L3A+PCUgZW5kICU+CjwlIGlmIC5maWVsZHMgJT4KPHVsPgoJPCUgcmFuZ2UgJGZpZWxkLCAkbWVzc2FnZSA6PSAuZmllbGRzICU+Cgk8bGk+PHN0cm9uZz48JSAkZmllbGQgJT48L3N0cm9uZz46IDwlICRtZXNzYWdlICU+PC9saT4KCTwlIGVuZCAlPgo8L3VsPgo8JSBlbmQgJT4KPCUgZW5kICU+",
+		"index.html.tpl":              "PCUgbGF5b3V0ICJsYXlvdXRzL21haW4uaHRtbCIgJT4KPCUgZGVmaW5lICJjb250ZW50IiAlPgoKPGRpdiBjbGFzcz0ianVtYm90cm9uIj4KIDxoMT5XZWxjb21lITwvaDE+CiAgPHA+WW91ciBuZXcgc2F3c2lqIGFwcGxpY2F0aW9uIGlzIHVwIGFuZCBydW5uaW5nLjwvcD4KPC9kaXY+Cgo8ZGl2IGNsYXNzPSJyb3ciPgoKCTxkaXYgY2xhc3M9InNwYW42Ij4KCQk8aDI+S2V5IEZpbGVzPC9oMj4KCQk8cD5IZXJlJ3MgYSBsaXN0IG9mIHNvbWUga2V5IGZpbGVzIGFuZCBkaXJlY3RvcmllcyBpbiB5b3VyIGFwcGxpY2F0aW9uLjwvcD4KCgkJPHVsPgoJCQk8bGk+PGI+c3JjL3t7Lm5hbWV9fXNlcnZlci97eyAubmFtZSB9fXNlcnZlci5nbzwvYj48YnIgLz4KCQkJCVRoZSBtYWluIGFwcGxpY2F0aW9uIHNlcnZlciBzb3VyY2UuIFRoaXMgaXMgd2hlcmUgdGhlIDxiPm1haW4oKTwvYj4gZnVuY3Rpb24gaXMuCgkJCQlHZW5lcmFsbHksIHRoaXMgaXMgd2hlcmUgeW91J2xsIGFkZCByb3V0ZXMgYW5kIGhhbmRsZXJzLgoJCQk8L2xpPgoJCQk8bGk+PGI+ZXRjL2NvbmZpZy55YW1sPC9iPjxiciAvPgoJCQkJVGhlIHByaW1hcnkgY29uZmlndXJhdGlvbiBmaWxlLiBDb250cm9scyB0aGluZ3MgbGlrZSB3aGF0IHBvcnQgeW91ciBhcHAgYW5zd2VycyBvbgoJCQkJYW5kIHlvdXIgZGF0YWJhc2UgcGFyYW1ldGVycy4KCQkJPC9saT4KCQkJPGxpPjxiPnRlbXBsYXRlcy88L2I+PGJyIC8+CgkJCQlUaGUgaHRtbCB0ZW1wbGF0ZXMgZm9yIHlvdXIgYXBwbGljYXRpb24uIFRoZSB0ZW1wbGF0ZSBmaWxlcyBhcmUgbmFtZWQgYWNjb3JkaW5nIHRvIHRoZSBVUkwgcGF0dGVybiBmb3IgdGhlIHJvdXRlLgoJCQk8L2xpPgoJCQk8bGk+PGI+c3RhdGljLzwvYj48YnIgLz4KCQkJCVdoZXJlIHN0YXRpYyBjb250ZW50IGxpdmVzLiBUaGluZ3MgbGlrZSBpbWFnZXMsIENTUyBmaWxlcyBhbmQgSmF2YXNjcmlwdC4KCQkJPC9saT4KCQkJPGxpPjxiPnRlbXBsYXRlcy9sYXlvdXRzL21haW4uaHRtbDwvYj48YnIgLz4KCQkJCVRoZSBsYXlvdXQgdGhhdCBwYWdlcyBhcmUgcmVuZGVyZWQgaW4uIEVhY2ggcGFnZSBuYW1lcyBpdHMgbGF5b3V0IG9uIGl0cyBmaXJzdCBsaW5lIGFuZCBmaWxscyBpbiB0aGUgImNvbnRlbnQiIGJsb2NrLgoJCQk8L2xpPgoJCQk8bGk+PGI+dGVtcGxhdGVzL2luZGV4Lmh0bWw8L2I+PGJyIC8+CgkJCQlUaGUgaHRtbCB0ZW1wbGF0ZSBmb3IgdGhlIHBhZ2UgeW91J3JlIGN1cnJlbnRseSB2aWV3aW5nLiBZb3UgY2FuIGRlbGV0ZSB0aGUgY29udGVudHMgYW5kIHJlcGxhY2UgaXQgd2l0aCB5b3VyIG93bi4KCQkJPC9saT4JCQkKCQk8L3VsPgoJPC9kaXY+Cgk8ZGl2IGNsYXNzPSJzcGFuNiI+CQkKCQk8aDI+RG9jdW1lbnRhdGlvbjwvaDI+CgkJPHA+SGVyZSdzIGFsbCB0aGUgcmVsZXZhbnQgZG9jdW1lbnRhdGlvbi48L3A+CgkJPGxpPjxhIGhyZWY9Imh0dHBzOi8vYml0YnVja2V0Lm9yZy9qYXliaWxsL3Nhd3Npai93aWtpL0hvbWUiPkRvY3VtZW50YXRpb24gV2lraTwvYT48L2xpPgoJCTxsaT48YSBocmVmPSJodHRwOi8vZ28ucGtnZG9jLm9yZy9iaXRidWNrZXQub3JnL2pheWJpbGwvc2F3c2lqL2ZyYW1ld29yayI+QVBJIERvY3VtZW50YXRpb248L2E+PC9saT4KCQk8bGk+PGEgaHJlZj0iaHR0cDovL2dvbGFuZy5vcmcvcmVmLyI+R28gRG9jdW1lbnRhdGlvbjwvYT48L2xpPgoJCTxsaT48YSBocmVmPSJodHRwOi8vZ29sYW5nLm9yZy9wa2cvdGV4dC90ZW1wbGF0ZS8iPlRlbXBsYXRlIERvY3VtZW50YXRpb248L2E+PC9saT4KCQk8bGk+PGEgaHJlZj0iaHR0cDovL2dldGJvb3RzdHJhcC5jb20vIj5Cb290c3RyYXA8L2E+PC9saT4KCTwvZGl2PgkKPC9kaXY+CgoKPCUgZW5kICU+",
+		"layout.html.tpl":             "PCFET0NUWVBFIGh0bWw+CjxodG1sIGxhbmc9ImVuIj4KICA8aGVhZD4KICAgIDxtZXRhIGNoYXJzZXQ9InV0Zi04Ij4KICAgIDxtZXRhIG5hbWU9InZpZXdwb3J0IiBjb250ZW50PSJ3aWR0aD1kZXZpY2Utd2lkdGgsIGluaXRpYWwtc2NhbGU9MS4wIj4KICAgIDxtZXRhIG5hbWU9ImRlc2NyaXB0aW9uIiBjb250ZW50PSIiPgogICAgPG1ldGEgbmFtZT0iYXV0aG9yIiBjb250ZW50PSIiPgoKICAgIDx0aXRsZT57ey5uYW1lfX08L3RpdGxlPgoKICAgIDwhLS0gQm9vdHN0cmFwIGNvcmUgQ1NTIC0tPgogICAgPGxpbmsgcmVsPSJzdHlsZXNoZWV0IiBocmVmPSIvL25ldGRuYS5ib290c3RyYXBjZG4uY29tL2Jvb3RzdHJhcC8zLjAuMC13aXAvY3NzL2Jvb3RzdHJhcC5taW4uY3NzIj4KCiAgICA8IS0tIEN1c3RvbSBzdHlsZXMgZm9yIHRoaXMgdGVtcGxhdGUgLS0+CiAgICA8bGluayBocmVmPSI8JSBhc3NldCAiY3NzL3NpdGUuY3NzIiAlPiIgcmVsPSJzdHlsZXNoZWV0Ij4KICA8L2hlYWQ+CgogIDxib2R5PgoKICA8ZGl2IGNsYXNzPSJuYXZiYXIgbmF2YmFyLWRlZmF1bHQgbmF2YmFyLWZpeGVkLXRvcCI+CiAgICA8ZGl2IGNsYXNzPSJuYXZiYXItaGVhZGVyIj4KICAgICAgPGEgY2xhc3M9Im5hdmJhci1icmFuZCIgaHJlZj0iLyI+e3submFtZX19PC9hPiAgICAgIAogICAgPC9kaXY+CiAgICAgIDx1bCBjbGFzcz0ibmF2IG5hdmJhci1uYXYgbmF2YmFyLXJpZ2h0Ij4KICAgICAgPCUgaWYgLmdsb2JhbC51c2VyICU+ICAKICAgICAgICA8JSBpZiBjYW4gLiAiYWRtaW4udmlldyIgJT4gIAogICAgICAgIDxsaT48YSBocmVmPSIvYWRtaW4iPkFkbWluPC9hPjwvbGk+CiAgICAgICAgPCUgZW5kICU+ICAgICAgICAgICAgICAKICAgICAgPGxpPjxwIGNsYXNzPSJuYXZiYXItdGV4dCI+TG9nZ2VkIGluIGFzIDxzdHJvbmc+PCUgLmdsb2JhbC51c2VyLlVzZXJuYW1lICU+PC9zdHJvbmc+PC9wPjwvbGk+CiAgICAgIDxsaT48YSBocmVmPSIvbG9nb3V0Ij5Mb2cgT3V0PC9hPjwvbGk+IAogICAgICA8JSBlbHNlICU+CiAgICAgIDxsaT48YSBocmVmPSIvbG9naW4iPkxvZyBJbjwvYT48L2xpPgogICAgICA8JSBlbmQgJT4KICAgICAgPC91bD4KICA8L2Rpdj4KICA8ZGl2IGNsYXNzPSJjb250YWluZXIiPgogIDwlIHRlbXBsYXRlICJtZXNzYWdlcy5odG1sIiAuJT4KICA8JSBibG9jayAiY29udGVudCIgLiAlPjwlIGVuZCAlPgogIDwvZGl2PjwhLS0gLy5jb250YWluZXIgLS0+CiAgPHNjcmlwdCBzcmM9Ii8vbmV0ZG5hLmJvb3RzdHJhcGNkbi5jb20vYm9vdHN0cmFwLzMuMC4wLXdpcC9qcy9ib290c3RyYXAubWluLmpzIj48L3NjcmlwdD4KICA8c2NyaXB0IHNyYz0iLy9hamF4Lmdvb2dsZWFwaXMuY29tL2FqYXgvbGlicy9qcXVlcnkvMi4wLjMvanF1ZXJ5Lm1pbi5qcyI+PC9zY3JpcHQ+ICAKICA8L2JvZHk+CjwvaHRtbD4=",
 		"license.tpl":                 "VGhpcyBmaWxlIHNob3VsZCBjb250YWluIHlvdXIgbGljZW5zZSB0ZXJtcy4K",
-		"login.html.tpl":              "PCUgdGVtcGxhdGUgImhlYWRlci5odG1sIiAuICU+CgogPGRpdiBjbGFzcz0icm93Ij4KICA8ZGl2IGNsYXNzPSJjb2wtbWQtNCI+CiAgPGZvcm0gY2xhc3M9IiIgbWV0aG9kPSJwb3N0IiBhY3Rpb249Ii9sb2dpbiIgcm9sZT0iZm9ybSI+ICAKICAgIAogICAgPGRpdiBjbGFzcz0iZm9ybS1ncm91cCI+CiAgICAgIDxsYWJlbCBmb3I9InVzZXJuYW1lIiBjbGFzcz0iY29udHJvbC1sYWJlbCI+VXNlcm5hbWU8L2xhYmVsPiAgICAgICAgICAgIAogICAgICA8aW5wdXQgdHlwZT0idGV4dCIgY2xhc3M9ImZvcm0tY29udHJvbCIgbmFtZT0idXNlcm5hbWUiIGlkPSJ1c2VybmFtZSIgPCVpZiAudXNlcm5hbWUgJT52YWx1ZT0iPCUgLnVzZXJuYW1lICU+IjwlIGVuZCAlPiA+ICAgICAgICAgICAgICAKICAgIDwvZGl2PgoKICAgIDxkaXYgY2xhc3M9ImZvcm0tZ3JvdXAiPgogICAgICA8bGFiZWwgZm9yPSJwYXNzd29yZCIgY2xhc3M9ImNvbnRyb2wtbGFiZWwiPlBhc3N3b3JkPC9sYWJlbD4gICAgICAgICAgCiAgICAgIDxpbnB1dCB0eXBlPSJwYXNzd29yZCIgY2xhc3M9ImZvcm0tY29udHJvbCIgbmFtZT0icGFzc3dvcmQiIGlkPSJwYXNzd29yZCI+ICAgICAgICAgICAgICAgICAgCiAgICA8L2Rpdj4KCiAgPCUgaWYgLmRlc3QgJT48aW5wdXQgdHlwZT0iaGlkZGVuIiBpZD0iZGVzdCIgbmFtZT0iZGVzdCIgdmFsdWU9IjwlIC5kZXN0ICU+Ii8+PCUgZW5kICU+IAogIAogIDxkaXYgY2xhc3M9ImZvcm0tZ3JvdXAiPiAgICAKICAgIDxidXR0b24gdHlwZT0ic3VibWl0IiBjbGFzcz0iYnRuIGJ0bi1wcmltYXJ5Ij5Mb2cgSW48L2J1dHRvbj4gICAgCiAgPC9kaXY+CgogIDwvZGl2PgogIDxkaXYgY2xhc3M9ImNvbC1tZC04Ij4KICA8L2Rpdj4KPC9mb3JtPiAKCjwlIHRlbXBsYXRlICJmb290ZXIuaHRtbCIgLiAlPg==",
+		"login.html.tpl":              "PCUgbGF5b3V0ICJsYXlvdXRzL21haW4uaHRtbCIgJT4KPCUgZGVmaW5lICJjb250ZW50IiAlPgoKIDxkaXYgY2xhc3M9InJvdyI+CiAgPGRpdiBjbGFzcz0iY29sLW1kLTQiPgogIDxmb3JtIGNsYXNzPSIiIG1ldGhvZD0icG9zdCIgYWN0aW9uPSIvbG9naW4iIHJvbGU9ImZvcm0iPiAgCiAgICA8JSBjc3JmRmllbGQgLiAlPgoKICAgIDwlIGlmIC5jb25maXJtICU+PHA+UGxlYXNlIGVudGVyIHlvdXIgcGFzc3dvcmQgYWdhaW4gdG8gY29udGludWUuPC9wPjwlIGVuZCAlPgogICAgCiAgICA8ZGl2IGNsYXNzPSJmb3JtLWdyb3VwIj4KICAgICAgPGxhYmVsIGZvcj0idXNlcm5hbWUiIGNsYXNzPSJjb250cm9sLWxhYmVsIj5Vc2VybmFtZTwvbGFiZWw+ICAgICAgICAgICAgCiAgICAgIDxpbnB1dCB0eXBlPSJ0ZXh0IiBjbGFzcz0iZm9ybS1jb250cm9sIiBuYW1lPSJ1c2VybmFtZSIgaWQ9InVzZXJuYW1lIiA8JWlmIC51c2VybmFtZSAlPnZhbHVlPSI8JSAudXNlcm5hbWUgJT4iPCUgZW5kICU+ID4gICAgICAgICAgICAgIAogICAgPC9kaXY+CgogICAgPGRpdiBjbGFzcz0iZm9ybS1ncm91cCI+CiAgICAgIDxsYWJlbCBmb3I9InBhc3N3b3JkIiBjbGFzcz0iY29udHJvbC1sYWJlbCI+UGFzc3dvcmQ8L2xhYmVsPiAgICAgICAgICAKICAgICAgPGlucHV0IHR5cGU9InBhc3N3b3JkIiBjbGFzcz0iZm9ybS1jb250cm9sIiBuYW1lPSJwYXNzd29yZCIgaWQ9InBhc3N3b3JkIj4gICAgICAgICAgICAgICAgICAKICAgIDwvZGl2PgoKICA8JSBpZiAuZGVzdCAlPjxpbnB1dCB0eXBlPSJoaWRkZW4iIGlkPSJkZXN0IiBuYW1lPSJkZXN0IiB2YWx1ZT0iPCUgLmRlc3QgJT4iLz48JSBlbmQgJT4gCiAgCiAgPGRpdiBjbGFzcz0iZm9ybS1ncm91cCI+ICAgIAogICAgPGJ1dHRvbiB0eXBlPSJzdWJtaXQiIGNsYXNzPSJidG4gYnRuLXByaW1hcnkiPkxvZyBJbjwvYnV0dG9uPiAgICAKICA8L2Rpdj4KCiAgPC9kaXY+CiAgPGRpdiBjbGFzcz0iY29sLW1kLTgiPgogIDwvZGl2Pgo8L2Zvcm0+IAoKPCUgZW5kICU+",
 		"messages.html.tpl":           "PCVpZiAuaW5mbyAlPjxkaXYgY2xhc3M9ImFsZXJ0IGFsZXJ0LWluZm8iPjwlIC5pbmZvICU+PC9kaXY+PCUgZW5kICU+CjwlaWYgLnN1Y2Nlc3MgJT48ZGl2IGNsYXNzPSJhbGVydCBhbGVydC1zdWNjZXNzIj48JSAuc3VjY2VzcyAlPjwvZGl2PjwlIGVuZCAlPgo8JWlmIC5lcnJvcnMgJT4KCTwlcmFuZ2UgJGVycm9yIDo9IC5lcnJvcnMlPgoJPGRpdiBjbGFzcz0iYWxlcnQgYWxlcnQtZGFuZ2VyIj48JSAkZXJyb3IgJT48L2Rpdj4KCTwlIGVuZCAlPgo8JSBlbmQgJT4K",
-		"mysql_0001.sql.tpl":          "Q1JFQVRFIFRBQkxFIGB7eyAuc2NoZW1hIH19X3Nhd3Npal9kYl92ZXJzaW9uYCAoCglgdmVyc2lvbl9pZGAgQklHSU5UIE5PVCBOVUxMLAoJYHJhbl9vbmAgREFURVRJTUUgTlVMTCwKCVBSSU1BUlkgS0VZIChgdmVyc2lvbl9pZGApCik7CgpJTlNFUlQgSU5UTyBge3sgLnNjaGVtYSB9fV9zYXdzaWpfZGJfdmVyc2lvbmAgKGB2ZXJzaW9uX2lkYCkKVkFMVUVTCgkoMSk7CgpDUkVBVEUgVEFCTEUgYHt7IC5zY2hlbWEgfX1fdXNlcmAgKAoJYGlkYCBCSUdJTlQgTk9UIE5VTEwgQVVUT19JTkNSRU1FTlQsCglgdXNlcm5hbWVgIFZBUkNIQVIgKDY0KSBOT1QgTlVMTCwKCWBwYXNzd29yZF9oYXNoYCB0ZXh0IE5PVCBOVUxMLAoJYGZ1bGxfbmFtZWAgdGV4dCBOT1QgTlVMTCwKCWBlbWFpbGAgdGV4dCBOVUxMLAoJYGNyZWF0ZWRfb25gIERBVEVUSU1FIE5VTEwsCglgcm9sZWAgSU5UIE5VTEwsCglQUklNQVJZIEtFWSAoYGlkYCkKKTsKCkFMVEVSIFRBQkxFIGB7eyAuc2NoZW1hIH19X3VzZXJgIEFERCBDT05TVFJBSU5UIGBVTklRVUVfdXNlcl8xYCBVTklRVUUgKGB1c2VybmFtZWApOwoKSU5TRVJUIElOVE8gIGB7eyAuc2NoZW1hIH19X3VzZXJgICh1c2VybmFtZSwgcGFzc3dvcmRfaGFzaCwgZnVsbF9uYW1lLCBlbWFpbCwgY3JlYXRlZF9vbiwgcm9sZSkgCglWQUxVRVMgKCdhZG1pbicsJ3t7IC5wYXNzd29yZF9oYXNoIH19JywgJ0FkbWluaXN0cmF0b3InLCd7eyAuYWRtaW5fZW1haWwgfX0nICwgbm93KCksIDMpOw==",
+		"mysql_0001.sql.tpl":          "Q1JFQVRFIFRBQkxFIGB7eyAuc2NoZW1hIH19X3Nhd3Npal9kYl92ZXJzaW9uYCAoCglgdmVyc2lvbl9pZGAgQklHSU5UIE5PVCBOVUxMLAoJYHJhbl9vbmAgREFURVRJTUUgTlVMTCwKCVBSSU1BUlkgS0VZIChgdmVyc2lvbl9pZGApCik7CgpJTlNFUlQgSU5UTyBge3sgLnNjaGVtYSB9fV9zYXdzaWpfZGJfdmVyc2lvbmAgKGB2ZXJzaW9uX2lkYCkKVkFMVUVTCgkoMSk7CgpDUkVBVEUgVEFCTEUgYHt7IC5zY2hlbWEgfX1fc2F3c2lqX3JhdGVfbGltaXRgICgKCWBidWNrZXRgIFZBUkNIQVIgKDI1NSkgTk9UIE5VTEwsCglgdG9rZW5zYCBET1VCTEUgTk9UIE5VTEwsCglgdXBkYXRlZF9vbmAgQklHSU5UIE5PVCBOVUxMLAoJUFJJTUFSWSBLRVkgKGBidWNrZXRgKQopOwoKQ1JFQVRFIFRBQkxFIGB7eyAuc2NoZW1hIH19X3Nhd3Npal9zZXNzaW9uYCAoCglgaWRgIFZBUkNIQVIgKDY0KSBOT1QgTlVMTCwKCWB1c2VybmFtZWAgVkFSQ0hBUiAoMjU1KSBOT1QgTlVMTCwKCWBkYXRhYCBNRURJVU1URVhUIE5PVCBOVUxMLAoJYGV4cGlyZXNfb25gIEJJR0lOVCBOT1QgTlVMTCwKCVBSSU1BUlkgS0VZIChgaWRgKSwKCUlOREVYIGBzYXdzaWpfc2Vzc2lvbl91c2VybmFtZWAgKGB1c2VybmFtZWApCik7CgpDUkVBVEUgVEFCTEUgYHt7IC5zY2hlbWEgfX1fdXNlcmAgKAoJYGlkYCBCSUdJTlQgTk9UIE5VTEwgQVVUT19JTkNSRU1FTlQsCglgdXNlcm5hbWVgIFZBUkNIQVIgKDY0KSBOT1QgTlVMTCwKCWBwYXNzd29yZF9oYXNoYCB0ZXh0IE5PVCBOVUxMLAoJYGZ1bGxfbmFtZWAgdGV4dCBOT1QgTlVMTCwKCWBlbWFpbGAgdGV4dCBOVUxMLAoJYGNyZWF0ZWRfb25gIERBVEVUSU1FIE5VTEwsCglgcm9sZWAgSU5UIE5VTEwsCglQUklNQVJZIEtFWSAoYGlkYCkKKTsKCkFMVEVSIFRBQkxFIGB7eyAuc2NoZW1hIH19X3VzZXJgIEFERCBDT05TVFJBSU5UIGBVTklRVUVfdXNlcl8xYCBVTklRVUUgKGB1c2VybmFtZWApOwoKSU5TRVJUIElOVE8gIGB7eyAuc2NoZW1hIH19X3VzZXJgICh1c2VybmFtZSwgcGFzc3dvcmRfaGFzaCwgZnVsbF9uYW1lLCBlbWFpbCwgY3JlYXRlZF9vbiwgcm9sZSkgCglWQUxVRVMgKCdhZG1pbicsJ3t7IC5wYXNzd29yZF9oYXNoIH19JywgJ0FkbWluaXN0cmF0b3InLCd7eyAuYWRtaW5fZW1haWwgfX0nICwgbm93KCksIDMpOw==",
 		"mysql_views.sql.tpl":         "LyogVGhpcyBpcyB3aGVyZSBzcWwgdmlld3MgZ28uICovCg==",
-		"postgres_0001.sql.tpl":       "Q1JFQVRFIFRBQkxFICJ7eyAuc2NoZW1hIH19Ii4ic2F3c2lqX2RiX3ZlcnNpb24iICgKICAgICJ2ZXJzaW9uX2lkIiBpbnQ4IE5PVCBOVUxMLAogICAgInJhbl9vbiIgdGltZXN0YW1wIE5VTEwgZGVmYXVsdCBub3coKSwKICAgIFBSSU1BUlkgS0VZKCJ2ZXJzaW9uX2lkIikKKTsKCklOU0VSVCBJTlRPICJ7eyAuc2NoZW1hIH19Ii4ic2F3c2lqX2RiX3ZlcnNpb24iICgidmVyc2lvbl9pZCIpIFZBTFVFUyAoMSk7CgpDUkVBVEUgVEFCTEUgInt7IC5zY2hlbWEgfX0iLiJ1c2VyIiAgKCAKCSJpZCIgICAgICAgICAgIAlzZXJpYWwgTk9UIE5VTEwsCgkidXNlcm5hbWUiICAgICAJdmFyY2hhcig2NCkgTk9UIE5VTEwsCgkicGFzc3dvcmRfaGFzaCIJdGV4dCBOT1QgTlVMTCwKCSJmdWxsX25hbWUiICAgIAl0ZXh0IE5PVCBOVUxMLAoJImVtYWlsIiAgICAgICAgCXRleHQgTlVMTCwKCSJjcmVhdGVkX29uIiAgIAl0aW1lc3RhbXAgTlVMTCwKCSJyb2xlIiAgICAgICAgIAlpbnQgTlVMTCwKCVBSSU1BUlkgS0VZKCJpZCIpCik7CgpBTFRFUiBUQUJMRSAie3sgLnNjaGVtYSB9fSIuInVzZXIiCglBREQgQ09OU1RSQUlOVCAiVU5JUVVFX3VzZXJfMSIKCVVOSVFVRSAoInVzZXJuYW1lIik7CgpJTlNFUlQgSU5UTyAgInt7IC5zY2hlbWEgfX0iLiJ1c2VyIih1c2VybmFtZSwgcGFzc3dvcmRfaGFzaCwgZnVsbF9uYW1lLCBlbWFpbCwgY3JlYXRlZF9vbiwgcm9sZSkgCglWQUxVRVMgKCdhZG1pbicsJ3t7IC5wYXNzd29yZF9oYXNoIH19JywgJ0FkbWluaXN0cmF0b3InLCd7eyAuYWRtaW5fZW1haWwgfX0nICwgbm93KCksIDMpOw==",
+		"postgres_0001.sql.tpl":       "Q1JFQVRFIFRBQkxFICJ7eyAuc2NoZW1hIH19Ii4ic2F3c2lqX2RiX3ZlcnNpb24iICgKICAgICJ2ZXJzaW9uX2lkIiBpbnQ4IE5PVCBOVUxMLAogICAgInJhbl9vbiIgdGltZXN0YW1wIE5VTEwgZGVmYXVsdCBub3coKSwKICAgIFBSSU1BUlkgS0VZKCJ2ZXJzaW9uX2lkIikKKTsKCklOU0VSVCBJTlRPICJ7eyAuc2NoZW1hIH19Ii4ic2F3c2lqX2RiX3ZlcnNpb24iICgidmVyc2lvbl9pZCIpIFZBTFVFUyAoMSk7CgpDUkVBVEUgVEFCTEUgInt7IC5zY2hlbWEgfX0iLiJzYXdzaWpfcmF0ZV9saW1pdCIgKAogICAgImJ1Y2tldCIgdmFyY2hhcigyNTUpIE5PVCBOVUxMLAogICAgInRva2VucyIgZG91YmxlIHByZWNpc2lvbiBOT1QgTlVMTCwKICAgICJ1cGRhdGVkX29uIiBpbnQ4IE5PVCBOVUxMLAogICAgUFJJTUFSWSBLRVkoImJ1Y2tldCIpCik7CgpDUkVBVEUgVEFCTEUgInt7IC5zY2hlbWEgfX0iLiJzYXdzaWpfc2Vzc2lvbiIgKAogICAgImlkIiB2YXJjaGFyKDY0KSBOT1QgTlVMTCwKICAgICJ1c2VybmFtZSIgdmFyY2hhcigyNTUpIE5PVCBOVUxMLAogICAgImRhdGEiIHRleHQgTk9UIE5VTEwsCiAgICAiZXhwaXJlc19vbiIgaW50OCBOT1QgTlVMTCwKICAgIFBSSU1BUlkgS0VZKCJpZCIpCik7CgpDUkVBVEUgSU5ERVggInNhd3Npal9zZXNzaW9uX3VzZXJuYW1lIiBPTiAie3sgLnNjaGVtYSB9fSIuInNhd3Npal9zZXNzaW9uIiAoInVzZXJuYW1lIik7CgpDUkVBVEUgVEFCTEUgInt7IC5zY2hlbWEgfX0iLiJ1c2VyIiAgKCAKCSJpZCIgICAgICAgICAgIAlzZXJpYWwgTk9UIE5VTEwsCgkidXNlcm5hbWUiICAgICAJdmFyY2hhcig2NCkgTk9UIE5VTEwsCgkicGFzc3dvcmRfaGFzaCIJdGV4dCBOT1QgTlVMTCwKCSJmdWxsX25hbWUiICAgIAl0ZXh0IE5PVCBOVUxMLAoJImVtYWlsIiAgICAgICAgCXRleHQgTlVMTCwKCSJjcmVhdGVkX29uIiAgIAl0aW1lc3RhbXAgTlVMTCwKCSJyb2xlIiAgICAgICAgIAlpbnQgTlVMTCwKCVBSSU1BUlkgS0VZKCJpZCIpCik7CgpBTFRFUiBUQUJMRSAie3sgLnNjaGVtYSB9fSIuInVzZXIiCglBREQgQ09OU1RSQUlOVCAiVU5JUVVFX3VzZXJfMSIKCVVOSVFVRSAoInVzZXJuYW1lIik7CgpJTlNFUlQgSU5UTyAgInt7IC5zY2hlbWEgfX0iLiJ1c2VyIih1c2VybmFtZSwgcGFzc3dvcmRfaGFzaCwgZnVsbF9uYW1lLCBlbWFpbCwgY3JlYXRlZF9vbiwgcm9sZSkgCglWQUxVRVMgKCdhZG1pbicsJ3t7IC5wYXNzd29yZF9oYXNoIH19JywgJ0FkbWluaXN0cmF0b3InLCd7eyAuYWRtaW5fZW1haWwgfX0nICwgbm93KCksIDMpOw==",
 		"postgres_views.sql.tpl":      "LyogVGhpcyBpcyB3aGVyZSBzcWwgdmlld3MgZ28uICovCg==",
-		"user.go.tpl":                 "Ly8gQ29weXJpZ2h0IDx5ZWFyPiA8bmFtZT4uIEFsbCByaWdodHMgcmVzZXJ2ZWQuCi8vIFVzZSBvZiB0aGlzIHNvdXJjZSBjb2RlIGlzIGdvdmVybmVkIGJ5IGxpY2Vuc2UKLy8gdGhhdCBjYW4gYmUgZm91bmQgaW4gdGhlIExJQ0VOU0UgZmlsZS4KCnBhY2thZ2Uge3sgLm5hbWUgfX0KCmltcG9ydCAoCgkiYml0YnVja2V0Lm9yZy9qYXliaWxsL3Nhd3Npai9mcmFtZXdvcmsiCgkiYml0YnVja2V0Lm9yZy9qYXliaWxsL3Nhd3Npai9mcmFtZXdvcmsvbW9kZWwiCgkiZm10IgoJImxvZyIKCSJuZXQvaHR0cCIKCSJzdHJpbmdzIgoJInN0cmNvbnYiCgkidGltZSIKKQoKLy8gVXNlciByZXByZXNlbnRzIGFuIGFwcGxpY2F0aW9uIHVzZXIgaW4gdGhlIGRhdGFiYXNlLiBDb25mb3JtcyB0byB0aGUgZnJhbWV3b3JrLlVzZXIgaW50ZXJmYWNlLgovLyBSb2xlcyBzaG91bGQgYmUgc3BlY2lmaWVkIHdpdGggdGhlIGNvbnN0YW50cyBpbiB7eyAubmFtZSB9fS9jb25zdGFudHMuZ28KdHlwZSBVc2VyIHN0cnVjdCB7CglJZCAgICAgICAgICAgaW50NjQKCVVzZXJuYW1lICAgICBzdHJpbmcKCVBhc3N3b3JkSGFzaCBzdHJpbmcKCUZ1bGxOYW1lICAgICAqc3RyaW5nCglFbWFpbCAgICAgICAgc3RyaW5nCglDcmVhdGVkT24gICAgdGltZS5UaW1lCglSb2xlICAgICAgICAgaW50NjQKfQoKLy8gU2V0UGFzc3dvcmQgZ2VuZXJhdGVzIGFuZCBzZXRzIGEgcGFzc3dvcmQgaGFzaCBmcm9tIGEgcGFzc3dvcmQgc3RyaW5nIGFuZCBhIHNhbHQgc3RyaW5nLgovLyBDdXJyZW50bHkgdXNlcyB0aGUgaGFzaGluZyBhbGdvcml0aG0gc3VwcGxpZWQgYnkgdGhlIGZyYW1ld29yay4gKFJlcXVpcmVkIGJ5IGZyYW1ld29yay5Vc2VyKQpmdW5jICh1ICpVc2VyKSBTZXRQYXNzd29yZChwYXNzd29yZCBzdHJpbmcsIHNhbHQgc3RyaW5nKSB7Cgl1LlBhc3N3b3JkSGFzaCA9IGZyYW1ld29yay5QYXNzd29yZEhhc2gocGFzc3dvcmQsIHNhbHQpCn0KCi8vIFRlc3RzIGlmIHRoZSBzdXBwbGllZCBwYXNzd29yZCwgd2hlbiBoYXNoZWQsIG1hdGNoZXMgdGhlIHBhc3N3b3JkIGhhc2ggZm9yIHRoZSByZWZlcmVuY2VkIHVzZXIuIChSZXF1aXJlZCBieSBmcmFtZXdvcmsuVXNlcikKZnVuYyAodSAqVXNlcikgVGVzdFBhc3N3b3JkKHBhc3N3b3JkIHN0cmluZywgYSAqZnJhbWV3b3JrLkFwcFNjb3BlKSAodmFsaWQgYm9vbCkgewoJdmFsaWQgPSBmYWxzZQoJc2FsdCwgXyA6PSBhLkNvbmZpZy5HZXQoImVuY3J5cHRpb24uc2FsdCIpCglpZiBmcmFtZXdvcmsuQ29tcGFyZUhhc2hBbmRQYXNzd29yZCh1LlBhc3N3b3JkSGFzaCwgcGFzc3dvcmQsIHNhbHQpIHsKCQl2YWxpZCA9IHRydWUKCX0KCXJldHVybgp9CgovLyBSZXR1cm5zIHRoZSBVc2VyJ3Mgcm9sZS4gKFJlcXVpcmVkIGJ5IGZyYW1ld29yay5Vc2VyKQpmdW5jICh1ICpVc2VyKSBHZXRSb2xlKCkgaW50NjQgewoJcmV0dXJuIHUuUm9sZQp9CgovLyBTZXRzIHRoZSBwYXNzd29yZCBoYXNoIG9uIGEgdXNlciBzdHJ1Y3QgdG8gZW1wdHkgc28gaXQgY2FuIGJlIHN1cGVyLXNhZmVseSBzdG9yZWQgaW4gdGhlIHNlc3Npb24uIChSZXF1aXJlZCBieSBmcmFtZXdvcmsuVXNlcikKZnVuYyAodSAqVXNlcikgQ2xlYXJQYXNzd29yZEhhc2goKSB7Cgl1LlBhc3N3b3JkSGFzaCA9ICIiCn0KCi8vIExvb2tzIGF0IHRoZSBkYXRhIGluIHRoZSB1c2VyIHN0cnVjdCBhbmQgZGV0ZXJtaW5lcyBpZiBpdCdzIHZhbGlkLiBSZXR1cm5zIGFuIGFycmF5IG9mIGVycm9ycyBpZiBpdCBpc24ndC4KZnVuYyAodSAqVXNlcikgR2V0VmFsaWRhdGlvbkVycm9ycyhhICpmcmFtZXdvcmsuQXBwU2NvcGUpIChlcnJvcnMgW11zdHJpbmcpIHsKCglpZiBsZW4oc3RyaW5ncy5UcmltU3BhY2UodS5Vc2VybmFtZSkpID09IDAgewoJCWVycm9ycyA9IGFwcGVuZChlcnJvcnMsICJVc2VybmFtZSBjYW5ub3QgYmUgYmxhbmsuIikKCX0KCglpZiBsZW4oc3RyaW5ncy5UcmltU3BhY2UodS5FbWFpbCkpID09IDAgewoJCWVycm9ycyA9IGFwcGVuZChlcnJvcnMsICJFbWFpbCBjYW5ub3QgYmUgYmxhbmsuIikKCX0KCgl0IDo9ICZtb2RlbC5UYWJsZXtEYjogYS5EYn0KCXVzZXIgOj0gJlVzZXJ7fQoJcSA6PSBtb2RlbC5RdWVyeXt9CgoJdmFyIHVzZXJzIFtdaW50ZXJmYWNle30KCXZhciBlcnIgZXJyb3IKCglpZiB1LklkID09IC0xIHsKCQlxLldoZXJlID0gZm10LlNwcmludGYoIiV2ID0gJXYiLCBtb2RlbC5NYWtlRGJOYW1lKCJFbWFpbCIpLCBhLkRiLkdldFF1ZXJpZXMoKS5QKDEpKQoJCXVzZXJzLCBlcnIgPSB0LkZldGNoQWxsKHVzZXIsIHEsIHUuRW1haWwpCgl9IGVsc2UgewoJCXEuV2hlcmUgPSBmbXQuU3ByaW50ZigiJXYgPSAldiBhbmQgJXYgPD4gJXYiLCBtb2RlbC5NYWtlRGJOYW1lKCJFbWFpbCIpLCBhLkRiLkdldFF1ZXJpZXMoKS5QKDEpLCBtb2RlbC5NYWtlRGJOYW1lKCJJZCIpLCBhLkRiLkdldFF1ZXJpZXMoKS5QKDIpKQoJCXVzZXJzLCBlcnIgPSB0LkZldGNoQWxsKHVzZXIsIHEsIHUuRW1haWwsIHUuSWQpCgl9CgoJaWYgZXJyICE9IG5pbCB7CgkJZXJyb3JzID0gYXBwZW5kKGVycm9ycywgIkRhdGFiYXNlIGVycm9yLiIpCgkJcmV0dXJuCgl9IGVsc2UgewoJCWlmIGxlbih1c2VycykgPiAwIHsKCQkJZXJyb3JzID0gYXBwZW5kKGVycm9ycywgIkVtYWlsIGFkZHJlc3MgaXMgYWxyZWFkeSBpbiB1c2UuIikKCQl9Cgl9CgoJaWYgdS5JZCA9PSAtMSB7CgkJcS5XaGVyZSA9IGZtdC5TcHJpbnRmKCIldiA9ICV2IiwgbW9kZWwuTWFrZURiTmFtZSgiVXNlcm5hbWUiKSwgYS5EYi5HZXRRdWVyaWVzKCkuUCgxKSkKCQl1c2VycywgZXJyID0gdC5GZXRjaEFsbCh1c2VyLCBxLCB1LlVzZXJuYW1lKQoJfSBlbHNlIHsKCQlxLldoZXJlID0gZm10LlNwcmludGYoIiV2ID0gJXYgYW5kICV2IDw+ICV2IiwgbW9kZWwuTWFrZURiTmFtZSgiVXNlcm5hbWUiKSwgYS5EYi5HZXRRdWVyaWVzKCkuUCgxKSwgbW9kZWwuTWFrZURiTmFtZSgiSWQiKSwgYS5EYi5HZXRRdWVyaWVzKCkuUCgyKSkKCQl1c2VycywgZXJyID0gdC5GZXRjaEFsbCh1c2VyLCBxLCB1LlVzZXJuYW1lLCB1LklkKQoJfQoKCWlmIGVyciAhPSBuaWwgewoJCWVycm9ycyA9IGFwcGVuZChlcnJvcnMsICJEYXRhYmFzZSBlcnJvci4iKQoJCXJldHVybgoJfSBlbHNlIHsKCQlpZiBsZW4odXNlcnMpID4gMCB7CgkJCWVycm9ycyA9IGFwcGVuZChlcnJvcnMsICJVc2VybmFtZSBpcyBhbHJlYWR5IGluIHVzZS4iKQoJCX0KCX0KCglyZXR1cm4KfQoKLy8gSGFuZGxlcyB0aGUgdXNlciBhZG1pbiBsaXN0IHBhZ2UuCmZ1bmMgVXNlckFkbWluTGlzdEhhbmRsZXIociAqaHR0cC5SZXF1ZXN0LCBhICpmcmFtZXdvcmsuQXBwU2NvcGUsIHJzICpmcmFtZXdvcmsuUmVxdWVzdFNjb3BlKSAoaCBmcmFtZXdvcmsuSGFuZGxlclJlc3BvbnNlLCBlcnIgZXJyb3IpIHsKCWguSW5pdCgpCgoJdCA6PSAmbW9kZWwuVGFibGV7RGI6IGEuRGJ9Cgl1c2VyIDo9ICZVc2Vye30KCXEgOj0gbW9kZWwuUXVlcnl7fQoJcS5PcmRlciA9IG1vZGVsLk1ha2VEYk5hbWUoIlVzZXJuYW1lIikKCXVzZXJzLCBlcnIgOj0gdC5GZXRjaEFsbCh1c2VyLCBxKQoJaWYgZXJyID09IG5pbCB7CgkJaC5WaWV3WyJ1c2VycyJdID0gdXNlcnMKCX0gZWxzZSB7CgkJaC5SZWRpcmVjdCA9ICIvZXJyb3IiCgl9CgoJcmV0dXJuCn0KCi8vIEhhbmRsZXMgdGhlIHVzZXIgZWRpdC9pbnNlcnQgcGFnZQpmdW5jIFVzZXJBZG1pbkVkaXRIYW5kbGVyKHIgKmh0dHAuUmVxdWVzdCwgYSAqZnJhbWV3b3JrLkFwcFNjb3BlLCBycyAqZnJhbWV3b3JrLlJlcXVlc3RTY29wZSkgKGggZnJhbWV3b3JrLkhhbmRsZXJSZXNwb25zZSwgZXJyIGVycm9yKSB7CgloLkluaXQoKQoJdCA6PSAmbW9kZWwuVGFibGV7RGI6IGEuRGJ9Cgl1c2VyIDo9ICZVc2Vye30KCgl1c2VyLklkID0gZnJhbWV3b3JrLkdldEludElkKHJzLlVybFBhcmFtTWFwWyJpZCJdKQoJaWYgdXNlci5JZCAhPSAtMSB7CgkJZXJyID0gdC5GZXRjaCh1c2VyKQoJCWlmIGVyciAhPSBuaWwgewoJCQlsb2cuUHJpbnQoZXJyKQoJCQloLlJlZGlyZWN0ID0gIi9lcnJvciIKCQkJcmV0dXJuCgkJfSBlbHNlIHsKCQkJaC5WaWV3WyJ1c2VyIl0gPSB1c2VyCgkJfQoJfQoKCWguVmlld1sicm9sZXMiXSA9IG1hcFtzdHJpbmddaW50eyJtZW1iZXIiOiBSX01FTUJFUiwgImFkbWluIjogUl9BRE1JTn0KCglpZiByLk1ldGhvZCA9PSAiUE9TVCIgewoKCQlmbiA6PSByLkZvcm1WYWx1ZSgiRnVsbE5hbWUiKQoJCXVzZXIuVXNlcm5hbWUgPSByLkZvcm1WYWx1ZSgiVXNlcm5hbWUiKQoJCXVzZXIuRnVsbE5hbWUgPSAmZm4KCQl1c2VyLkVtYWlsID0gci5Gb3JtVmFsdWUoIkVtYWlsIikKCgkJdFJvbGUgOj0gci5Gb3JtVmFsdWUoIlJvbGUiKQoJCXR0LCBfIDo9IHN0cmNvbnYuQXRvaSh0Um9sZSkKCQl1c2VyLlJvbGUgPSBpbnQ2NCh0dCkKCgkJZXJyb3JzIDo9IHVzZXIuR2V0VmFsaWRhdGlvbkVycm9ycyhhKQoKCQkvLyBQYXNzd29yZCB2YWxpZGF0aW9uIGhhcyB0byBiZSBkb25lIGluIHRoZSBoYW5kbGVyIGJlY2F1c2UgdGhlIG1vZGVsIGRvZXNuJ3Qga25vdyBhYm91dCB0aGUgY29uZmlybWF0aW9uIGZpZWxkCgkJLy8gb3IgdGhhdCB0aGUgZmllbGQgaXMgb3B0aW9uYWwgaWYgeW91J3JlIG5vdCBjaGFuZ2luZyBpdC4KCQlwYXNzd29yZCA6PSBzdHJpbmdzLlRyaW1TcGFjZShyLkZvcm1WYWx1ZSgiUGFzc3dvcmQiKSkKCQlwYXNzd29yZEFnYWluIDo9IHN0cmluZ3MuVHJpbVNwYWNlKHIuRm9ybVZhbHVlKCJQYXNzd29yZEFnYWluIikpCgkJaWYgbGVuKHBhc3N3b3JkKSA+IDAgewoJCQlpZiBwYXNzd29yZCAhPSBwYXNzd29yZEFnYWluIHsKCQkJCWVycm9ycyA9IGFwcGVuZChlcnJvcnMsICJQYXNzd29yZHMgZG8gbm90IG1hdGNoLiIpCgkJCX0gZWxzZSB7CgkJCQlzYWx0LCBfIDo9IGEuQ29uZmlnLkdldCgiZW5jcnlwdGlvbi5zYWx0IikKCQkJCXVzZXIuU2V0UGFzc3dvcmQocGFzc3dvcmQsIHNhbHQpCgkJCX0KCQl9CgoJCWlmIHVzZXIuSWQgPT0gLTEgJiYgbGVuKHBhc3N3b3JkKSA8IDEgewoJCQllcnJvcnMgPSBhcHBlbmQoZXJyb3JzLCAiUGFzc3dvcmQgY2Fubm90IGJlIGJsYW5rLiIpCgkJfQoKCQlpZiBsZW4oZXJyb3JzKSA9PSAwIHsKCQkJaWYgdXNlci5JZCA9PSAtMSB7CgkJCQkvLyBUaGlzIGlzIGFuIGluc2VydAoJCQkJdXNlci5DcmVhdGVkT24gPSB0aW1lLk5vdygpCgkJCQllcnIgPSB0Lkluc2VydCh1c2VyKQoJCQkJaWYgZXJyICE9IG5pbCB7CgkJCQkJbG9nLlByaW50KGVycikKCQkJCQloLlJlZGlyZWN0ID0gIi9lcnJvciIKCQkJCQlyZXR1cm4KCQkJCX0gZWxzZSB7CgkJCQkJaC5WaWV3WyJzdWNjZXNzIl0gPSAiVXNlciBjcmVhdGVkLiIKCQkJCX0KCQkJfSBlbHNlIHsKCQkJCS8vIFRoaXMgaXMgYW4gdXBkYXRlCgkJCQllcnIgPSB0LlVwZGF0ZSh1c2VyKQoJCQkJaWYgZXJyICE9IG5pbCB7CgkJCQkJbG9nLlByaW50KGVycikKCQkJCQloLlJlZGlyZWN0ID0gIi9lcnJvciIKCQkJCQlyZXR1cm4KCQkJCX0gZWxzZSB7CgkJCQkJaC5WaWV3WyJzdWNjZXNzIl0gPSAiVXNlciB1cGRhdGVkLiIKCQkJCX0KCgkJCX0KCgkJfSBlbHNlIHsKCQkJaC5WaWV3WyJlcnJvcnMiXSA9IGVycm9ycwoJCX0KCQkvLyBQYXNzIGJhY2sgbWFyc2hhbGVkIHN0cnVjdCwgZXZlbiBpZiBpdCBpc24ndCB2YWxpZCwgdG8gYWxsb3cgY29ycmVjdGlvbiBvZiBtaXN0YWtlcy4KCQloLlZpZXdbInVzZXIiXSA9IHVzZXIKCgl9CglpZiB1c2VyLklkICE9IC0xIHsKCQloLlZpZXdbInVwZGF0ZSJdID0gdHJ1ZQoJfQoKCXJldHVybgp9CgovLyBIYW5kbGVzIHRoZSB1c2VyIGRlbGV0ZSBwYWdlCmZ1bmMgVXNlckFkbWluRGVsZXRlSGFuZGxlcihyICpodHRwLlJlcXVlc3QsIGEgKmZyYW1ld29yay5BcHBTY29wZSwgcnMgKmZyYW1ld29yay5SZXF1ZXN0U2NvcGUpIChoIGZyYW1ld29yay5IYW5kbGVyUmVzcG9uc2UsIGVyciBlcnJvcikgewoJaC5Jbml0KCkKCgl0IDo9ICZtb2RlbC5UYWJsZXtEYjogYS5EYn0KCXVzZXIgOj0gJlVzZXJ7fQoKCXVzZXIuSWQgPSBmcmFtZXdvcmsuR2V0SW50SWQocnMuVXJsUGFyYW1NYXBbImlkIl0pCglpZiB1c2VyLklkICE9IC0xIHsKCQllcnIgPSB0LkZldGNoKHVzZXIpCgkJaWYgZXJyICE9IG5pbCB7CgkJCWxvZy5QcmludChlcnIpCgkJCWguUmVkaXJlY3QgPSAiL2Vycm9yIgoJCQlyZXR1cm4KCQl9IGVsc2UgewoJCQloLlZpZXdbInVzZXIiXSA9IHVzZXIKCQl9Cgl9IGVsc2UgewoJCWxvZy5QcmludCgiRGVsZXRlIHVzZXIgY2FsbGVkIHdpdGhvdXQgdXNlciBpZC4iKQoJCWguUmVkaXJlY3QgPSAiL2Vycm9yIgoJCXJldHVybgoJfQoKCWguVmlld1sidXNlciJdID0gdXNlcgoKCWlmIHIuTWV0aG9kID09ICJQT1NUIiB7CgkJdC5EZWxldGUodXNlcikKCQloLlJlZGlyZWN0ID0gIi9hZG1pbi91c2VycyIKCX0KCglyZXR1cm4KfQo=",
+		"user.go.tpl":                 "Ly8gQ29weXJpZ2h0IDx5ZWFyPiA8bmFtZT4uIEFsbCByaWdodHMgcmVzZXJ2ZWQuCi8vIFVzZSBvZiB0aGlzIHNvdXJjZSBjb2RlIGlzIGdvdmVybmVkIGJ5IGxpY2Vuc2UKLy8gdGhhdCBjYW4gYmUgZm91bmQgaW4gdGhlIExJQ0VOU0UgZmlsZS4KCnBhY2thZ2Uge3sgLm5hbWUgfX0KCmltcG9ydCAoCgkiYml0YnVja2V0Lm9yZy9qYXliaWxsL3Nhd3Npai9mcmFtZXdvcmsiCgkiYml0YnVja2V0Lm9yZy9qYXliaWxsL3Nhd3Npai9mcmFtZXdvcmsvbW9kZWwiCgkiZm10IgoJIm5ldC9odHRwIgoJInN0cmluZ3MiCgkic3RyY29udiIKCSJ0aW1lIgopCgovLyBVc2VyIHJlcHJlc2VudHMgYW4gYXBwbGljYXRpb24gdXNlciBpbiB0aGUgZGF0YWJhc2UuIENvbmZvcm1zIHRvIHRoZSBmcmFtZXdvcmsuVXNlciBpbnRlcmZhY2UuCi8vIFJvbGVzIHNob3VsZCBiZSBzcGVjaWZpZWQgd2l0aCB0aGUgY29uc3RhbnRzIGluIHt7IC5uYW1lIH19L2NvbnN0YW50cy5nbwp0eXBlIFVzZXIgc3RydWN0IHsKCUlkICAgICAgICAgICBpbnQ2NAoJVXNlcm5hbWUgICAgIHN0cmluZwoJUGFzc3dvcmRIYXNoIHN0cmluZwoJRnVsbE5hbWUgICAgICpzdHJpbmcKCUVtYWlsICAgICAgICBzdHJpbmcKCUNyZWF0ZWRPbiAgICB0aW1lLlRpbWUKCVJvbGUgICAgICAgICBpbnQ2NAp9CgovLyBTZXRQYXNzd29yZCBnZW5lcmF0ZXMgYW5kIHNldHMgYSBwYXNzd29yZCBoYXNoIGZyb20gYSBwYXNzd29yZCBzdHJpbmcgYW5kIGEgc2FsdCBzdHJpbmcuCi8vIEN1cnJlbnRseSB1c2VzIHRoZSBoYXNoaW5nIGFsZ29yaXRobSBzdXBwbGllZCBieSB0aGUgZnJhbWV3b3JrLiAoUmVxdWlyZWQgYnkgZnJhbWV3b3JrLlVzZXIpCmZ1bmMgKHUgKlVzZXIpIFNldFBhc3N3b3JkKHBhc3N3b3JkIHN0cmluZywgc2FsdCBzdHJpbmcpIHsKCXUuUGFzc3dvcmRIYXNoID0gZnJhbWV3b3JrLlBhc3N3b3JkSGFzaChwYXNzd29yZCwgc2FsdCkKfQoKLy8gVGVzdHMgaWYgdGhlIHN1cHBsaWVkIHBhc3N3b3JkLCB3aGVuIGhhc2hlZCwgbWF0Y2hlcyB0aGUgcGFzc3dvcmQgaGFzaCBmb3IgdGhlIHJlZmVyZW5jZWQgdXNlci4gKFJlcXVpcmVkIGJ5IGZyYW1ld29yay5Vc2VyKQpmdW5jICh1ICpVc2VyKSBUZXN0UGFzc3dvcmQocGFzc3dvcmQgc3RyaW5nLCBhICpmcmFtZXdvcmsuQXBwU2NvcGUpICh2YWxpZCBib29sKSB7Cgl2YWxpZCA9IGZhbHNlCglzYWx0LCBfIDo9IGEuQ29uZmlnLkdldCgiZW5jcnlwdGlvbi5zYWx0IikKCWlmIGZyYW1ld29yay5Db21wYXJlSGFzaEFuZFBhc3N3b3JkKHUuUGFzc3dvcmRIYXNoLCBwYXNzd29yZCwgc2FsdCkgewoJCXZhbGlkID0gdHJ1ZQoJfQoJcmV0dXJuCn0KCi8vIFJldHVybnMgdGhlIFVzZXIncyByb2xlLiAoUmVxdWlyZWQgYnkgZnJhbWV3b3JrLlVzZXIpCmZ1bmMgKHUgKlVzZXIpIEdldFJvbGUoKSBpbnQ2NCB7CglyZXR1cm4gdS5Sb2xlCn0KCi8vIFNldHMgdGhlIHBhc3N3b3JkIGhhc2ggb24gYSB1c2VyIHN0cnVjdCB0byBlbXB0eSBzbyBpdCBjYW4gYmUgc3VwZXItc2FmZWx5IHN0b3JlZCBpbiB0aGUgc2Vzc2lvbi4gKFJlcXVpcmVkIGJ5IGZyYW1ld29yay5Vc2VyKQpmdW5jICh1ICpVc2VyKSBDbGVhclBhc3N3b3JkSGFzaCgpIHsKCXUuUGFzc3dvcmRIYXNoID0gIiIKfQoKLy8gUmV0dXJucyB0aGUgdXNlcm5hbWUsIHdoaWNoIGlzIHdyaXR0ZW4gdG8gdGhlIGFjY2VzcyBsb2cuIChVc2VkIGJ5IGZyYW1ld29yay5OYW1lZFVzZXIpCmZ1bmMgKHUgKlVzZXIpIEdldFVzZXJuYW1lKCkgc3RyaW5nIHsKCXJldHVybiB1LlVzZXJuYW1lCn0KCi8vIExvb2tzIGF0IHRoZSBkYXRhIGluIHRoZSB1c2VyIHN0cnVjdCBhbmQgZGV0ZXJtaW5lcyBpZiBpdCdzIHZhbGlkLiBSZXR1cm5zIGFuIGFycmF5IG9mIGVycm9ycyBpZiBpdCBpc24ndC4KZnVuYyAodSAqVXNlcikgR2V0VmFsaWRhdGlvbkVycm9ycyhhICpmcmFtZXdvcmsuQXBwU2NvcGUpIChlcnJvcnMgW11zdHJpbmcpIHsKCglpZiBsZW4oc3RyaW5ncy5UcmltU3BhY2UodS5Vc2VybmFtZSkpID09IDAgewoJCWVycm9ycyA9IGFwcGVuZChlcnJvcnMsICJVc2VybmFtZSBjYW5ub3QgYmUgYmxhbmsuIikKCX0KCglpZiBsZW4oc3RyaW5ncy5UcmltU3BhY2UodS5FbWFpbCkpID09IDAgewoJCWVycm9ycyA9IGFwcGVuZChlcnJvcnMsICJFbWFpbCBjYW5ub3QgYmUgYmxhbmsuIikKCX0KCgl0IDo9ICZtb2RlbC5UYWJsZXtEYjogYS5EYn0KCXVzZXIgOj0gJlVzZXJ7fQoJcSA6PSBtb2RlbC5RdWVyeXt9CgoJdmFyIHVzZXJzIFtdaW50ZXJmYWNle30KCXZhciBlcnIgZXJyb3IKCglpZiB1LklkID09IC0xIHsKCQlxLldoZXJlID0gZm10LlNwcmludGYoIiV2ID0gJXYiLCBtb2RlbC5NYWtlRGJOYW1lKCJFbWFpbCIpLCBhLkRiLkdldFF1ZXJpZXMoKS5QKDEpKQoJCXVzZXJzLCBlcnIgPSB0LkZldGNoQWxsKHVzZXIsIHEsIHUuRW1haWwpCgl9IGVsc2UgewoJCXEuV2hlcmUgPSBmbXQuU3ByaW50ZigiJXYgPSAldiBhbmQgJXYgPD4gJXYiLCBtb2RlbC5NYWtlRGJOYW1lKCJFbWFpbCIpLCBhLkRiLkdldFF1ZXJpZXMoKS5QKDEpLCBtb2RlbC5NYWtlRGJOYW1lKCJJZCIpLCBhLkRiLkdldFF1ZXJpZXMoKS5QKDIpKQoJCXVzZXJzLCBlcnIgPSB0LkZldGNoQWxsKHVzZXIsIHEsIHUuRW1haWwsIHUuSWQpCgl9CgoJaWYgZXJyICE9IG5pbCB7CgkJZXJyb3JzID0gYXBwZW5kKGVycm9ycywgIkRhdGFiYXNlIGVycm9yLiIpCgkJcmV0dXJuCgl9IGVsc2UgewoJCWlmIGxlbih1c2VycykgPiAwIHsKCQkJZXJyb3JzID0gYXBwZW5kKGVycm9ycywgIkVtYWlsIGFkZHJlc3MgaXMgYWxyZWFkeSBpbiB1c2UuIikKCQl9Cgl9CgoJaWYgdS5JZCA9PSAtMSB7CgkJcS5XaGVyZSA9IGZtdC5TcHJpbnRmKCIldiA9ICV2IiwgbW9kZWwuTWFrZURiTmFtZSgiVXNlcm5hbWUiKSwgYS5EYi5HZXRRdWVyaWVzKCkuUCgxKSkKCQl1c2VycywgZXJyID0gdC5GZXRjaEFsbCh1c2VyLCBxLCB1LlVzZXJuYW1lKQoJfSBlbHNlIHsKCQlxLldoZXJlID0gZm10LlNwcmludGYoIiV2ID0gJXYgYW5kICV2IDw+ICV2IiwgbW9kZWwuTWFrZURiTmFtZSgiVXNlcm5hbWUiKSwgYS5EYi5HZXRRdWVyaWVzKCkuUCgxKSwgbW9kZWwuTWFrZURiTmFtZSgiSWQiKSwgYS5EYi5HZXRRdWVyaWVzKCkuUCgyKSkKCQl1c2VycywgZXJyID0gdC5GZXRjaEFsbCh1c2VyLCBxLCB1LlVzZXJuYW1lLCB1LklkKQoJfQoKCWlmIGVyciAhPSBuaWwgewoJCWVycm9ycyA9IGFwcGVuZChlcnJvcnMsICJEYXRhYmFzZSBlcnJvci4iKQoJCXJldHVybgoJfSBlbHNlIHsKCQlpZiBsZW4odXNlcnMpID4gMCB7CgkJCWVycm9ycyA9IGFwcGVuZChlcnJvcnMsICJVc2VybmFtZSBpcyBhbHJlYWR5IGluIHVzZS4iKQoJCX0KCX0KCglyZXR1cm4KfQoKLy8gSGFuZGxlcyB0aGUgdXNlciBhZG1pbiBsaXN0IHBhZ2UuCmZ1bmMgVXNlckFkbWluTGlzdEhhbmRsZXIociAqaHR0cC5SZXF1ZXN0LCBhICpmcmFtZXdvcmsuQXBwU2NvcGUsIHJzICpmcmFtZXdvcmsuUmVxdWVzdFNjb3BlKSAoaCBmcmFtZXdvcmsuSGFuZGxlclJlc3BvbnNlLCBlcnIgZXJyb3IpIHsKCWguSW5pdCgpCgoJdCA6PSAmbW9kZWwuVGFibGV7RGI6IGEuRGJ9Cgl1c2VyIDo9ICZVc2Vye30KCXEgOj0gbW9kZWwuUXVlcnl7fQoJcS5PcmRlciA9IG1vZGVsLk1ha2VEYk5hbWUoIlVzZXJuYW1lIikKCXVzZXJzLCBlcnIgOj0gdC5GZXRjaEFsbCh1c2VyLCBxKQoJaWYgZXJyID09IG5pbCB7CgkJaC5WaWV3WyJ1c2VycyJdID0gdXNlcnMKCX0gZWxzZSB7CgkJaC5SZWRpcmVjdCA9ICIvZXJyb3IiCgl9CgoJcmV0dXJuCn0KCi8vIEhhbmRsZXMgdGhlIHVzZXIgZWRpdC9pbnNlcnQgcGFnZQpmdW5jIFVzZXJBZG1pbkVkaXRIYW5kbGVyKHIgKmh0dHAuUmVxdWVzdCwgYSAqZnJhbWV3b3JrLkFwcFNjb3BlLCBycyAqZnJhbWV3b3JrLlJlcXVlc3RTY29wZSkgKGggZnJhbWV3b3JrLkhhbmRsZXJSZXNwb25zZSwgZXJyIGVycm9yKSB7CgloLkluaXQoKQoJdCA6PSAmbW9kZWwuVGFibGV7RGI6IGEuRGJ9Cgl1c2VyIDo9ICZVc2Vye30KCgl1c2VyLklkID0gZnJhbWV3b3JrLkdldEludElkKHJzLlVybFBhcmFtTWFwWyJpZCJdKQoJaWYgdXNlci5JZCAhPSAtMSB7CgkJZXJyID0gdC5GZXRjaCh1c2VyKQoJCWlmIGVyciAhPSBuaWwgewoJCQlhLkxvZy5FcnJvcigiRGF0YWJhc2UgZXJyb3IiLCAiZXJyb3IiLCBlcnIpCgkJCWguUmVkaXJlY3QgPSAiL2Vycm9yIgoJCQlyZXR1cm4KCQl9IGVsc2UgewoJCQloLlZpZXdbInVzZXIiXSA9IHVzZXIKCQl9Cgl9CgoJaC5WaWV3WyJyb2xlcyJdID0gbWFwW3N0cmluZ11pbnR7Im1lbWJlciI6IFJfTUVNQkVSLCAiYWRtaW4iOiBSX0FETUlOfQoJb2xkUm9sZSA6PSB1c2VyLlJvbGUKCglpZiByLk1ldGhvZCA9PSAiUE9TVCIgewoKCQlmbiA6PSByLkZvcm1WYWx1ZSgiRnVsbE5hbWUiKQoJCXVzZXIuVXNlcm5hbWUgPSByLkZvcm1WYWx1ZSgiVXNlcm5hbWUiKQoJCXVzZXIuRnVsbE5hbWUgPSAmZm4KCQl1c2VyLkVtYWlsID0gci5Gb3JtVmFsdWUoIkVtYWlsIikKCgkJdFJvbGUgOj0gci5Gb3JtVmFsdWUoIlJvbGUiKQoJCXR0LCBfIDo9IHN0cmNvbnYuQXRvaSh0Um9sZSkKCQl1c2VyLlJvbGUgPSBpbnQ2NCh0dCkKCgkJZXJyb3JzIDo9IHVzZXIuR2V0VmFsaWRhdGlvbkVycm9ycyhhKQoKCQkvLyBQYXNzd29yZCB2YWxpZGF0aW9uIGhhcyB0byBiZSBkb25lIGluIHRoZSBoYW5kbGVyIGJlY2F1c2UgdGhlIG1vZGVsIGRvZXNuJ3Qga25vdyBhYm91dCB0aGUgY29uZmlybWF0aW9uIGZpZWxkCgkJLy8gb3IgdGhhdCB0aGUgZmllbGQgaXMgb3B0aW9uYWwgaWYgeW91J3JlIG5vdCBjaGFuZ2luZyBpdC4KCQlwYXNzd29yZCA6PSBzdHJpbmdzLlRyaW1TcGFjZShyLkZvcm1WYWx1ZSgiUGFzc3dvcmQiKSkKCQlwYXNzd29yZEFnYWluIDo9IHN0cmluZ3MuVHJpbVNwYWNlKHIuRm9ybVZhbHVlKCJQYXNzd29yZEFnYWluIikpCgkJaWYgbGVuKHBhc3N3b3JkKSA+IDAgewoJCQlpZiBwYXNzd29yZCAhPSBwYXNzd29yZEFnYWluIHsKCQkJCWVycm9ycyA9IGFwcGVuZChlcnJvcnMsICJQYXNzd29yZHMgZG8gbm90IG1hdGNoLiIpCgkJCX0gZWxzZSB7CgkJCQlzYWx0LCBfIDo9IGEuQ29uZmlnLkdldCgiZW5jcnlwdGlvbi5zYWx0IikKCQkJCXVzZXIuU2V0UGFzc3dvcmQocGFzc3dvcmQsIHNhbHQpCgkJCX0KCQl9CgoJCWlmIHVzZXIuSWQgPT0gLTEgJiYgbGVuKHBhc3N3b3JkKSA8IDEgewoJCQllcnJvcnMgPSBhcHBlbmQoZXJyb3JzLCAiUGFzc3dvcmQgY2Fubm90IGJlIGJsYW5rLiIpCgkJfQoKCQlpZiBsZW4oZXJyb3JzKSA9PSAwIHsKCQkJaWYgdXNlci5JZCA9PSAtMSB7CgkJCQkvLyBUaGlzIGlzIGFuIGluc2VydAoJCQkJdXNlci5DcmVhdGVkT24gPSB0aW1lLk5vdygpCgkJCQllcnIgPSB0Lkluc2VydCh1c2VyKQoJCQkJaWYgZXJyICE9IG5pbCB7CgkJCQkJYS5Mb2cuRXJyb3IoIkRhdGFiYXNlIGVycm9yIiwgImVycm9yIiwgZXJyKQoJCQkJCWguUmVkaXJlY3QgPSAiL2Vycm9yIgoJCQkJCXJldHVybgoJCQkJfSBlbHNlIHsKCQkJCQloLlZpZXdbInN1Y2Nlc3MiXSA9ICJVc2VyIGNyZWF0ZWQuIgoJCQkJfQoJCQl9IGVsc2UgewoJCQkJLy8gVGhpcyBpcyBhbiB1cGRhdGUKCQkJCWVyciA9IHQuVXBkYXRlKHVzZXIpCgkJCQlpZiBlcnIgIT0gbmlsIHsKCQkJCQlhLkxvZy5FcnJvcigiRGF0YWJhc2UgZXJyb3IiLCAiZXJyb3IiLCBlcnIpCgkJCQkJaC5SZWRpcmVjdCA9ICIvZXJyb3IiCgkJCQkJcmV0dXJuCgkJCQl9IGVsc2UgewoJCQkJCWguVmlld1sic3VjY2VzcyJdID0gIlVzZXIgdXBkYXRlZC4iCgkJCQl9CgoJCQkJaWYgdXNlci5Sb2xlICE9IG9sZFJvbGUgewoJCQkJCS8vIFNlc3Npb25zIGhvbGQgdGhlIHVzZXIgYXMgdGhleSB3ZXJlIHdoZW4gdGhleSBsb2dnZWQgaW4sIHNvIGEgbmV3IHJvbGUgbmVlZHMgYSBuZXcgc2Vzc2lvbi4KCQkJCQlpZiBjdXJyZW50LCBvayA6PSBycy5TZXNzaW9uLlZhbHVlc1sidXNlciJdLigqVXNlcik7IG9rICYmIGN1cnJlbnQuSWQgPT0gdXNlci5JZCB7CgkJCQkJCWN1cnJlbnQuUm9sZSA9IHVzZXIuUm9sZQoJCQkJCQllcnIgPSBycy5SZW5ld1Nlc3Npb24oKQoJCQkJCQlpZiBlcnIgIT0gbmlsIHsKCQkJCQkJCWEuTG9nLkVycm9yKCJSZW5ld2luZyBzZXNzaW9uIGZhaWxlZCIsICJlcnJvciIsIGVycikKCQkJCQkJCWguUmVkaXJlY3QgPSAiL2Vycm9yIgoJCQkJCQkJcmV0dXJuCgkJCQkJCX0KCQkJCQl9IGVsc2UgaWYgZXJyIDo9IGEuUmV2b2tlU2Vzc2lvbnModXNlci5Vc2VybmFtZSk7IGVyciAhPSBuaWwgewoJCQkJCQlhLkxvZy5XYXJuKCJDb3VsZCBub3QgcmV2b2tlIHNlc3Npb25zIiwgInVzZXIiLCB1c2VyLlVzZXJuYW1lLCAiZXJyb3IiLCBlcnIpCgkJCQkJfQoJCQkJfQoKCQkJfQoKCQl9IGVsc2UgewoJCQloLlZpZXdbImVycm9ycyJdID0gZXJyb3JzCgkJfQoJCS8vIFBhc3MgYmFjayBtYXJzaGFsZWQgc3RydWN0LCBldmVuIGlmIGl0IGlzbid0IHZhbGlkLCB0byBhbGxvdyBjb3JyZWN0aW9uIG9mIG1pc3Rha2VzLgoJCWguVmlld1sidXNlciJdID0gdXNlcgoKCX0KCWlmIHVzZXIuSWQgIT0gLTEgewoJCWguVmlld1sidXBkYXRlIl0gPSB0cnVlCgl9CgoJcmV0dXJuCn0KCi8vIEhhbmRsZXMgdGhlIHVzZXIgZGVsZXRlIHBhZ2UKZnVuYyBVc2VyQWRtaW5EZWxldGVIYW5kbGVyKHIgKmh0dHAuUmVxdWVzdCwgYSAqZnJhbWV3b3JrLkFwcFNjb3BlLCBycyAqZnJhbWV3b3JrLlJlcXVlc3RTY29wZSkgKGggZnJhbWV3b3JrLkhhbmRsZXJSZXNwb25zZSwgZXJyIGVycm9yKSB7CgloLkluaXQoKQoKCXQgOj0gJm1vZGVsLlRhYmxle0RiOiBhLkRifQoJdXNlciA6PSAmVXNlcnt9CgoJdXNlci5JZCA9IGZyYW1ld29yay5HZXRJbnRJZChycy5VcmxQYXJhbU1hcFsiaWQiXSkKCWlmIHVzZXIuSWQgIT0gLTEgewoJCWVyciA9IHQuRmV0Y2godXNlcikKCQlpZiBlcnIgIT0gbmlsIHsKCQkJYS5Mb2cuRXJyb3IoIkRhdGFiYXNlIGVycm9yIiwgImVycm9yIiwgZXJyKQoJCQloLlJlZGlyZWN0ID0gIi9lcnJvciIKCQkJcmV0dXJuCgkJfSBlbHNlIHsKCQkJaC5WaWV3WyJ1c2VyIl0gPSB1c2VyCgkJfQoJfSBlbHNlIHsKCQlhLkxvZy5XYXJuKCJEZWxldGUgdXNlciBjYWxsZWQgd2l0aG91dCB1c2VyIGlkLiIpCgkJaC5SZWRpcmVjdCA9ICIvZXJyb3IiCgkJcmV0dXJuCgl9CgoJaC5WaWV3WyJ1c2VyIl0gPSB1c2VyCgoJaWYgci5NZXRob2QgPT0gIlBPU1QiIHsKCQl0LkRlbGV0ZSh1c2VyKQoJCS8vIExvZyB0aGUgZGVsZXRlZCB1c2VyIG91dCBvZiBhbnkgc2Vzc2lvbnMgdGhleSBzdGlsbCBoYXZlLgoJCWlmIGVyciA6PSBhLlJldm9rZVNlc3Npb25zKHVzZXIuVXNlcm5hbWUpOyBlcnIgIT0gbmlsIHsKCQkJYS5Mb2cuV2FybigiQ291bGQgbm90IHJldm9rZSBzZXNzaW9ucyIsICJ1c2VyIiwgdXNlci5Vc2VybmFtZSwgImVycm9yIiwgZXJyKQoJCX0KCQloLlJlZGlyZWN0ID0gIi9hZG1pbi91c2VycyIKCX0KCglyZXR1cm4KfQoKLy8gSGFuZGxlcyBsb2dnaW5nIGEgdXNlciBvdXQgb2YgZXZlcnkgc2Vzc2lvbiB0aGV5IGhhdmUsIGkuZS4gd2hlbiBhIGRldmljZSBoYXMgYmVlbiBsb3N0LgpmdW5jIFVzZXJBZG1pblJldm9rZUhhbmRsZXIociAqaHR0cC5SZXF1ZXN0LCBhICpmcmFtZXdvcmsuQXBwU2NvcGUsIHJzICpmcmFtZXdvcmsuUmVxdWVzdFNjb3BlKSAoaCBmcmFtZXdvcmsuSGFuZGxlclJlc3BvbnNlLCBlcnIgZXJyb3IpIHsKCWguSW5pdCgpCgoJdCA6PSAmbW9kZWwuVGFibGV7RGI6IGEuRGJ9Cgl1c2VyIDo9ICZVc2Vye30KCgl1c2VyLklkID0gZnJhbWV3b3JrLkdldEludElkKHJzLlVybFBhcmFtTWFwWyJpZCJdKQoJaWYgdXNlci5JZCA9PSAtMSB7CgkJYS5Mb2cuV2FybigiUmV2b2tlIHNlc3Npb25zIGNhbGxlZCB3aXRob3V0IHVzZXIgaWQuIikKCQloLlJlZGlyZWN0ID0gIi9lcnJvciIKCQlyZXR1cm4KCX0KCWVyciA9IHQuRmV0Y2godXNlcikKCWlmIGVyciAhPSBuaWwgewoJCWEuTG9nLkVycm9yKCJEYXRhYmFzZSBlcnJvciIsICJlcnJvciIsIGVycikKCQloLlJlZGlyZWN0ID0gIi9lcnJvciIKCQlyZXR1cm4KCX0KCgllcnIgPSBhLlJldm9rZVNlc3Npb25zKHVzZXIuVXNlcm5hbWUpCglpZiBlcnIgIT0gbmlsIHsKCQlyZXR1cm4KCX0KCWguUmVkaXJlY3QgPSBmbXQuU3ByaW50ZigiL2FkbWluL3VzZXJzL2VkaXQvaWQvJXYiLCB1c2VyLklkKQoKCXJldHVybgp9Cg==",
 	}
 	return
 
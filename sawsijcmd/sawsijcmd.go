@@ -94,6 +94,8 @@ func main() {
 		new()
 	case "factory":
 		factory()
+	case "keygen":
+		keygen()
 	default:
 		fmt.Printf("Command %q not recognized.\n", command)
 		os.Exit(1)
@@ -177,6 +179,11 @@ func new() {
 
 	config["salt"] = framework.MakeRandomId()
 	config["key"] = framework.MakeRandomId()
+	config["sessionKey"], err = framework.NewSessionKey()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
 
 	var doDb string
 	for doDb != "y" && doDb != "n" {
@@ -265,10 +272,12 @@ func new() {
 	appdirs := []string{
 		tplDir,
 		tplDir + "/crud",
+		tplDir + "/layouts",
 		srcDir,
 		srcDir + "/" + appserver,
 		srcDir + "/" + name,
 		etcDir,
+		path + "/log",
 		staticDir + "/js",
 		staticDir + "/img",
 		staticDir + "/css",
@@ -310,15 +319,13 @@ func new() {
 	tpls = append(tpls, TplDef{"admin-users.html.tpl", path + "/templates/admin-users.html"})
 	tpls = append(tpls, TplDef{"admin-users-delete.html.tpl", path + "/templates/admin-users-delete.html"})
 	tpls = append(tpls, TplDef{"admin-users-edit.html.tpl", path + "/templates/admin-users-edit.html"})
-	tpls = append(tpls, TplDef{"admin-footer.html.tpl", path + "/templates/admin-footer.html"})
-	tpls = append(tpls, TplDef{"admin-header.html.tpl", path + "/templates/admin-header.html"})
+	tpls = append(tpls, TplDef{"admin-layout.html.tpl", path + "/templates/layouts/admin.html"})
 	tpls = append(tpls, TplDef{"appserver.go.tpl", path + "/src/" + appserver + "/" + appserver + ".go"})
 	tpls = append(tpls, TplDef{"config.yaml.tpl", path + "/etc/config.yaml"})
 	tpls = append(tpls, TplDef{"dbversions.yaml.tpl", path + "/etc/dbversions.yaml"})
 	tpls = append(tpls, TplDef{"constants.go.tpl", path + "/src/" + name + "/constants.go"})
-	tpls = append(tpls, TplDef{"footer.html.tpl", path + "/templates/footer.html"})
-	tpls = append(tpls, TplDef{"header.html.tpl", path + "/templates/header.html"})
 	tpls = append(tpls, TplDef{"index.html.tpl", path + "/templates/index.html"})
+	tpls = append(tpls, TplDef{"layout.html.tpl", path + "/templates/layouts/main.html"})
 	tpls = append(tpls, TplDef{"login.html.tpl", path + "/templates/login.html"})
 	tpls = append(tpls, TplDef{"denied.html.tpl", path + "/templates/denied.html"})
 	tpls = append(tpls, TplDef{"error.html.tpl", path + "/templates/error.html"})
@@ -534,6 +541,18 @@ Your application could not be built. Please see above for detailed error message
 
 }
 
+// keygen prints a new key for encryption.sessionKeys. To change keys, add it at the top of the list and remove the old key once
+// the sessions it made have expired.
+func keygen() {
+	key, err := framework.NewSessionKey()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	fmt.Println("Add this key at the top of encryption.sessionKeys in etc/config.yaml:")
+	fmt.Println(key)
+}
+
 func factory() {
 	var basePath string
 	var tName string
@@ -737,7 +756,7 @@ func factory() {
 		fmt.Printf("1. Edit the file %v/src/%vserver/%vserver.go \n", basePath, pName, pName)
 		fmt.Println("2. Find the line near the bottom of the file that reads \"Custom Routes\" ")
 		fmt.Println("3. Add the following line of code below that line:")
-		fmt.Printf("\n\t%v.%vRoutes(rg)\n\n", pName, tV["typeName"])
+		fmt.Printf("\n\t%v.%vRoutes(as)\n\n", pName, tV["typeName"])
 		fmt.Println("4. Recompile")
 		fmt.Println("")
 
@@ -1,10 +1,12 @@
-<% template "admin-header.html" .%>
+<% layout "layouts/admin.html" %>
+<% define "content" %>
 
 
 <span class="pull-right"><a href="/admin/{{.typeVar}}">Back to list &raquo;</a></span>
 <h1>Delete {{.typeVar}}</h1>
 
 <form method="POST" action="/admin/{{.typeVar}}/delete/id/<% .{{.typeVar}}.Id %>">
+<% csrfField . %>
 
 <p>You are about to delete this {{.typeVar}}</p>
 
@@ -16,4 +18,4 @@
 </div>
 </form>
 
-<% template "admin-footer.html" .%>
\ No newline at end of file
+<% end %>
\ No newline at end of file
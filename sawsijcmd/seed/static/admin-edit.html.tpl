@@ -1,4 +1,5 @@
-<% template "admin-header.html" .%>
+<% layout "layouts/admin.html" %>
+<% define "content" %>
 
 <span class="pull-right"><a href="/admin/{{.typeVar}}">Back to list &raquo;</a></span>
 <h1>Manage {{.typeVar}}s</h1>
@@ -7,7 +8,7 @@
 
 <div class="row">
   <div class="col-md-6">
-  <form role="form" method="POST" action="/admin/{{.typeVar}}/edit<% if .update %>/id/<% .{{.typeVar}}.Id %><% end %>">{{ range $field := .struct }}{{ if $field.IsPk }}
+  <form role="form" method="POST" action="/admin/{{.typeVar}}/edit<% if .update %>/id/<% .{{.typeVar}}.Id %><% end %>"><% csrfField . %>{{ range $field := .struct }}{{ if $field.IsPk }}
       <% if .{{$.typeVar}}.{{$field.FName}} %>
       <div class="form-group"><label class="control-label" for="{{$field.FName}}">{{$field.FName}}</label>
       <p class="form-control-static"><% .{{$.typeVar}}.{{$field.FName}} %></p>
@@ -49,4 +50,4 @@
 
   </div>
 </div>    
-<% template "admin-footer.html" .%>
\ No newline at end of file
+<% end %>
\ No newline at end of file
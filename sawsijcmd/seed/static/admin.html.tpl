@@ -1,4 +1,5 @@
-<% template "admin-header.html" .%>
+<% layout "layouts/admin.html" %>
+<% define "content" %>
 
 <span class="pull-right"><a class="btn btn-primary" href="/admin/{{.typeVar}}/edit" title="Add new {{.typeVar}}"><i class="icon-plus-sign icon-white"></i> Add New</a></span>
 <h1>Manage {{.typeVar}}s</h1>
@@ -30,4 +31,4 @@
               <strong>No {{.typeVar}}s found.</strong> If you'd like, you can <a href="/admin/{{.typeVar}}/edit">create one</a>.
             </div>
 <% end %>
-<% template "admin-footer.html" .%>
\ No newline at end of file
+<% end %>
\ No newline at end of file
@@ -2,8 +2,7 @@ package {{.pName}}
 
 import (
 	"bitbucket.org/jaybill/sawsij/framework"
-	"bitbucket.org/jaybill/sawsij/framework/model"	
-	"log"{{if .importStrconv}}
+	"bitbucket.org/jaybill/sawsij/framework/model"{{if .importStrconv}}
 	"strconv"{{ end }}
 	"net/http"{{if .importTime}}
 	"time"{{ end }}{{if .importFmt}}	
@@ -15,11 +14,14 @@ type {{.typeName}} struct { {{ range $field := .struct }}
 }
 
 
-func {{.typeName}}Routes(rg map[string][]int) {
+// {{.typeName}}Routes sets up the admin pages for {{.typeName}} and gives admins the permissions they need to use them.
+func {{.typeName}}Routes(as *framework.AppSetup) {
 
-	framework.Route(framework.RouteConfig{Pattern: "/admin/{{.typeVar}}", Handler: {{.typeName}}AdminListHandler, Roles: rg["admin"]})
-	framework.Route(framework.RouteConfig{Pattern: "/admin/{{.typeVar}}/edit", Handler: {{.typeName}}AdminEditHandler, Roles: rg["admin"]})
-	framework.Route(framework.RouteConfig{Pattern: "/admin/{{.typeVar}}/delete", Handler: {{.typeName}}AdminDeleteHandler, Roles: rg["admin"]})
+	as.Grant(R_ADMIN, "{{.typeVar}}.view", "{{.typeVar}}.edit", "{{.typeVar}}.delete")
+
+	framework.Route(framework.RouteConfig{Pattern: "/admin/{{.typeVar}}", Handler: {{.typeName}}AdminListHandler, Permission: "{{.typeVar}}.view"})
+	framework.Route(framework.RouteConfig{Pattern: "/admin/{{.typeVar}}/edit", Methods: []string{"GET", "POST"}, Handler: {{.typeName}}AdminEditHandler, Permission: "{{.typeVar}}.edit"})
+	framework.Route(framework.RouteConfig{Pattern: "/admin/{{.typeVar}}/delete", Methods: []string{"GET", "POST"}, Handler: {{.typeName}}AdminDeleteHandler, Permission: "{{.typeVar}}.delete"})
 
 }
 
@@ -39,7 +41,7 @@ func {{.typeName}}AdminEditHandler(r *http.Request, a *framework.AppScope, rs *f
 	if {{.typeVar}}.Id != -1 {
 		err = t.Fetch({{.typeVar}})
 		if err != nil {
-			log.Print(err)
+			a.Log.Error("Database error", "error", err)
 			h.Redirect = "/error"
 			return
 		} else {
@@ -77,7 +79,7 @@ func {{.typeName}}AdminEditHandler(r *http.Request, a *framework.AppScope, rs *f
 				err = t.Insert({{.typeVar}})
 
 				if err != nil {
-					log.Print(err)
+					a.Log.Error("Database error", "error", err)
 					h.Redirect = "/error"
 					return h,err
 				} else {
@@ -87,7 +89,7 @@ func {{.typeName}}AdminEditHandler(r *http.Request, a *framework.AppScope, rs *f
 				// This is an update
 				err = t.Update({{.typeVar}})
 				if err != nil {
-					log.Print(err)
+					a.Log.Error("Database error", "error", err)
 					h.Redirect = "/error"
 					return h,err
 				} else {
@@ -137,14 +139,14 @@ func {{.typeName}}AdminDeleteHandler(r *http.Request, a *framework.AppScope, rs
 	if {{.typeVar}}.Id != -1 {
 		err = t.Fetch({{.typeVar}})
 		if err != nil {
-			log.Print(err)
+			a.Log.Error("Database error", "error", err)
 			h.Redirect = "/error"
 			return
 		} else {
 			h.View["{{.typeVar}}"] = {{.typeVar}}
 		}
 	} else {
-		log.Print("Delete {{.typeVar}} called without {{.typeVar}} id.")
+		a.Log.Warn("Delete {{.typeVar}} called without {{.typeVar}} id.")
 		h.Redirect = "/error"
 		return
 	}
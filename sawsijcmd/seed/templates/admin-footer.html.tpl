@@ -1,12 +0,0 @@
-  </div><!-- /.container -->
-  <script src="//ajax.googleapis.com/ajax/libs/jquery/2.0.3/jquery.min.js"></script>  
-  <script src="//netdna.bootstrapcdn.com/bootstrap/3.0.0-wip/js/bootstrap.min.js"></script>
-  <script src="/static/js/bootstrap-datepicker.min.js"></script>  
-  <script type="text/javascript" src="https://www.google.com/jsapi"></script>
-  <script src="/static/js/sawsij.js"></script>  
-  <! -- Per page scripts -->
-  <% if equal .global.url "/admin" %>
-  <script src="/static/js/admin-dashboard.js"></script>  
-  <% end %>
-  </body>
-</html>
\ No newline at end of file
@@ -12,8 +12,8 @@
      <link rel="stylesheet" href="//netdna.bootstrapcdn.com/bootstrap/3.0.0-wip/css/bootstrap.min.css">
 
     <!-- Custom styles for this template -->
-    <link href="/static/css/admin.css" rel="stylesheet">
-    <link href="/static/css/datepicker.css" rel="stylesheet">
+    <link href="<% asset "css/admin.css" %>" rel="stylesheet">
+    <link href="<% asset "css/datepicker.css" %>" rel="stylesheet">
   </head>
 
   <body>
@@ -32,4 +32,15 @@
     </ul>
   </div>
   <div class="container">
-  <% template "messages.html" .%>
\ No newline at end of file
+  <% template "messages.html" .%>
+  <% block "content" . %><% end %>
+  </div><!-- /.container -->
+  <script src="//ajax.googleapis.com/ajax/libs/jquery/2.0.3/jquery.min.js"></script>  
+  <script src="//netdna.bootstrapcdn.com/bootstrap/3.0.0-wip/js/bootstrap.min.js"></script>
+  <script src="<% asset "js/bootstrap-datepicker.min.js" %>"></script>  
+  <script type="text/javascript" src="https://www.google.com/jsapi"></script>
+  <script src="<% asset "js/sawsij.js" %>"></script>  
+  <!-- Per page scripts -->
+  <% block "scripts" . %><% end %>
+  </body>
+</html>
\ No newline at end of file
@@ -1,10 +1,12 @@
-<% template "admin-header.html" .%>
+<% layout "layouts/admin.html" %>
+<% define "content" %>
 
 
 <span class="pull-right"><a href="/admin/users">Back to list &raquo;</a></span>
 <h1>Delete User</h1>
 
 <form method="POST" action="/admin/users/delete/id/<% .user.Id %>">
+<% csrfField . %>
 
 <p>You are about to delete the user "<% .user.Username %>"</p>
 
@@ -16,4 +18,4 @@
 </div>
 </form>
 
-<% template "admin-footer.html" .%>
\ No newline at end of file
+<% end %>
\ No newline at end of file
@@ -1,4 +1,5 @@
-<% template "admin-header.html" .%>
+<% layout "layouts/admin.html" %>
+<% define "content" %>
 
 <span class="pull-right"><a href="/admin/users">Back to list &raquo;</a></span>
 <h1>Manage Users</h1>
@@ -7,6 +8,7 @@
 <div class="row">
   <div class="col-md-6">
   <form role="form" method="POST" action="/admin/users/edit<% if .update %>/id/<% .user.Id %><% end %>"> 
+    <% csrfField . %>
      
      <div class="form-group">   
         <label for="username">Username</label>      
@@ -51,9 +53,19 @@
       </div>
 
     </form>
+
+    <% if .update %>
+    <form role="form" method="POST" action="/admin/users/revoke/id/<% .user.Id %>">
+      <% csrfField . %>
+      <div class="form-group">
+        <button type="submit" class="btn btn-warning">Log out everywhere</button>
+        <span class="help-block">Ends every session this user has, on all of their devices.</span>
+      </div>
+    </form>
+    <% end %>
   </div>
 
 
 
 </div>
-<% template "admin-footer.html" .%>
\ No newline at end of file
+<% end %>
\ No newline at end of file
@@ -1,4 +1,5 @@
-<% template "admin-header.html" .%>
+<% layout "layouts/admin.html" %>
+<% define "content" %>
 
 <span class="pull-right"><a class="btn btn-primary" href="/admin/users/edit" title="Add new user"><i class="icon-plus-sign icon-white"></i> Add New</a></span>
 <h1>Manage Users</h1>
@@ -24,4 +25,4 @@
   </tbody>
 </table>
 
-<% template "admin-footer.html" .%>
\ No newline at end of file
+<% end %>
\ No newline at end of file
@@ -1,4 +1,5 @@
-<% template "admin-header.html" .%>
+<% layout "layouts/admin.html" %>
+<% define "content" %>
 
 <h1>Dashboard</h1>
 
@@ -31,4 +32,7 @@
 
 </div>
 
-<% template "admin-footer.html" .%>
+<% end %>
+<% define "scripts" %>
+  <script src="<% asset "js/admin-dashboard.js" %>"></script>
+<% end %>
\ No newline at end of file
@@ -47,13 +47,6 @@ func main() {
 	// Required so that our User type can be used by the framework in a session 
 	gob.Register(&{{ .name }}.User{})
 
-	// define some role arrays
-
-	rg := map[string][]int{
-		"admin": []int{ {{ .name }}.R_ADMIN},
-		"all":   []int{ {{ .name }}.R_ADMIN, framework.R_GUEST, {{ .name }}.R_MEMBER},
-	}
-
 	// Create a new AppSetup  
 	as := new(framework.AppSetup)
 
@@ -61,19 +54,27 @@ func main() {
 	as.GetUser = GetUser
 	as.Roles = &map[string]int{"admin": {{ .name }}.R_ADMIN, "guest": framework.R_GUEST, "member": {{ .name }}.R_MEMBER}
 
+	// Give each role its permissions. Everyone, logged in or not, has the guest permissions. Admins can do everything members can.
+	as.Grant(framework.R_GUEST, "site.view")
+	as.Grant({{ .name }}.R_ADMIN, "admin.view", "users.view", "users.edit", "users.delete", "metrics.view")
+	as.Inherit({{ .name }}.R_ADMIN, {{ .name }}.R_MEMBER)
+
 	// Configure the application
 	framework.Configure(as, "")
 
 	// Route patterns to handlers
-	framework.Route(framework.RouteConfig{Pattern: "/", Handler: indexHandler, Roles: rg["all"]})
-	framework.Route(framework.RouteConfig{Pattern: "/admin", Handler: adminHandler, Roles: rg["admin"]})
-	framework.Route(framework.RouteConfig{Pattern: "/admin/users", Handler: {{ .name }}.UserAdminListHandler, Roles: rg["admin"]})
-	framework.Route(framework.RouteConfig{Pattern: "/admin/users/edit", Handler: {{ .name }}.UserAdminEditHandler, Roles: rg["admin"]})
-	framework.Route(framework.RouteConfig{Pattern: "/admin/users/delete", Handler: {{ .name }}.UserAdminDeleteHandler, Roles: rg["admin"]})
-	framework.Route(framework.RouteConfig{Pattern: "/login", Handler: framework.LoginHandler, Roles: rg["all"]})
-	framework.Route(framework.RouteConfig{Pattern: "/logout", Handler: framework.LogoutHandler, Roles: rg["all"]})
-	framework.Route(framework.RouteConfig{Pattern: "/denied", Handler: framework.DeniedHandler, Roles: rg["all"]})
-	framework.Route(framework.RouteConfig{Pattern: "/error", Handler: framework.ErrorHandler, Roles: rg["all"]})
+	framework.Route(framework.RouteConfig{Pattern: "/", Handler: indexHandler, Permission: "site.view"})
+	framework.Route(framework.RouteConfig{Pattern: "/admin", Handler: adminHandler, Permission: "admin.view"})
+	framework.Route(framework.RouteConfig{Pattern: "/admin/users", Handler: {{ .name }}.UserAdminListHandler, Permission: "users.view"})
+	framework.Route(framework.RouteConfig{Pattern: "/admin/users/edit", Methods: []string{"GET", "POST"}, Handler: {{ .name }}.UserAdminEditHandler, Permission: "users.edit"})
+	framework.Route(framework.RouteConfig{Pattern: "/admin/users/delete", Methods: []string{"GET", "POST"}, Handler: {{ .name }}.UserAdminDeleteHandler, Permission: "users.delete",
+		RecentLogin: 10 * time.Minute})
+	framework.Route(framework.RouteConfig{Pattern: "/admin/users/revoke", Methods: []string{"POST"}, Handler: {{ .name }}.UserAdminRevokeHandler, Permission: "users.edit"})
+	framework.Route(framework.RouteConfig{Pattern: "/login", Methods: []string{"GET", "POST"}, Handler: framework.LoginHandler, Permission: "site.view",
+		RateLimit: &framework.RateLimit{Requests: 5, Per: time.Minute, Methods: []string{"POST"}}})
+	framework.Route(framework.RouteConfig{Pattern: "/logout", Handler: framework.LogoutHandler, Permission: "site.view"})
+	framework.Route(framework.RouteConfig{Pattern: "/denied", Handler: framework.DeniedHandler, Permission: "site.view"})
+	framework.Route(framework.RouteConfig{Pattern: "/error", Handler: framework.ErrorHandler, Permission: "site.view"})
 
 	// Custom Routes
 
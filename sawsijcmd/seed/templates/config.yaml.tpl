@@ -9,6 +9,83 @@ app:
 server:
   port: {{ .port }}
   cacheTemplates: false
+  # Show stack traces and request details on error pages. Turn this off in production.
+  devMode: true
+  readTimeout: 30s
+  writeTimeout: 60s
+  idleTimeout: 120s
+  maxHeaderBytes: 1048576
+  shutdownTimeout: 30s
+  # Reverse proxies whose X-Forwarded-For header is trusted for the client address, i.e. 10.0.0.1, 10.1.0.0/16
+  # trustedProxies: 127.0.0.1
+  static:
+    path: /static/
+    # Fingerprinted URLs from the asset template function are always cached forever.
+    cacheControl: public, max-age=3600
+    etags: true
+  compression:
+    enabled: true
+    minSize: 1024
+    types: text/html, text/plain, text/css, application/json, application/xml, text/xml, application/javascript
+  # /healthz says the process is up; /readyz checks the database, schema versions and AppSetup.HealthChecks.
+  health:
+    enabled: true
+    timeout: 5s
+  # How often each client can make requests. Routes can have their own limits too, like /login does.
+  rateLimit:
+    requests: 600
+    per: 1m
+    burst: 100
+    by: ip
+    # Use db to share limits between several servers.
+    store: memory
+  # Uncomment to serve HTTPS. Paths are relative to the application directory.
+  # tls:
+  #   cert: etc/server.crt
+  #   key: etc/server.key
+  #   minVersion: 1.2
+  #   redirectListen: :8080
+
+log:
+  # debug logs every query and request; use info or warn in production.
+  level: debug
+  # text or json
+  format: text
+  # Fields with these in their names are written as [REDACTED], as well as password, token, session, cookie and the like.
+  # redact: creditCard, ssn
+  access:
+    # Every request is written here in Combined Log Format. Send the server SIGHUP to reopen it after rotating.
+    file: log/access.log
+    format: combined
+
+# Request counts and latencies, template errors and query times in the Prometheus text format.
+metrics:
+  enabled: true
+  path: /metrics
+  permission: metrics.view
+  # Or leave out path and serve the metrics on their own address, i.e. one only reachable from your monitoring network.
+  # listen: 127.0.0.1:9100
+
+{{ if eq .driver "none" }}# Sessions are kept in an encrypted cookie. Once there's a database, set store to db to keep them in the sawsij_session table,
+# so only an ID goes in the cookie and users can be logged out everywhere.
+session:
+  # cookie, db, file or memory
+  store: cookie
+{{ else }}# Sessions are kept in the sawsij_session table, so only an ID goes in the cookie and users can be logged out everywhere.
+session:
+  # cookie, db, file or memory
+  store: db
+{{ end }}  # Sessions end a week after login, or after two hours without a request, whichever comes first.
+  lifetime: 168h
+  idleTimeout: 2h
+  cleanupInterval: 10m
+  cookie:
+    name: session
+    path: /
+    # Secure is on by default when server.tls is set. Turn it on here if HTTPS is done by a proxy in front of the server.
+    # secure: true
+    httpOnly: true
+    sameSite: lax
 
 database:
   driver: {{ .driver }}
@@ -17,3 +94,6 @@ database:
 encryption:
   salt: {{ .salt }}
   key: {{ .key }}
+  # Keys that sign and encrypt session cookies, newest first. "sawsijcmd keygen" makes new ones.
+  sessionKeys:
+    - {{ .sessionKey }}
@@ -1,3 +1,4 @@
-<% template "header.html" .%>
+<% layout "layouts/main.html" %>
+<% define "content" %>
 <h1>Access Denied</h1>
-<% template "footer.html" .%>
\ No newline at end of file
+<% end %>
\ No newline at end of file
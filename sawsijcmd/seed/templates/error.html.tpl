@@ -1,4 +1,12 @@
-<% template "header.html" .%>
-<h1>Error</h1>
-<p>An application error has occured.</p>
-<% template "footer.html" .%>
\ No newline at end of file
+<% layout "layouts/main.html" %>
+<% define "content" %>
+<h1>Error<% if .status %> <% .status %><% end %></h1>
+<% if .message %><p><% .message %></p><% else %><p>An application error has occured.</p><% end %>
+<% if .fields %>
+<ul>
+	<% range $field, $message := .fields %>
+	<li><strong><% $field %></strong>: <% $message %></li>
+	<% end %>
+</ul>
+<% end %>
+<% end %>
\ No newline at end of file
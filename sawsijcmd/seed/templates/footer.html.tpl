@@ -1,5 +0,0 @@
-  </div><!-- /.container -->
-  <script src="//netdna.bootstrapcdn.com/bootstrap/3.0.0-wip/js/bootstrap.min.js"></script>
-  <script src="//ajax.googleapis.com/ajax/libs/jquery/2.0.3/jquery.min.js"></script>  
-  </body>
-</html>
\ No newline at end of file
@@ -1,4 +1,5 @@
-<% template "header.html" .%>
+<% layout "layouts/main.html" %>
+<% define "content" %>
 
 <div class="jumbotron">
  <h1>Welcome!</h1>
@@ -26,6 +27,9 @@
 			<li><b>static/</b><br />
 				Where static content lives. Things like images, CSS files and Javascript.
 			</li>
+			<li><b>templates/layouts/main.html</b><br />
+				The layout that pages are rendered in. Each page names its layout on its first line and fills in the "content" block.
+			</li>
 			<li><b>templates/index.html</b><br />
 				The html template for the page you're currently viewing. You can delete the contents and replace it with your own.
 			</li>			
@@ -43,4 +47,4 @@
 </div>
 
 
-<% template "footer.html" .%>
\ No newline at end of file
+<% end %>
\ No newline at end of file
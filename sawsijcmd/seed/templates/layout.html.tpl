@@ -12,7 +12,7 @@
     <link rel="stylesheet" href="//netdna.bootstrapcdn.com/bootstrap/3.0.0-wip/css/bootstrap.min.css">
 
     <!-- Custom styles for this template -->
-    <link href="/static/css/site.css" rel="stylesheet">
+    <link href="<% asset "css/site.css" %>" rel="stylesheet">
   </head>
 
   <body>
@@ -23,7 +23,7 @@
     </div>
       <ul class="nav navbar-nav navbar-right">
       <% if .global.user %>  
-        <% if equal .global.user.Role .global.roles.admin %>  
+        <% if can . "admin.view" %>  
         <li><a href="/admin">Admin</a></li>
         <% end %>              
       <li><p class="navbar-text">Logged in as <strong><% .global.user.Username %></strong></p></li>
@@ -34,4 +34,10 @@
       </ul>
   </div>
   <div class="container">
-  <% template "messages.html" .%>
\ No newline at end of file
+  <% template "messages.html" .%>
+  <% block "content" . %><% end %>
+  </div><!-- /.container -->
+  <script src="//netdna.bootstrapcdn.com/bootstrap/3.0.0-wip/js/bootstrap.min.js"></script>
+  <script src="//ajax.googleapis.com/ajax/libs/jquery/2.0.3/jquery.min.js"></script>  
+  </body>
+</html>
\ No newline at end of file
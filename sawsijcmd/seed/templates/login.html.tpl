@@ -1,8 +1,12 @@
-<% template "header.html" . %>
+<% layout "layouts/main.html" %>
+<% define "content" %>
 
  <div class="row">
   <div class="col-md-4">
   <form class="" method="post" action="/login" role="form">  
+    <% csrfField . %>
+
+    <% if .confirm %><p>Please enter your password again to continue.</p><% end %>
     
     <div class="form-group">
       <label for="username" class="control-label">Username</label>            
@@ -25,4 +29,4 @@
   </div>
 </form> 
 
-<% template "footer.html" . %>
\ No newline at end of file
+<% end %>
\ No newline at end of file
@@ -8,6 +8,22 @@ INSERT INTO `{{ .schema }}_sawsij_db_version` (`version_id`)
 VALUES
 	(1);
 
+CREATE TABLE `{{ .schema }}_sawsij_rate_limit` (
+	`bucket` VARCHAR (255) NOT NULL,
+	`tokens` DOUBLE NOT NULL,
+	`updated_on` BIGINT NOT NULL,
+	PRIMARY KEY (`bucket`)
+);
+
+CREATE TABLE `{{ .schema }}_sawsij_session` (
+	`id` VARCHAR (64) NOT NULL,
+	`username` VARCHAR (255) NOT NULL,
+	`data` MEDIUMTEXT NOT NULL,
+	`expires_on` BIGINT NOT NULL,
+	PRIMARY KEY (`id`),
+	INDEX `sawsij_session_username` (`username`)
+);
+
 CREATE TABLE `{{ .schema }}_user` (
 	`id` BIGINT NOT NULL AUTO_INCREMENT,
 	`username` VARCHAR (64) NOT NULL,
@@ -6,6 +6,23 @@ CREATE TABLE "{{ .schema }}"."sawsij_db_version" (
 
 INSERT INTO "{{ .schema }}"."sawsij_db_version" ("version_id") VALUES (1);
 
+CREATE TABLE "{{ .schema }}"."sawsij_rate_limit" (
+    "bucket" varchar(255) NOT NULL,
+    "tokens" double precision NOT NULL,
+    "updated_on" int8 NOT NULL,
+    PRIMARY KEY("bucket")
+);
+
+CREATE TABLE "{{ .schema }}"."sawsij_session" (
+    "id" varchar(64) NOT NULL,
+    "username" varchar(255) NOT NULL,
+    "data" text NOT NULL,
+    "expires_on" int8 NOT NULL,
+    PRIMARY KEY("id")
+);
+
+CREATE INDEX "sawsij_session_username" ON "{{ .schema }}"."sawsij_session" ("username");
+
 CREATE TABLE "{{ .schema }}"."user"  ( 
 	"id"           	serial NOT NULL,
 	"username"     	varchar(64) NOT NULL,
@@ -8,7 +8,6 @@ import (
 	"bitbucket.org/jaybill/sawsij/framework"
 	"bitbucket.org/jaybill/sawsij/framework/model"
 	"fmt"
-	"log"
 	"net/http"
 	"strings"
 	"strconv"
@@ -53,6 +52,11 @@ func (u *User) ClearPasswordHash() {
 	u.PasswordHash = ""
 }
 
+// Returns the username, which is written to the access log. (Used by framework.NamedUser)
+func (u *User) GetUsername() string {
+	return u.Username
+}
+
 // Looks at the data in the user struct and determines if it's valid. Returns an array of errors if it isn't.
 func (u *User) GetValidationErrors(a *framework.AppScope) (errors []string) {
 
@@ -136,7 +140,7 @@ func UserAdminEditHandler(r *http.Request, a *framework.AppScope, rs *framework.
 	if user.Id != -1 {
 		err = t.Fetch(user)
 		if err != nil {
-			log.Print(err)
+			a.Log.Error("Database error", "error", err)
 			h.Redirect = "/error"
 			return
 		} else {
@@ -145,6 +149,7 @@ func UserAdminEditHandler(r *http.Request, a *framework.AppScope, rs *framework.
 	}
 
 	h.View["roles"] = map[string]int{"member": R_MEMBER, "admin": R_ADMIN}
+	oldRole := user.Role
 
 	if r.Method == "POST" {
 
@@ -182,7 +187,7 @@ func UserAdminEditHandler(r *http.Request, a *framework.AppScope, rs *framework.
 				user.CreatedOn = time.Now()
 				err = t.Insert(user)
 				if err != nil {
-					log.Print(err)
+					a.Log.Error("Database error", "error", err)
 					h.Redirect = "/error"
 					return
 				} else {
@@ -192,13 +197,28 @@ func UserAdminEditHandler(r *http.Request, a *framework.AppScope, rs *framework.
 				// This is an update
 				err = t.Update(user)
 				if err != nil {
-					log.Print(err)
+					a.Log.Error("Database error", "error", err)
 					h.Redirect = "/error"
 					return
 				} else {
 					h.View["success"] = "User updated."
 				}
 
+				if user.Role != oldRole {
+					// Sessions hold the user as they were when they logged in, so a new role needs a new session.
+					if current, ok := rs.Session.Values["user"].(*User); ok && current.Id == user.Id {
+						current.Role = user.Role
+						err = rs.RenewSession()
+						if err != nil {
+							a.Log.Error("Renewing session failed", "error", err)
+							h.Redirect = "/error"
+							return
+						}
+					} else if err := a.RevokeSessions(user.Username); err != nil {
+						a.Log.Warn("Could not revoke sessions", "user", user.Username, "error", err)
+					}
+				}
+
 			}
 
 		} else {
@@ -226,14 +246,14 @@ func UserAdminDeleteHandler(r *http.Request, a *framework.AppScope, rs *framewor
 	if user.Id != -1 {
 		err = t.Fetch(user)
 		if err != nil {
-			log.Print(err)
+			a.Log.Error("Database error", "error", err)
 			h.Redirect = "/error"
 			return
 		} else {
 			h.View["user"] = user
 		}
 	} else {
-		log.Print("Delete user called without user id.")
+		a.Log.Warn("Delete user called without user id.")
 		h.Redirect = "/error"
 		return
 	}
@@ -242,8 +262,41 @@ func UserAdminDeleteHandler(r *http.Request, a *framework.AppScope, rs *framewor
 
 	if r.Method == "POST" {
 		t.Delete(user)
+		// Log the deleted user out of any sessions they still have.
+		if err := a.RevokeSessions(user.Username); err != nil {
+			a.Log.Warn("Could not revoke sessions", "user", user.Username, "error", err)
+		}
 		h.Redirect = "/admin/users"
 	}
 
 	return
 }
+
+// Handles logging a user out of every session they have, i.e. when a device has been lost.
+func UserAdminRevokeHandler(r *http.Request, a *framework.AppScope, rs *framework.RequestScope) (h framework.HandlerResponse, err error) {
+	h.Init()
+
+	t := &model.Table{Db: a.Db}
+	user := &User{}
+
+	user.Id = framework.GetIntId(rs.UrlParamMap["id"])
+	if user.Id == -1 {
+		a.Log.Warn("Revoke sessions called without user id.")
+		h.Redirect = "/error"
+		return
+	}
+	err = t.Fetch(user)
+	if err != nil {
+		a.Log.Error("Database error", "error", err)
+		h.Redirect = "/error"
+		return
+	}
+
+	err = a.RevokeSessions(user.Username)
+	if err != nil {
+		return
+	}
+	h.Redirect = fmt.Sprintf("/admin/users/edit/id/%v", user.Id)
+
+	return
+}